@@ -2,5 +2,13 @@
   data:{
     "000001_create_initial_version.down.sql": importstr "scd/000001_create_initial_version.down.sql",
     "000001_create_initial_version.up.sql": importstr "scd/000001_create_initial_version.up.sql",
+    "000002_nullable_operation_subscription.down.sql": importstr "scd/000002_nullable_operation_subscription.down.sql",
+    "000002_nullable_operation_subscription.up.sql": importstr "scd/000002_nullable_operation_subscription.up.sql",
+    "000003_create_uss_availability.down.sql": importstr "scd/000003_create_uss_availability.down.sql",
+    "000003_create_uss_availability.up.sql": importstr "scd/000003_create_uss_availability.up.sql",
+    "000004_add_operation_priority.down.sql": importstr "scd/000004_add_operation_priority.down.sql",
+    "000004_add_operation_priority.up.sql": importstr "scd/000004_add_operation_priority.up.sql",
+    "000005_prefix_schema_versions.down.sql": importstr "scd/000005_prefix_schema_versions.down.sql",
+    "000005_prefix_schema_versions.up.sql": importstr "scd/000005_prefix_schema_versions.up.sql",
   },
 }
@@ -0,0 +1,3 @@
+DELETE FROM scd_operations WHERE subscription_id IS NULL;
+ALTER TABLE scd_operations ALTER COLUMN subscription_id SET NOT NULL;
+UPDATE schema_versions set schema_version = 'v1.0.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,2 @@
+ALTER TABLE scd_operations ALTER COLUMN subscription_id DROP NOT NULL;
+UPDATE schema_versions set schema_version = 'v1.1.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS scd_uss_availability;
+UPDATE schema_versions set schema_version = 'v1.1.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS scd_uss_availability (
+  id STRING PRIMARY KEY,
+  availability STRING NOT NULL,
+  version INT4 NOT NULL DEFAULT 0,
+  updated_at TIMESTAMPTZ NOT NULL
+);
+UPDATE schema_versions set schema_version = 'v1.2.0' WHERE onerow_enforcer = TRUE;
@@ -0,0 +1,2 @@
+ALTER TABLE scd_operations DROP COLUMN IF EXISTS priority;
+UPDATE schema_versions set schema_version = 'v1.2.0' WHERE onerow_enforcer = TRUE;
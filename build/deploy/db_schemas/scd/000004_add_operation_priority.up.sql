@@ -0,0 +1,2 @@
+ALTER TABLE scd_operations ADD COLUMN IF NOT EXISTS priority INT4 NOT NULL DEFAULT 0;
+UPDATE schema_versions set schema_version = 'v1.3.0' WHERE onerow_enforcer = TRUE;
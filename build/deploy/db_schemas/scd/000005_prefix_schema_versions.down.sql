@@ -0,0 +1,2 @@
+UPDATE scd_schema_versions set schema_version = 'v1.3.0' WHERE onerow_enforcer = TRUE;
+ALTER TABLE scd_schema_versions RENAME TO schema_versions;
@@ -0,0 +1,2 @@
+UPDATE schema_versions set schema_version = 'v1.4.0' WHERE onerow_enforcer = TRUE;
+ALTER TABLE schema_versions RENAME TO scd_schema_versions;
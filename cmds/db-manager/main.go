@@ -29,6 +29,41 @@ type MyMigrate struct {
 	*migrate.Migrate
 	postgresURI string
 	database    string
+	tables      schemaTables
+}
+
+// schemaTables names the tables tracking the migrations and schema version
+// of a set of migrations, which must differ between sets sharing a database.
+type schemaTables struct {
+	migrations string
+	versions   string
+}
+
+// defaultTables are the schemaTables of a set of migrations applied to its
+// own database.
+var defaultTables = schemaTables{migrations: "schema_migrations", versions: cockroach.DefaultVersionTable}
+
+// namespacedTables maps schemas_dir folder names to their schemaTables when
+// they differ from the defaults. A schema only records its version in its
+// versions table once migrated to the version renaming the default table to
+// it, and the default table is read until then.
+var namespacedTables = map[string]schemaTables{
+	"scd": {migrations: "scd_schema_migrations", versions: "scd_schema_versions"},
+}
+
+// tablesFor returns the schemaTables of the migrations in the schemas_dir
+// folder named schema, applied to database. Migrations are tracked in the
+// default table of their own database, as they were before databases could
+// be shared.
+func tablesFor(schema string, database string) schemaTables {
+	tables, ok := namespacedTables[schema]
+	if !ok {
+		return defaultTables
+	}
+	if database == schema {
+		tables.migrations = defaultTables.migrations
+	}
+	return tables
 }
 
 // Direction is an alias for int indicating the direction and steps of migration
@@ -47,6 +82,7 @@ var (
 	path      = flag.String("schemas_dir", "", "path to db migration files directory. the migrations found there will be applied to the database whose name matches the folder name.")
 	dbVersion = flag.String("db_version", "", "the db version to migrate to (ex: 1.0.0)")
 	step      = flag.Int("migration_step", 0, "the db migration step to go to")
+	dbName    = flag.String("db_name", "", "the database to apply migrations to, if not the one whose name matches the schemas_dir folder name. migrations from different folders may share a database.")
 )
 
 func main() {
@@ -73,11 +109,15 @@ func main() {
 	params := flags.ConnectParameters()
 	params.ApplicationName = "SchemaManager"
 	params.DBName = filepath.Base(*path)
+	if *dbName != "" {
+		params.DBName = *dbName
+	}
 	postgresURI, err := params.BuildURI()
 	if err != nil {
 		log.Panic("Failed to build URI", zap.Error(err))
 	}
-	myMigrater, err := New(*path, postgresURI, params.DBName)
+	tables := tablesFor(filepath.Base(*path), params.DBName)
+	myMigrater, err := New(*path, postgresURI, params.DBName, tables)
 	if err != nil {
 		log.Panic(err)
 	}
@@ -104,7 +144,7 @@ func main() {
 		log.Printf("Moved %d step(s) in total from Step %d to Step %d", intAbs(totalMoves), preMigrationStep, postMigrationStep)
 	}
 
-	currentDBVersion, err := getCurrentDBVersion(postgresURI, params.DBName)
+	currentDBVersion, err := getCurrentDBVersion(postgresURI, params.DBName, tables)
 	if err != nil {
 		log.Fatal("Failed to get Current DB version for confirmation")
 	}
@@ -134,7 +174,7 @@ func (m *MyMigrate) DoMigrate(desiredDBVersion semver.Version, desiredStep int)
 }
 
 // New instantiates a new migrate object
-func New(path string, dbURI string, database string) (*MyMigrate, error) {
+func New(path string, dbURI string, database string, tables schemaTables) (*MyMigrate, error) {
 	noDbPostgres := strings.Replace(dbURI, fmt.Sprintf("/%s", database), "", 1)
 	err := createDatabaseIfNotExists(noDbPostgres, database)
 	if err != nil {
@@ -142,11 +182,12 @@ func New(path string, dbURI string, database string) (*MyMigrate, error) {
 	}
 	path = fmt.Sprintf("file://%v", path)
 	crdbURI := strings.Replace(dbURI, "postgresql", "cockroachdb", 1)
+	crdbURI = fmt.Sprintf("%s&x-migrations-table=%s", crdbURI, tables.migrations)
 	migrater, err := migrate.New(path, crdbURI)
 	if err != nil {
 		return nil, err
 	}
-	myMigrater := &MyMigrate{migrater, dbURI, database}
+	myMigrater := &MyMigrate{migrater, dbURI, database, tables}
 	// handle Ctrl+c
 	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, syscall.SIGINT)
@@ -197,7 +238,7 @@ func createDatabaseIfNotExists(crdbURI string, database string) error {
 	return nil
 }
 
-func getCurrentDBVersion(crdbURI string, database string) (*semver.Version, error) {
+func getCurrentDBVersion(crdbURI string, database string, tables schemaTables) (*semver.Version, error) {
 	crdb, err := cockroach.Dial(crdbURI)
 	defer func() {
 		crdb.Close()
@@ -206,7 +247,49 @@ func getCurrentDBVersion(crdbURI string, database string) (*semver.Version, erro
 		return nil, fmt.Errorf("Failed to dial CRDB while getting DB version: %v", err)
 	}
 
-	return crdb.GetVersion(context.Background(), database)
+	ctx := context.Background()
+	version, err := crdb.GetVersionFromTable(ctx, database, tables.versions)
+	if err != nil || version != cockroach.UnknownVersion || tables.versions == defaultTables.versions {
+		return version, err
+	}
+
+	// Schemas record their version in the default table until migrated to the
+	// version renaming it. That table is only theirs if they have their own
+	// database, or if no schema tracked in the default tables, such as the
+	// remote ID one, was applied to the database they share.
+	if tables.migrations != defaultTables.migrations {
+		shared, err := tableExists(ctx, crdb, database, defaultTables.migrations)
+		if err != nil {
+			return nil, err
+		}
+		if shared {
+			return nil, fmt.Errorf("%s not found in database %s, whose %s holds the version of another schema: "+
+				"schemas sharing a database must be migrated to the version creating %s before any other schema is applied",
+				tables.versions, database, defaultTables.versions, tables.versions)
+		}
+	}
+	return crdb.GetVersionFromTable(ctx, database, defaultTables.versions)
+}
+
+// tableExists returns true if database holds table.
+func tableExists(ctx context.Context, crdb *cockroach.DB, database string, table string) (bool, error) {
+	const query = `
+		SELECT EXISTS (
+			SELECT
+				*
+			FROM
+				information_schema.tables
+			WHERE
+				table_name = $2
+			AND
+				table_catalog = $1
+		)
+	`
+	var exists bool
+	if err := crdb.QueryRowContext(ctx, query, database, table).Scan(&exists); err != nil {
+		return false, fmt.Errorf("Failed to check whether table %s exists: %v", table, err)
+	}
+	return exists, nil
 }
 
 // MigrationDirection reads our custom DB version string as well as the Migration Steps from the framework
@@ -222,7 +305,7 @@ func (m *MyMigrate) MigrationDirection(desiredVersion semver.Version, desiredSte
 		}
 		return Direction(desiredStep - int(currentStep)), nil
 	}
-	currentVersion, err := getCurrentDBVersion(m.postgresURI, m.database)
+	currentVersion, err := getCurrentDBVersion(m.postgresURI, m.database, m.tables)
 	if err != nil {
 		return 0, fmt.Errorf("Failed to get current DB version to determine migration direction: %v", err)
 	}
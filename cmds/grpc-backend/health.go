@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// pinger is implemented by the stores whose connectivity determines whether
+// the services backed by them are healthy.
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// monitorHealth reports each service in stores as SERVING through server while
+// its store responds to pings, and NOT_SERVING otherwise, pinging every
+// interval until ctx is done. The server as a whole, designated by the empty
+// service name, is SERVING only while every service is.
+func monitorHealth(ctx context.Context, logger *zap.Logger, server *health.Server, stores map[string]pinger, interval time.Duration) {
+	var (
+		serving = map[string]bool{}
+		check   = func() {
+			all := true
+			for service, store := range stores {
+				pingCtx, cancel := context.WithTimeout(ctx, interval)
+				err := store.Ping(pingCtx)
+				cancel()
+
+				ok := err == nil
+				if ok != serving[service] {
+					if ok {
+						logger.Info("store reachable, serving", zap.String("service", service))
+					} else {
+						logger.Warn("store unreachable, not serving", zap.String("service", service), zap.Error(err))
+					}
+				}
+				serving[service] = ok
+				server.SetServingStatus(service, servingStatus(ok))
+				all = all && ok
+			}
+			server.SetServingStatus("", servingStatus(all))
+		}
+	)
+
+	check()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			check()
+		case <-ctx.Done():
+			server.Shutdown()
+			return
+		}
+	}
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type fakePinger struct {
+	mu  sync.Mutex
+	err error
+}
+
+func (p *fakePinger) Ping(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+func (p *fakePinger) setErr(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+func TestHealthFollowsStoreConnectivity(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		server = health.NewServer()
+		rid    = &fakePinger{}
+		scd    = &fakePinger{}
+		done   = make(chan struct{})
+	)
+	go func() {
+		monitorHealth(ctx, zap.L(), server, map[string]pinger{"rid": rid, "scd": scd}, time.Millisecond)
+		close(done)
+	}()
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := server.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN
+		}
+		return resp.GetStatus()
+	}
+	requireStatus := func(service string, want healthpb.HealthCheckResponse_ServingStatus) {
+		require.Eventually(t, func() bool { return status(service) == want }, 10*time.Second, time.Millisecond, service)
+	}
+
+	requireStatus("", healthpb.HealthCheckResponse_SERVING)
+	requireStatus("rid", healthpb.HealthCheckResponse_SERVING)
+
+	// An outage of one store flips readiness until it recovers.
+	scd.setErr(errors.New("connection refused"))
+	requireStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus("scd", healthpb.HealthCheckResponse_NOT_SERVING)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, status("rid"))
+	scd.setErr(nil)
+	requireStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	cancel()
+	<-done
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, status(""))
+}
+
+func TestHealthServedWithoutAccessToken(t *testing.T) {
+	_, address := startAuxOnlyServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+	require.Eventually(t, func() bool {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+		return resp.GetStatus() == healthpb.HealthCheckResponse_SERVING
+	}, 10*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"net"
+
+	"github.com/interuss/stacktrace"
+)
+
+// listen announces on the TCP "address". If "backlog" is positive, the accept
+// queue of the resulting listener is resized to hold "backlog" pending
+// connections; otherwise the OS default applies.
+//
+// The standard library derives the backlog from the OS configuration when it
+// calls listen(2) and offers no way of overriding it, so the backlog is
+// adjusted by calling listen(2) again on the already-listening socket.
+func listen(address string, backlog int) (net.Listener, error) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error attempting to listen at %s", address)
+	}
+	if backlog <= 0 {
+		return l, nil
+	}
+
+	if err := setBacklog(l.(*net.TCPListener), backlog); err != nil {
+		l.Close()
+		return nil, stacktrace.Propagate(err, "Error setting listen backlog to %d", backlog)
+	}
+	return l, nil
+}
@@ -0,0 +1,14 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package main
+
+import (
+	"net"
+
+	"github.com/interuss/stacktrace"
+)
+
+func setBacklog(l *net.TCPListener, backlog int) error {
+	return stacktrace.NewError("Configuring the listen backlog is not supported on this platform")
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// countAcceptedWithoutAccepting dials "address" "attempts" times without the
+// listener ever accepting, and returns the number of connections the kernel
+// completed on its behalf.
+func countAcceptedWithoutAccepting(t *testing.T, address string, attempts int) int {
+	var (
+		established int
+		conns       []net.Conn
+	)
+	defer func() {
+		for _, c := range conns {
+			c.Close()
+		}
+	}()
+	for i := 0; i < attempts; i++ {
+		c, err := net.DialTimeout("tcp", address, 200*time.Millisecond)
+		if err != nil {
+			continue
+		}
+		conns = append(conns, c)
+		established++
+	}
+	return established
+}
+
+func TestListenAppliesBacklog(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("accept queue overflow behavior is only asserted on Linux")
+	}
+	const attempts = 16
+
+	l, err := listen("localhost:0", 1)
+	require.NoError(t, err)
+	defer l.Close()
+
+	// Linux queues up to backlog+1 fully established connections and drops
+	// further SYNs until the queue drains.
+	require.LessOrEqual(t, countAcceptedWithoutAccepting(t, l.Addr().String(), attempts), 2)
+}
+
+func TestListenDefaultsToOSBacklog(t *testing.T) {
+	const attempts = 16
+
+	l, err := listen("localhost:0", 0)
+	require.NoError(t, err)
+	defer l.Close()
+
+	require.Equal(t, attempts, countAcceptedWithoutAccepting(t, l.Addr().String(), attempts))
+}
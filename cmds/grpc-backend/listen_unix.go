@@ -0,0 +1,26 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package main
+
+import (
+	"net"
+	"syscall"
+
+	"github.com/interuss/stacktrace"
+)
+
+func setBacklog(l *net.TCPListener, backlog int) error {
+	rc, err := l.SyscallConn()
+	if err != nil {
+		return stacktrace.Propagate(err, "Error accessing listener socket")
+	}
+
+	var listenErr error
+	if err := rc.Control(func(fd uintptr) {
+		listenErr = syscall.Listen(int(fd), backlog)
+	}); err != nil {
+		return stacktrace.Propagate(err, "Error controlling listener socket")
+	}
+	return listenErr
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
+	"math"
 	"net"
 	"net/url"
 	"os"
 	"os/signal"
+	"sort"
 	"strings"
 	"syscall"
 	"time"
@@ -15,6 +18,7 @@ import (
 	"cloud.google.com/go/profiler"
 	"github.com/interuss/dss/pkg/api/v1/auxpb"
 	"github.com/interuss/dss/pkg/api/v1/ridpb"
+	"github.com/interuss/dss/pkg/api/v1/ridv22apb"
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
 	"github.com/interuss/dss/pkg/auth"
 	aux "github.com/interuss/dss/pkg/aux_"
@@ -22,39 +26,143 @@ import (
 	"github.com/interuss/dss/pkg/cockroach"
 	"github.com/interuss/dss/pkg/cockroach/flags" // Force command line flag registration
 	uss_errors "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/gc"
+	"github.com/interuss/dss/pkg/geo"
 	"github.com/interuss/dss/pkg/logging"
+	"github.com/interuss/dss/pkg/metrics"
+	"github.com/interuss/dss/pkg/quota"
+	"github.com/interuss/dss/pkg/ratelimit"
 	application "github.com/interuss/dss/pkg/rid/application"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	rid "github.com/interuss/dss/pkg/rid/server"
 	ridc "github.com/interuss/dss/pkg/rid/store/cockroach"
 	"github.com/interuss/dss/pkg/scd"
 	scdc "github.com/interuss/dss/pkg/scd/store/cockroach"
 	"github.com/interuss/dss/pkg/validations"
+	"github.com/interuss/dss/pkg/version"
 	"github.com/interuss/stacktrace"
 
+	"github.com/coreos/go-semver/semver"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 var (
 	address           = flag.String("addr", ":8081", "address")
-	pkFile            = flag.String("public_key_files", "", "Path to public Keys to use for JWT decoding, separated by commas.")
-	jwksEndpoint      = flag.String("jwks_endpoint", "", "URL pointing to an endpoint serving JWKS")
+	listenBacklog     = flag.Int("listen_backlog", 0, "Maximum number of pending connections queued on the listening socket; 0 uses the OS default")
+	globalRateLimit   = flag.Float64("global_rate_limit", 0, "Maximum requests per second served across all clients, beyond which requests fail with ResourceExhausted; health checks are exempt and 0 means no limit")
+	globalRateBurst   = flag.Int("global_rate_burst", 0, "Number of requests beyond --global_rate_limit that may be served in a burst; 0 uses the rate rounded up")
+	tlsCertFile       = flag.String("tls_cert_file", "", "Path to the PEM-encoded certificate with which TLS is terminated on the gRPC listener, along with --tls_key_file; plaintext is served if neither is set")
+	tlsKeyFile        = flag.String("tls_key_file", "", "Path to the PEM-encoded private key of --tls_cert_file")
+	tlsClientCA       = flag.String("tls_client_ca", "", "Path to PEM-encoded CA certificates against which client certificates are verified, requiring every client to present one; requires --tls_cert_file and --tls_key_file")
+	tlsNoTickets      = flag.Bool("tls_disable_session_tickets", false, "Disable TLS session resumption with session tickets on the gRPC listener, for forward secrecy; requires --tls_cert_file and --tls_key_file")
+	requireTLS        = flag.Bool("require_tls", false, "Fail startup unless TLS is terminated on the gRPC listener with --tls_cert_file and --tls_key_file, rather than serving plaintext")
+	maxStreams        = flag.Uint("max_concurrent_streams", 100, "Maximum number of concurrent streams a single client connection may open; further streams are refused and 0 means no limit")
+	pkFile            = flag.String("public_key_files", "", "Path to public Keys to use for JWT decoding, separated by commas. Directories are watched for keys being added, changed or removed, and reloaded at every refresh of keys (see --jwks_refresh_interval).")
+	jwksEndpoint      = flag.String("jwks_endpoint", "", "URLs pointing to endpoints serving JWKS, separated by commas; keys with the same ID are taken from the first endpoint listed, and an endpoint that cannot be fetched contributes the keys last fetched from it, subject to --max_key_staleness")
 	jwksKeyIDs        = flag.String("jwks_key_ids", "", "IDs of a set of key in a JWKS, separated by commas")
-	keyRefreshTimeout = flag.Duration("key_refresh_timeout", 1*time.Minute, "Timeout for refreshing keys for JWT verification")
+	keyRefreshTimeout = flag.Duration("key_refresh_timeout", 1*time.Minute, "Timeout for each fetch of keys for JWT verification, also used as the refresh interval if --jwks_refresh_interval is not set")
+	jwksRefresh       = flag.Duration("jwks_refresh_interval", 0, "How often keys for JWT verification are proactively refreshed; 0 uses --key_refresh_timeout")
+	keyRetry          = flag.Duration("key_retry_interval", auth.DefaultKeyRetryInterval, "Delay before retrying a failed refresh of keys for JWT verification, doubled after each failure up to the refresh interval; the previous keys remain in use meanwhile")
+	maxKeyStaleness   = flag.Duration("max_key_staleness", 0, "How long keys for JWT verification remain in use after the last successful refresh, after which every request is rejected; 0 keeps them in use indefinitely")
+	maxKeyRefreshes   = flag.Int("max_key_refreshes", 0, "Largest number of concurrent refreshes of keys for JWT verification triggered by access tokens with a kid that no key verifies, coalesced by kid; 0 only refreshes keys every --jwks_refresh_interval")
+	minKeyRefreshGap  = flag.Duration("min_key_refresh_gap", auth.DefaultMinKeyRefreshGap, "Least time between the starts of refreshes of keys triggered by access tokens with a kid that no key verifies, when --max_key_refreshes is set; tokens arriving sooner are verified with the keys already known")
+	jwtAlgorithms     = flag.String("jwt_algorithms", "", "Signing algorithms of the JWTs accepted, such as ES256, separated by commas; if empty, any algorithm verifiable with the configured keys is accepted")
+	jwtClockSkew      = flag.Duration("jwt_clock_skew", 0, "How far past their exp claim, or before their nbf or iat claim, JWTs are still accepted, to tolerate clients whose clocks are off; 0 validates them strictly")
+	scopesIgnoreCase  = flag.Bool("case_insensitive_scopes", false, "Accept claimed scopes differing from the required ones only by case, as a compatibility shim for identity providers inconsistent in their casing; every required scope must still be claimed")
+	tokenCacheSize    = flag.Int("token_cache_size", 0, "Number of verified JWTs whose signature and claims are not verified again when presented within --token_cache_ttl; 0 disables caching")
+	tokenCacheTTL     = flag.Duration("token_cache_ttl", 30*time.Second, "How long a verified JWT is cached, at most until it expires")
+	introspectURL     = flag.String("introspection_endpoint", "", "URL of an RFC 7662 endpoint introspecting opaque access tokens, used instead of --public_key_files or --jwks_endpoint")
+	introspectID      = flag.String("introspection_client_id", "", "Client ID authenticating requests to --introspection_endpoint")
+	introspectSecret  = flag.String("introspection_client_secret_file", "", "Path to a file holding the client secret authenticating requests to --introspection_endpoint")
+	introspectCache   = flag.Int("introspection_cache_size", 1000, "Number of active access tokens whose introspection is reused until they expire; 0 introspects every request")
+	jwksMaxBytes      = flag.Int64("jwks_max_bytes", auth.DefaultMaxJWKSBytes, "Largest JWKS response accepted from --jwks_endpoint, in bytes; larger responses are rejected")
+	jwksEndpointEvery = flag.Duration("jwks_endpoint_refresh_interval", 0, "How long the keys fetched from each of --jwks_endpoint are used before that endpoint is fetched again, timed separately for each endpoint; 0 fetches every endpoint at every refresh of keys")
+	jwksAllowEmpty    = flag.Bool("jwks_allow_empty", false, "Accept JWKS responses from --jwks_endpoint without any key, rejecting every access token while no endpoint serves keys; otherwise an empty JWKS is a failure to fetch its endpoint, whose keys last fetched remain in use, subject to --max_key_staleness")
 	timeout           = flag.Duration("server timeout", 10*time.Second, "Default timeout for server calls")
+	shutdownTimeout   = flag.Duration("shutdown_timeout", 25*time.Second, "How long pending RPCs may take to finish once the server is shutting down, after which their connections are forcibly closed; 0 waits for them indefinitely")
+	requireDeadline   = flag.Bool("require_deadline", false, "Reject requests that do not set a deadline with InvalidArgument, rather than only bounding them by the server default timeout; health checks are exempt")
+	contentTypes      = flag.String("content_types", "", "Content types of the requests served, such as application/grpc+proto, separated by commas; requests of any other content type are rejected with InvalidArgument, and requests of any gRPC content type are served if empty")
 	reflectAPI        = flag.Bool("reflect_api", false, "Whether to reflect the API.")
+	healthInterval    = flag.Duration("health_check_interval", 10*time.Second, "How often the databases are pinged to report the health of the services they back through grpc.health.v1.Health")
+	reflectServices   = flag.String("reflect_services", "", "Services reflected when --reflect_api is set, among aux, rid and scd, separated by commas; every service is reflected if empty")
 	logFormat         = flag.String("log_format", logging.DefaultFormat, "The log format in {json, console}")
 	logLevel          = flag.String("log_level", logging.DefaultLevel.String(), "The log level")
+	logLevelRID       = flag.String("log_level_rid", "", "The log level of the remote ID service; defaults to --log_level")
+	logLevelSCD       = flag.String("log_level_scd", "", "The log level of the strategic conflict detection service; defaults to --log_level")
 	dumpRequests      = flag.Bool("dump_requests", false, "Log request and response protos")
+	logSampleInitial  = flag.Int("log_sample_initial", 0, "Number of identical request logs per second to emit before sampling; 0 disables sampling")
+	logSampleEvery    = flag.Int("log_sample_thereafter", 100, "Once sampling, emit every Nth identical request log per second; only request logs at error level, such as those of internal errors, are always emitted")
+	logStackFrames    = flag.Int("log_stack_frames", 0, "Maximum number of frames of an error's stacktrace to log, keeping the outermost; 0 logs every frame")
+	logEventBuffer    = flag.Int("log_event_buffer", 0, "Number of recent log events kept for administrators streaming them through the aux API, and by which each stream may fall behind before events are dropped; 0 disables log event streaming")
+	maxStreamMsgSize  = flag.Int("max_stream_message_size", 4<<20, "Size in bytes beyond which an item streamed through the aux API is not sent as is, since gRPC clients reject messages beyond 4 MiB by default along with the rest of the stream; 0 for no limit")
+	skipOversized     = flag.Bool("skip_oversized_stream_items", false, "Skip streamed items beyond --max_stream_message_size, counting them as dropped, rather than sending them without their content, flagged as oversized")
+	maxErrorDepth     = flag.Int("max_error_depth", 0, "Maximum number of wrapped errors kept in errors returned by handlers; deeper errors keep only their outermost context and root cause. Values below 2 keep every error")
+	errorHeaders      = flag.Bool("error_headers", false, "Send response headers ahead of errors, rather than responding to them with trailers only, for proxies mishandling trailers-only responses")
+	panicOnFailure    = flag.Bool("panic_on_startup_failure", false, "Log failures to start or run the server at panic level, followed by the stack trace of the panic, rather than at fatal level before exiting with status 1")
+	repanic           = flag.Bool("repanic", false, "Crash the server when a request handler panics, after logging the panic, rather than responding with an Internal error")
+	slowRequests      = flag.Duration("slow_request_threshold", 0, "Log requests taking at least this long along with the time spent authorizing, validating and querying the store; 0 does not log slow requests")
 	profServiceName   = flag.String("gcp_prof_service_name", "", "Service name for the Go profiler")
+	profVersion       = flag.String("gcp_prof_service_version", "", "Version label under which the Go profiler records profiles, such as the DSS release")
+	profMutex         = flag.Bool("gcp_prof_mutex", false, "Collect mutex contention profiles with the Go profiler")
+	profNoHeap        = flag.Bool("gcp_prof_no_heap", false, "Do not collect heap profiles with the Go profiler")
+	metricsAddr       = flag.String("metrics_addr", "", "Address at which Prometheus metrics, including those of the RPCs served, are exposed over HTTP at /metrics; metrics are not exposed if empty. They are served in plaintext even with --tls_cert_file, so the address should only be reachable by scrapers")
+	memoryLimit       = flag.String("memory_limit", "", "Soft memory limit of the process as a positive number of bytes, optionally followed by a unit in {B, KiB, MiB, GiB, TiB} such as 512MiB, beyond which garbage is collected more aggressively; overrides GOMEMLIMIT, which applies if empty")
+	runtimeMetrics    = flag.Duration("runtime_metrics_interval", 15*time.Second, "Interval between samples of the goroutine, heap and GC metrics; 0 disables them")
+	requireObs        = flag.Bool("require_observability", false, "Fail startup if the --metrics_addr listener or the runtime metrics collector cannot be set up, rather than serving without them; errors serving metrics once started are only logged")
+	enableRID         = flag.Bool("enable_rid", true, "Enables the Remote ID API")
+	enableRIDV22a     = flag.Bool("enable_rid_v22a", false, "Also serves the F3411-22a version of the Remote ID API, from the same database as the earlier version; requires --enable_rid")
 	enableSCD         = flag.Bool("enable_scd", false, "Enables the Strategic Conflict Detection API")
+	ridDBName         = flag.String("rid_db_name", ridc.DatabaseName, "Name of the database storing remote ID data")
+	scdNotifier       = flag.String("scd_notifier", scd.DefaultNotifier, "Backend delivering notifications of changes to strategic conflict detection Operations and Constraints, among those in scd.Notifiers: client leaves delivery to the client making the change, which is told the subscribers to notify, and log also logs them")
+	scdDBName         = flag.String("scd_db_name", scdc.DatabaseName, "Name of the database storing strategic conflict detection data; may be the same as --rid_db_name")
+	txnAttempts       = flag.Int("transaction_attempts", cockroach.TransactionAttempts, "Number of attempts at database transactions failing with CockroachDB serialization errors, retried after a randomized exponential backoff")
+	followerReads     = flag.Bool("enable_follower_reads", false, "Serve remote ID and strategic conflict detection Get, Search and List requests from the nearest CockroachDB replica, as of follower_read_timestamp(); responses may then miss writes of the last few seconds, and ISAs cached meanwhile stay stale up to --isa_cache_ttl longer, while writes and the reads they depend on remain strongly consistent")
 	locality          = flag.String("locality", "", "self-identification string used as CRDB table writer column")
+	region            = flag.String("region", "", "Identifier of the DSS region, or pool, this instance belongs to, as reported through the aux API")
+	dbClock           = flag.Bool("db_clock", false, "Use the database's current time rather than the local clock for expiry-critical operations")
+	dbClockCache      = flag.Duration("db_clock_cache", 1*time.Second, "How long the offset to the database's clock is reused before it is read again, when --db_clock is set")
+	timesToExpiry     = flag.Bool("time_to_expiry", false, "Include in strategic conflict detection Operations, Subscriptions and Constraints the time_to_expiry remaining until they end, as computed from the server's clock, which is the database's when --db_clock is set; remote ID entities do not carry it")
+	gcShedding        = flag.Bool("gc_load_shedding", false, "Reject non-critical reads with Unavailable while a garbage collection batch runs")
+	gcShedRetryAfter  = flag.Duration("gc_shed_retry_after", 5*time.Second, "Retry delay suggested to clients whose reads are rejected during garbage collection")
+	gcWindowMax       = flag.Duration("gc_window_max", 1*time.Minute, "Maximum time reads are rejected for after a garbage collection batch begins")
+	gcInterval        = flag.Duration("gc_interval", 0, "Interval between scheduled garbage collection passes over expired remote ID entities and strategic conflict detection Operations; 0 only collects remote ID entities when triggered through the aux API")
+	gcGracePeriod     = flag.Duration("gc_grace_period", 0, "How long expired entities are kept after they end before garbage collection deletes them")
+	maxFutureWindow   = flag.Duration("max_future_window", 0, "Furthest in the future that remote ID ISAs and Subscriptions may start; 0 does not limit it")
+	isaCacheSize      = flag.Int("isa_cache_size", 0, "Maximum number of remote ID ISAs kept in memory after being retrieved by ID; 0 disables the cache")
+	isaCacheTTL       = flag.Duration("isa_cache_ttl", 5*time.Second, "How long a remote ID ISA retrieved by ID may be served from memory, when --isa_cache_size is set")
+	decoupleNotify    = flag.Bool("decouple_notifications", false, "Commit remote ID ISA writes before incrementing the notification indices of affected Subscriptions, retried up to --notification_attempts times, so that failing to increment them does not fail the write; indices left unincremented are reconciled with the next ISA write by the same instance, and subscribers may meanwhile see an index not change across a notification. Indices left unincremented are only kept in memory: they are lost if the instance restarts, and beyond --max_pending_notification_cells")
+	notifyAttempts    = flag.Int("notification_attempts", application.NotificationAttempts, "Number of attempts at incrementing notification indices after an ISA write, when --decouple_notifications is set")
+	maxPendingNotify  = flag.Int("max_pending_notification_cells", application.MaxPendingNotificationCells, "Largest number of cells whose notification indices are left to increment after failing to, when --decouple_notifications is set; failures in further cells are logged and not reconciled")
+	quotas            = flag.String("quotas", "", "Maximum number of active resources each owner may hold, as comma-separated resource=limit pairs with resources in {isas, subscriptions, operations}, such as isas=100; resources not listed are not limited")
+	maxStoredCells    = flag.Int("max_stored_cells", geo.DefaultMaxStoredCells, "Maximum number of S2 cells covering a stored ISA, Subscription, Operation or Constraint, beyond which it is rejected as too large; 0 does not limit it")
+	maxIntentVolumes  = flag.Int("scd_max_volumes_per_intent", 0, "Maximum number of 4D volumes in the extents of a strategic conflict detection Operation, beyond which it is rejected with InvalidArgument; 0 does not limit it")
+	maxIntentVertices = flag.Int("scd_max_vertices_per_intent", 0, "Maximum total number of polygon vertices across the 4D volumes of a strategic conflict detection Operation, beyond which it is rejected with InvalidArgument; 0 does not limit it")
+	wrapLongitudes    = flag.Bool("wrap_longitudes", false, "Accept longitudes in [-360, 360] by wrapping them across the antimeridian onto [-180, 180], instead of rejecting those outside [-180, 180]")
+	zeroAreaBuffer    = flag.Float64("zero_area_query_buffer_m", 0, "Radius in meters around each point of query areas that enclose no area, such as a single point; 0 rejects such queries")
+
+	partialSearchResults = flag.Bool("partial_search_results", false, "Respond to remote ID ISA searches that time out with the results found so far, flagged with an x-dss-partial-results header")
+	allowGlobalSearch    = flag.Bool("allow_global_search", false, "Allow clients with the admin scope to search remote ID ISAs and subscriptions without an area, covering the whole world")
+	ridPageSize          = flag.Int("rid_page_size", 0, "Number of results of remote ID ISA and subscription searches not requesting a page size with an x-dss-page-size header, beyond which the following page is designated by an x-dss-next-page-token header; 0 returns every result")
+	ridMaxPageSize       = flag.Int("rid_max_page_size", 0, "Largest page size remote ID ISA and subscription searches may request, also bounding those not requesting any; 0 means no limit")
+	maxRIDAreaKm2        = flag.Float64("max_rid_area_km2", 0, "Largest area in km² a remote ID ISA or subscription search may cover, beyond which it is rejected with InvalidArgument before querying the store; 0 does not limit it beyond the limit of every query area")
 
 	jwtAudiences = flag.String("accepted_jwt_audiences", "", "comma-separated acceptable JWT `aud` claims")
 )
 
+const (
+	// ridService and scdService name the loggers of the remote ID and
+	// strategic conflict detection services.
+	ridService = "rid"
+	scdService = "scd"
+)
+
 func connectTo(dbName string) (*cockroach.DB, error) {
 	connectParameters := flags.ConnectParameters()
 	connectParameters.DBName = dbName
@@ -67,63 +175,272 @@ func connectTo(dbName string) (*cockroach.DB, error) {
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error dialing CockroachDB database at %s", uri)
 	}
+	if err := db.ConfigurePool(connectParameters.Pool); err != nil {
+		db.Close()
+		return nil, stacktrace.Propagate(err, "Error configuring connections to CockroachDB database %s", dbName)
+	}
 	return db, nil
 }
 
 func createKeyResolver() (auth.KeyResolver, error) {
+	configured := 0
+	for _, f := range []string{*pkFile, *jwksEndpoint, *introspectURL} {
+		if f != "" {
+			configured++
+		}
+	}
+	if configured > 1 {
+		return nil, stacktrace.NewError("Only one of --public_key_files, --jwks_endpoint and --introspection_endpoint may be set")
+	}
+
 	switch {
+	case *introspectURL != "":
+		endpoint, err := url.Parse(*introspectURL)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error parsing introspection URL %s", *introspectURL)
+		}
+		var secret string
+		if *introspectSecret != "" {
+			bytes, err := ioutil.ReadFile(*introspectSecret)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error reading introspection client secret")
+			}
+			secret = strings.TrimSpace(string(bytes))
+		}
+		return &auth.IntrospectionResolver{
+			Endpoint:     endpoint,
+			ClientID:     *introspectID,
+			ClientSecret: secret,
+			CacheSize:    *introspectCache,
+		}, nil
 	case *pkFile != "":
 		return &auth.FromFileKeyResolver{
 			KeyFiles: strings.Split(*pkFile, ","),
 		}, nil
 	case *jwksEndpoint != "" && *jwksKeyIDs != "":
-		u, err := url.Parse(*jwksEndpoint)
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "Error parsing JWKS URL")
+		var endpoints []*url.URL
+		for _, endpoint := range strings.Split(*jwksEndpoint, ",") {
+			u, err := url.Parse(endpoint)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Error parsing JWKS URL %s", endpoint)
+			}
+			endpoints = append(endpoints, u)
 		}
 
 		return &auth.JWKSResolver{
-			Endpoint: u,
-			KeyIDs:   strings.Split(*jwksKeyIDs, ","),
+			Endpoints:        endpoints,
+			KeyIDs:           strings.Split(*jwksKeyIDs, ","),
+			MaxResponseBytes: *jwksMaxBytes,
+			AllowEmpty:       *jwksAllowEmpty,
+			RefreshInterval:  *jwksEndpointEvery,
+			MaxStaleness:     *maxKeyStaleness,
 		}, nil
 	default:
 		return nil, nil
 	}
 }
 
-func createRIDServer(ctx context.Context, locality string, logger *zap.Logger) (*rid.Server, error) {
+// startProfiler starts the Go profiler; tests replace it to avoid contacting
+// GCP.
+var startProfiler = profiler.Start
+
+// startProfilerFromFlags starts the Go profiler configured by flags, if
+// --gcp_prof_service_name is set.
+func startProfilerFromFlags() error {
+	if *profServiceName == "" {
+		return nil
+	}
+	return startProfiler(profiler.Config{
+		Service:         *profServiceName,
+		ServiceVersion:  *profVersion,
+		MutexProfiling:  *profMutex,
+		NoHeapProfiling: *profNoHeap,
+	})
+}
+
+// startMetrics starts exporting metrics to registerer, and serving them at
+// --metrics_addr until ctx is done. If the listener at --metrics_addr or the
+// runtime metrics collector cannot be set up, an error is returned when
+// --require_observability is set; otherwise a warning is logged and the DSS
+// runs without them. Errors serving metrics later on are only logged.
+func startMetrics(ctx context.Context, logger *zap.Logger, registerer prometheus.Registerer) error {
+	if err := cockroach.RegisterMetrics(registerer); err != nil {
+		return stacktrace.Propagate(err, "Failed to register database metrics")
+	}
+	if err := application.RegisterMetrics(registerer); err != nil {
+		return stacktrace.Propagate(err, "Failed to register RID notification metrics")
+	}
+
+	if *metricsAddr != "" {
+		l, err := net.Listen("tcp", *metricsAddr)
+		switch {
+		case err != nil && *requireObs:
+			return stacktrace.Propagate(err, "Failed to listen for metrics scrapes at %s", *metricsAddr)
+		case err != nil:
+			logger.Warn("operating without metrics endpoint", zap.Error(err))
+		default:
+			go func() {
+				if err := serveMetrics(ctx, logger, l, promhttp.Handler(), *shutdownTimeout); err != nil {
+					logger.Error("Metrics endpoint failed", zap.Error(err))
+				}
+			}()
+		}
+	}
+
+	if *runtimeMetrics <= 0 {
+		return nil
+	}
+
+	collector, err := metrics.NewRuntimeCollector(registerer)
+	switch {
+	case err != nil && *requireObs:
+		return stacktrace.Propagate(err, "Failed to create runtime metrics collector")
+	case err != nil:
+		logger.Warn("operating without runtime metrics", zap.Error(err))
+		return nil
+	}
+	go collector.Run(ctx, *runtimeMetrics)
+	return nil
+}
+
+func createRIDServer(ctx context.Context, locality string, logger *zap.Logger) (*rid.Server, *ridc.Store, *semver.Version, error) {
+	ridc.DatabaseName = *ridDBName
+	ridc.FollowerReads = *followerReads
 	ridCrdb, err := connectTo(ridc.DatabaseName)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to connect to remote ID database; verify your database configuration is current with https://github.com/interuss/dss/tree/master/build#upgrading-database-schemas")
+		return nil, nil, nil, stacktrace.Propagate(err, "Failed to connect to remote ID database; verify your database configuration is current with https://github.com/interuss/dss/tree/master/build#upgrading-database-schemas")
+	}
+	if *dbClock {
+		clock := cockroach.NewDBClock(ridCrdb, ridc.DefaultClock, *dbClockCache, logger)
+		ridc.DefaultClock = clock
+		application.DefaultClock = clock
 	}
 
 	ridStore, err := ridc.NewStore(ctx, ridCrdb, logger)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to create remote ID store")
+		return nil, nil, nil, stacktrace.Propagate(err, "Failed to create remote ID store")
+	}
+	schemaVersion, err := ridStore.GetVersion(ctx)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "Failed to get remote ID schema version")
+	}
+
+	ridQuotas, err := quota.Parse(*quotas)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "Invalid --quotas")
+	}
+	application.Quotas = ridQuotas
+
+	if *ridPageSize < 0 || *ridMaxPageSize < 0 {
+		return nil, nil, nil, stacktrace.NewError("--rid_page_size and --rid_max_page_size must not be negative")
+	}
+
+	var globalSearchScope auth.Scope
+	if *allowGlobalSearch {
+		globalSearchScope = aux.AdminScope
 	}
 
 	return &rid.Server{
-		App:      application.NewFromTransactor(ridStore, logger),
-		Timeout:  *timeout,
-		Locality: locality,
-	}, nil
+		App:                       application.NewFromTransactor(ridStore, logger),
+		Timeout:                   *timeout,
+		Locality:                  locality,
+		AllowPartialSearchResults: *partialSearchResults,
+		GlobalSearchScope:         globalSearchScope,
+		PageSize:                  *ridPageSize,
+		MaxPageSize:               *ridMaxPageSize,
+		MaxAreaKm2:                *maxRIDAreaKm2,
+	}, ridStore, schemaVersion, nil
 }
 
-func createSCDServer(ctx context.Context, logger *zap.Logger) (*scd.Server, error) {
+func createSCDServer(ctx context.Context, logger *zap.Logger) (*scd.Server, *semver.Version, error) {
+	notifier, err := newSCDNotifier(logger)
+	if err != nil {
+		return nil, nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	scdc.DatabaseName = *scdDBName
+	scdc.FollowerReads = *followerReads
+	scdc.TimesToExpiry = *timesToExpiry
 	scdCrdb, err := connectTo(scdc.DatabaseName)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to connect to strategic conflict detection database; verify your database configuration is current with https://github.com/interuss/dss/tree/master/build#upgrading-database-schemas")
+		return nil, nil, stacktrace.Propagate(err, "Failed to connect to strategic conflict detection database; verify your database configuration is current with https://github.com/interuss/dss/tree/master/build#upgrading-database-schemas")
+	}
+	if *dbClock {
+		clock := cockroach.NewDBClock(scdCrdb, scdc.DefaultClock, *dbClockCache, logger)
+		scdc.DefaultClock = clock
+		scd.DefaultClock = clock
 	}
 
 	scdStore, err := scdc.NewStore(ctx, scdCrdb, logger)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Failed to create strategic conflict detection store")
+		return nil, nil, stacktrace.Propagate(err, "Failed to create strategic conflict detection store")
+	}
+	schemaVersion, err := scdStore.GetVersion(ctx)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Failed to get strategic conflict detection schema version")
+	}
+
+	scdQuotas, err := quota.Parse(*quotas)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Invalid --quotas")
 	}
 
 	return &scd.Server{
-		Store:   scdStore,
-		Timeout: *timeout,
-	}, nil
+		Store:                scdStore,
+		Timeout:              *timeout,
+		Quotas:               scdQuotas,
+		MaxVolumesPerIntent:  *maxIntentVolumes,
+		MaxVerticesPerIntent: *maxIntentVertices,
+		Notifier:             notifier,
+	}, schemaVersion, nil
+}
+
+// newSCDNotifier creates the Notifier selected with --scd_notifier.
+func newSCDNotifier(logger *zap.Logger) (scd.Notifier, error) {
+	newNotifier, ok := scd.Notifiers[*scdNotifier]
+	if !ok {
+		names := make([]string, 0, len(scd.Notifiers))
+		for name := range scd.Notifiers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, stacktrace.NewError("Invalid --scd_notifier %q, not among %s", *scdNotifier, strings.Join(names, ", "))
+	}
+	notifier, err := newNotifier(logger)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create --scd_notifier %s", *scdNotifier)
+	}
+	return notifier, nil
+}
+
+// isNonCriticalRead returns true for the remote ID and strategic conflict
+// detection operations that only read data, and that may be rejected while
+// garbage collection runs.
+func isNonCriticalRead(fullMethod string) bool {
+	if !strings.HasPrefix(fullMethod, "/ridpb.") && !strings.HasPrefix(fullMethod, "/ridv22apb.") && !strings.HasPrefix(fullMethod, "/scdpb.") {
+		return false
+	}
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	return strings.HasPrefix(method, "Get") || strings.HasPrefix(method, "Search") || strings.HasPrefix(method, "Query")
+}
+
+// isHealthCheck returns true for the operations that report whether the DSS
+// is serving, which must not be rate limited.
+func isHealthCheck(fullMethod string) bool {
+	return isHealthService(fullMethod) || fullMethod == "/auxpb.DSSAuxService/GetVersion"
+}
+
+// isHealthService returns true for the operations of the gRPC health service,
+// which are served without an access token.
+func isHealthService(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/")
+}
+
+// isUnauthenticated returns true for the operations served without an access
+// token: those of the gRPC health service, and server reflection, which is
+// only registered with --reflect_api.
+func isUnauthenticated(fullMethod string) bool {
+	return isHealthService(fullMethod) || fullMethod == reflectionMethod
 }
 
 // RunGRPCServer starts the example gRPC service.
@@ -136,10 +453,26 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 		// correctly.
 		logger.Warn("missing required --accepted_jwt_audiences")
 	}
+	if *enableRIDV22a && !*enableRID {
+		return stacktrace.NewError("--enable_rid_v22a requires --enable_rid")
+	}
+
+	// Validate the TLS configuration before listening, so that the API is
+	// never exposed over plaintext by mistake.
+	creds, err := serverCredentials(tlsOptions{
+		CertFile:              *tlsCertFile,
+		KeyFile:               *tlsKeyFile,
+		ClientCAFile:          *tlsClientCA,
+		DisableSessionTickets: *tlsNoTickets,
+		Require:               *requireTLS,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "Error configuring TLS")
+	}
 
-	l, err := net.Listen("tcp", address)
+	l, err := listen(address, *listenBacklog)
 	if err != nil {
-		return stacktrace.Propagate(err, "Error attempting to listen at %s", address)
+		return stacktrace.Propagate(err, "Error creating listener")
 	}
 	// l does not need to be closed manually. Instead, the grpc Server instance owning
 	// l will close it on a graceful stop.
@@ -147,34 +480,104 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 	var (
 		ridServer *rid.Server
 		scdServer *scd.Server
-		auxServer = &aux.Server{}
+		collector = &gc.Collector{Window: &gc.Window{MaxDuration: *gcWindowMax}}
+		auxServer = &aux.Server{
+			GC:                   collector,
+			Region:               *region,
+			Locality:             locality,
+			Flags:                flag.CommandLine,
+			SchemaVersions:       map[string]*semver.Version{},
+			Pools:                map[string]aux.PoolStatser{},
+			MaxStreamMessageSize: *maxStreamMsgSize,
+			SkipOversized:        *skipOversized,
+		}
+		// Schema versions of the databases serving each API, by gRPC package.
+		schemaVersions = map[string]*semver.Version{}
+		// Stores whose connectivity determines the health of each service.
+		healthStores = map[string]pinger{}
 	)
 
-	// Initialize remote ID
-	server, err := createRIDServer(ctx, locality, logger)
-	if err != nil {
-		return stacktrace.Propagate(err, "Failed to create remote ID server")
+	if *logEventBuffer > 0 {
+		logging.Events.SetCapacity(*logEventBuffer)
+		auxServer.LogEvents = logging.Events
 	}
-	ridServer = server
 
-	scopesValidators := auth.MergeOperationsAndScopesValidators(
-		ridServer.AuthScopes(), auxServer.AuthScopes(),
-	)
+	scopesValidators := auxServer.AuthScopes()
+
+	// Initialize remote ID
+	if *enableRID {
+		server, store, schemaVersion, err := createRIDServer(ctx, locality, logging.ForService(ridService))
+		if err != nil {
+			return stacktrace.Propagate(err, "Failed to create remote ID server")
+		}
+		ridServer = server
+		healthStores["ridpb.DiscoveryAndSynchronizationService"] = store
+		schemaVersions["/ridpb."] = schemaVersion
+		auxServer.SchemaVersions["rid"] = schemaVersion
+		auxServer.Pools["rid"] = store
+		auxServer.RIDApp = ridServer.App
+
+		if *gcInterval > 0 {
+			go collector.RunEvery(ctx, *gcInterval, func(ctx context.Context) error {
+				isas, subs, err := ridServer.App.CollectGarbage(ctx)
+				if err != nil {
+					return stacktrace.Propagate(err, "Error collecting expired remote ID entities")
+				}
+				logger.Info("Collected expired remote ID entities",
+					zap.Int("identification_service_areas", isas), zap.Int("subscriptions", subs))
+				return nil
+			}, logger)
+		}
+
+		scopesValidators = auth.MergeOperationsAndScopesValidators(
+			scopesValidators, ridServer.AuthScopes(),
+		)
+
+		if *enableRIDV22a {
+			healthStores["ridv22apb.DiscoveryAndSynchronizationService"] = store
+			schemaVersions["/ridv22apb."] = schemaVersion
+			scopesValidators = auth.MergeOperationsAndScopesValidators(
+				scopesValidators, (&rid.V22aServer{Server: ridServer}).AuthScopes(),
+			)
+		}
+	}
 
 	// Initialize strategic conflict detection
 
 	if *enableSCD {
-		server, err := createSCDServer(ctx, logger)
+		server, schemaVersion, err := createSCDServer(ctx, logging.ForService(scdService))
 		if err != nil {
 			return stacktrace.Propagate(err, "Failed to create strategic conflict detection server")
 		}
 		scdServer = server
+		if store, ok := scdServer.Store.(pinger); ok {
+			healthStores["scdpb.UTMAPIUSSDSSAndUSSUSSService"] = store
+		}
+		schemaVersions["/scdpb."] = schemaVersion
+		auxServer.SchemaVersions["scd"] = schemaVersion
+		if store, ok := scdServer.Store.(aux.PoolStatser); ok {
+			auxServer.Pools["scd"] = store
+		}
+		auxServer.SCDStore = scdServer.Store
+
+		if *gcInterval > 0 {
+			go collector.RunEvery(ctx, *gcInterval, func(ctx context.Context) error {
+				ops, err := scdServer.CollectGarbage(ctx)
+				if err != nil {
+					return stacktrace.Propagate(err, "Error collecting expired strategic conflict detection Operations")
+				}
+				logger.Info("Collected expired strategic conflict detection entities", zap.Int("operations", ops))
+				return nil
+			}, logger)
+		}
 
 		scopesValidators = auth.MergeOperationsAndScopesValidators(
 			scopesValidators, scdServer.AuthScopes(),
 		)
 	}
 
+	auxServer.ScopesValidators = scopesValidators
+
 	// Initialize access token validation
 	keyResolver, err := createKeyResolver()
 	switch {
@@ -184,12 +587,29 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 		logger.Warn("operating without authorizing interceptor")
 	}
 
+	var algorithms []string
+	if *jwtAlgorithms != "" {
+		algorithms = strings.Split(*jwtAlgorithms, ",")
+	}
+
 	authorizer, err := auth.NewRSAAuthorizer(
 		ctx, auth.Configuration{
-			KeyResolver:       keyResolver,
-			KeyRefreshTimeout: *keyRefreshTimeout,
-			ScopesValidators:  scopesValidators,
-			AcceptedAudiences: strings.Split(*jwtAudiences, ","),
+			KeyResolver:        keyResolver,
+			KeyRefreshTimeout:  *keyRefreshTimeout,
+			KeyRefreshInterval: *jwksRefresh,
+			KeyRetryInterval:   *keyRetry,
+			MaxKeyStaleness:    *maxKeyStaleness,
+			MaxKeyRefreshes:    *maxKeyRefreshes,
+			MinKeyRefreshGap:   *minKeyRefreshGap,
+			ScopesValidators:   scopesValidators,
+			AcceptedAudiences:  strings.Split(*jwtAudiences, ","),
+			AllowedAlgorithms:  algorithms,
+			ClockSkew:          *jwtClockSkew,
+			ScopesIgnoreCase:   *scopesIgnoreCase,
+			TokenCacheSize:     *tokenCacheSize,
+			TokenCacheTTL:      *tokenCacheTTL,
+			Registerer:         prometheus.DefaultRegisterer,
+			Exempt:             isUnauthenticated,
 		},
 	)
 	if err != nil {
@@ -197,17 +617,96 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 	}
 
 	// Set up server functionality
-	interceptors := []grpc.UnaryServerInterceptor{
-		uss_errors.Interceptor(logger),
-		logging.Interceptor(logger),
-		authorizer.AuthInterceptor,
-		validations.ValidationInterceptor,
+	var rpcMetrics *metrics.GRPCServerMetrics
+	if *metricsAddr != "" {
+		rpcMetrics, err = metrics.NewGRPCServerMetrics(prometheus.DefaultRegisterer)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error creating gRPC server metrics")
+		}
+	}
+
+	var interceptors []grpc.UnaryServerInterceptor
+	if rpcMetrics != nil {
+		// Metrics come first, to record the status codes errors are converted
+		// to by the rest of the chain.
+		interceptors = append(interceptors, rpcMetrics.UnaryInterceptor)
+	}
+	if *errorHeaders {
+		interceptors = append(interceptors, uss_errors.HeadersInterceptor)
+	}
+	interceptors = append(interceptors,
+		uss_errors.RecoveryInterceptor(logger, *repanic),
+		uss_errors.Interceptor(logger, *logStackFrames, *maxErrorDepth),
+		logging.Interceptor(logger, logging.SamplingConfig{
+			Initial:    *logSampleInitial,
+			Thereafter: *logSampleEvery,
+		}),
+	)
+	if *slowRequests > 0 {
+		interceptors = append(interceptors, logging.SlowRequestInterceptor(logger, *slowRequests))
+	}
+	if *globalRateLimit > 0 {
+		burst := *globalRateBurst
+		if burst <= 0 {
+			burst = int(math.Ceil(*globalRateLimit))
+		}
+		bucket := &ratelimit.TokenBucket{Rate: *globalRateLimit, Burst: burst}
+		interceptors = append(interceptors, ratelimit.Interceptor(bucket, isHealthCheck))
+	}
+	if *gcShedding {
+		interceptors = append(interceptors, gc.SheddingInterceptor(collector.Window, isNonCriticalRead, *gcShedRetryAfter))
 	}
+	var acceptedTypes []string
+	if *contentTypes != "" {
+		acceptedTypes = strings.Split(*contentTypes, ",")
+		interceptors = append(interceptors, validations.ContentTypeInterceptor(acceptedTypes))
+	}
+	if *requireDeadline {
+		interceptors = append(interceptors, validations.DeadlineInterceptor(isHealthCheck))
+	}
+	interceptors = append(interceptors,
+		logging.PhaseInterceptor("auth", authorizer.AuthInterceptor),
+		logging.PhaseInterceptor("validation", validations.ValidationInterceptor),
+		version.SchemaVersionInterceptor(schemaVersions),
+	)
 	if *dumpRequests {
 		interceptors = append(interceptors, logging.DumpRequestResponseInterceptor(logger))
 	}
 
-	s := grpc.NewServer(grpc_middleware.WithUnaryServerChain(interceptors...))
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if rpcMetrics != nil {
+		streamInterceptors = append(streamInterceptors, rpcMetrics.StreamInterceptor)
+	}
+	if *errorHeaders {
+		streamInterceptors = append(streamInterceptors, uss_errors.StreamHeadersInterceptor)
+	}
+	streamInterceptors = append(streamInterceptors,
+		uss_errors.StreamRecoveryInterceptor(logger, *repanic),
+		uss_errors.StreamInterceptor(logger, *logStackFrames, *maxErrorDepth),
+	)
+	if acceptedTypes != nil {
+		streamInterceptors = append(streamInterceptors, validations.StreamContentTypeInterceptor(acceptedTypes))
+	}
+	streamInterceptors = append(streamInterceptors, authorizer.StreamAuthInterceptor)
+	reflected, err := parseReflectedServices(*reflectServices)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error parsing --reflect_services")
+	}
+	if *reflectAPI && reflected != nil {
+		streamInterceptors = append(streamInterceptors, reflectionFilter(reflected))
+	}
+
+	serverOptions := []grpc.ServerOption{
+		grpc_middleware.WithUnaryServerChain(interceptors...),
+		grpc_middleware.WithStreamServerChain(streamInterceptors...),
+		grpc.MaxConcurrentStreams(uint32(*maxStreams)),
+	}
+	if creds != nil {
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+	}
+	logger.Info("config", zap.Bool("tls", creds != nil), zap.Bool("mtls", creds != nil && *tlsClientCA != ""))
+
+	s := grpc.NewServer(serverOptions...)
 	if err != nil {
 		return stacktrace.Propagate(err, "Error creating new gRPC server")
 	}
@@ -217,8 +716,22 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 
 	logger.Info("build", zap.Any("description", build.Describe()))
 
-	ridpb.RegisterDiscoveryAndSynchronizationServiceServer(s, ridServer)
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(s, healthServer)
+	go monitorHealth(ctx, logger, healthServer, healthStores, *healthInterval)
+
 	auxpb.RegisterDSSAuxServiceServer(s, auxServer)
+	if *enableRID {
+		logger.Info("config", zap.Any("rid", "enabled"))
+		ridpb.RegisterDiscoveryAndSynchronizationServiceServer(s, ridServer)
+		if *enableRIDV22a {
+			logger.Info("config", zap.Any("rid_v22a", "enabled"))
+			ridv22apb.RegisterDiscoveryAndSynchronizationServiceServer(s, &rid.V22aServer{Server: ridServer})
+		}
+	} else {
+		logger.Info("config", zap.Any("rid", "disabled"))
+	}
 	if *enableSCD {
 		logger.Info("config", zap.Any("scd", "enabled"))
 		scdpb.RegisterUTMAPIUSSDSSAndUSSUSSServiceServer(s, scdServer)
@@ -231,7 +744,7 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 	defer signal.Stop(signals)
 
 	go func() {
-		defer s.GracefulStop()
+		defer stopWithin(logger, s, *shutdownTimeout)
 
 		for {
 			select {
@@ -247,10 +760,30 @@ func RunGRPCServer(ctx context.Context, ctxCanceler func(), address string, loca
 	return s.Serve(l)
 }
 
+// startupFailureLogger returns the function with which main logs a failure to
+// start or run the server, and then ends the process: logger.Panic, followed
+// by the stack trace of the panic, if panics, or logger.Fatal, exiting with
+// status 1, otherwise.
+func startupFailureLogger(logger *zap.Logger, panics bool) func(msg string, fields ...zap.Field) {
+	if panics {
+		return logger.Panic
+	}
+	return logger.Fatal
+}
+
 func main() {
 	flag.Parse()
 
-	if err := logging.Configure(*logLevel, *logFormat); err != nil {
+	serviceLevels := map[string]string{}
+	for service, level := range map[string]string{
+		ridService: *logLevelRID,
+		scdService: *logLevelSCD,
+	} {
+		if level != "" {
+			serviceLevels[service] = level
+		}
+	}
+	if err := logging.Configure(*logLevel, *logFormat, serviceLevels); err != nil {
 		panic(fmt.Sprintf("Failed to configure logging: %s", err.Error()))
 	}
 
@@ -259,17 +792,35 @@ func main() {
 		logger      = logging.WithValuesFromContext(ctx, logging.Logger)
 	)
 	defer cancel()
+	fail := startupFailureLogger(logger, *panicOnFailure)
 
-	if *profServiceName != "" {
-		if err := profiler.Start(profiler.Config{
-			Service: *profServiceName,
-		}); err != nil {
-			logger.Panic("Failed to start the profiler ", zap.Error(err))
-		}
+	if err := applyMemoryLimit(runtimeMemoryLimiter{}, *memoryLimit); err != nil {
+		fail("Failed to apply --memory_limit", zap.Error(err))
+	}
+
+	geo.ZeroAreaQueryBufferMeters = *zeroAreaBuffer
+	geo.WrapLongitudes = *wrapLongitudes
+	geo.MaxStoredCells = *maxStoredCells
+	ridmodels.MaxFutureWindow = *maxFutureWindow
+	application.ISACacheSize = *isaCacheSize
+	application.ISACacheTTL = *isaCacheTTL
+	application.DecoupleNotifications = *decoupleNotify
+	application.NotificationAttempts = *notifyAttempts
+	application.MaxPendingNotificationCells = *maxPendingNotify
+	application.GCGracePeriod = *gcGracePeriod
+	scd.GCGracePeriod = *gcGracePeriod
+	cockroach.TransactionAttempts = *txnAttempts
+
+	if err := startProfilerFromFlags(); err != nil {
+		fail("Failed to start the profiler ", zap.Error(err))
+	}
+
+	if err := startMetrics(ctx, logger, prometheus.DefaultRegisterer); err != nil {
+		fail("Failed to start metrics", zap.Error(err))
 	}
 
 	if err := RunGRPCServer(ctx, cancel, *address, *locality); err != nil {
-		logger.Panic("Failed to execute service", zap.Error(err))
+		fail("Failed to execute service", zap.Error(err))
 	}
 
 	logger.Info("Shutting down gracefully")
@@ -0,0 +1,371 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"flag"
+	"io/ioutil"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/profiler"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/api/v1/ridpb"
+	"github.com/interuss/dss/pkg/scd"
+	"github.com/interuss/dss/pkg/version"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// setUpTestKey writes the public half of a freshly generated RSA key to a
+// temporary file and returns the private key along with the file name.
+func setUpTestKey(t *testing.T) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	f, err := ioutil.TempFile("", "grpc-backend-test-*.pem")
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, pem.Encode(f, &pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	return key, f.Name()
+}
+
+func freeAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func setFlag(t *testing.T, name, value string) {
+	previous := flag.Lookup(name).Value.String()
+	require.NoError(t, flag.Set(name, value))
+	t.Cleanup(func() {
+		require.NoError(t, flag.Set(name, previous))
+	})
+}
+
+// startAuxOnlyServer runs RunGRPCServer with only the aux API enabled and
+// returns the key accepted for access tokens along with the server's address
+// once it is listening.
+// The server is stopped, and required to shut down cleanly, when the test
+// completes.
+func startAuxOnlyServer(t *testing.T) (*rsa.PrivateKey, string) {
+	key, keyFile := setUpTestKey(t)
+	t.Cleanup(func() { os.Remove(keyFile) })
+
+	setFlag(t, "public_key_files", keyFile)
+	setFlag(t, "accepted_jwt_audiences", "localhost")
+	setFlag(t, "enable_rid", "false")
+	setFlag(t, "enable_scd", "false")
+
+	var (
+		address     = freeAddress(t)
+		ctx, cancel = context.WithCancel(context.Background())
+		done        = make(chan error, 1)
+	)
+
+	go func() {
+		done <- RunGRPCServer(ctx, cancel, address, "")
+	}()
+	t.Cleanup(func() {
+		cancel()
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("server did not stop after its context was canceled")
+		}
+	})
+
+	// Wait for the server to start listening.
+	for deadline := time.Now().Add(10 * time.Second); ; {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			conn.Close()
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server did not start listening on %s: %s", address, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return key, address
+}
+
+func TestAuxOnlyServerServesVersion(t *testing.T) {
+	key, address := startAuxOnlyServer(t)
+	ctx := context.Background()
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dialCancel()
+	conn, err := grpc.DialContext(dialCtx, address, grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub": "uss",
+		"iss": "test",
+		"aud": "localhost",
+		"exp": time.Now().Add(10 * time.Minute).Unix(),
+	}).SignedString(key)
+	require.NoError(t, err)
+	callCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+
+	resp, err := auxpb.NewDSSAuxServiceClient(conn).GetVersion(callCtx, &auxpb.GetVersionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, version.Current().String(), resp.GetVersion().GetAsString())
+
+	// Remote ID must not be served by an aux-only node.
+	_, err = ridpb.NewDiscoveryAndSynchronizationServiceClient(conn).GetSubscription(
+		callCtx, &ridpb.GetSubscriptionRequest{Id: "4348c8e5-0b1c-43cf-9114-2e67a4532765"})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestAuxServerReportsRegionWithoutScopes(t *testing.T) {
+	setFlag(t, "region", "us-west")
+	key, address := startAuxOnlyServer(t)
+	ctx := context.Background()
+
+	dialCtx, dialCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer dialCancel()
+	conn, err := grpc.DialContext(dialCtx, address, grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The token claims no scopes at all.
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub": "uss",
+		"iss": "test",
+		"aud": "localhost",
+		"exp": time.Now().Add(10 * time.Minute).Unix(),
+	}).SignedString(key)
+	require.NoError(t, err)
+	callCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+
+	resp, err := auxpb.NewDSSAuxServiceClient(conn).GetRegion(callCtx, &auxpb.GetRegionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "us-west", resp.GetRegion())
+	require.Equal(t, "", resp.GetLocality())
+}
+
+func TestMaxConcurrentStreamsRefusesExcess(t *testing.T) {
+	const maxStreams = 2
+	setFlag(t, "max_concurrent_streams", strconv.Itoa(maxStreams))
+	_, address := startAuxOnlyServer(t)
+
+	// A gRPC client would queue streams beyond the advertised limit, so speak
+	// HTTP/2 directly to observe how the server treats a misbehaving client.
+	conn, err := net.DialTimeout("tcp", address, 10*time.Second)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+
+	_, err = conn.Write([]byte(http2.ClientPreface))
+	require.NoError(t, err)
+	framer := http2.NewFramer(conn, conn)
+	require.NoError(t, framer.WriteSettings())
+
+	var headers bytes.Buffer
+	encoder := hpack.NewEncoder(&headers)
+	for _, f := range []hpack.HeaderField{
+		{Name: ":method", Value: "POST"},
+		{Name: ":scheme", Value: "http"},
+		{Name: ":path", Value: "/auxpb.DSSAuxService/GetVersion"},
+		{Name: ":authority", Value: address},
+		{Name: "content-type", Value: "application/grpc"},
+		{Name: "te", Value: "trailers"},
+	} {
+		require.NoError(t, encoder.WriteField(f))
+	}
+
+	// Leave every stream open by never ending it, so that all of them count
+	// against the limit at once.
+	const streams = maxStreams + 3
+	for i := 0; i < streams; i++ {
+		require.NoError(t, framer.WriteHeaders(http2.HeadersFrameParam{
+			StreamID:      uint32(2*i + 1),
+			BlockFragment: headers.Bytes(),
+			EndHeaders:    true,
+		}))
+	}
+
+	refused := map[uint32]bool{}
+	for len(refused) < streams-maxStreams {
+		frame, err := framer.ReadFrame()
+		require.NoError(t, err)
+		switch frame := frame.(type) {
+		case *http2.SettingsFrame:
+			if !frame.IsAck() {
+				require.NoError(t, framer.WriteSettingsAck())
+			}
+		case *http2.RSTStreamFrame:
+			require.Equal(t, http2.ErrCodeRefusedStream, frame.ErrCode)
+			refused[frame.StreamID] = true
+		}
+	}
+	expected := map[uint32]bool{}
+	for i := maxStreams; i < streams; i++ {
+		expected[uint32(2*i+1)] = true
+	}
+	require.Equal(t, expected, refused)
+}
+
+func TestIsNonCriticalRead(t *testing.T) {
+	for method, expected := range map[string]bool{
+		"/ridpb.DiscoveryAndSynchronizationService/SearchIdentificationServiceAreas": true,
+		"/ridpb.DiscoveryAndSynchronizationService/GetSubscription":                  true,
+		"/ridv22apb.DiscoveryAndSynchronizationService/GetIdentificationServiceArea": true,
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QueryConstraintReferences":              true,
+		"/ridpb.DiscoveryAndSynchronizationService/CreateSubscription":               false,
+		"/ridv22apb.DiscoveryAndSynchronizationService/CreateSubscription":           false,
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/PutOperationReference":                  false,
+		"/auxpb.DSSAuxService/GetVersion":                                            false,
+	} {
+		require.Equal(t, expected, isNonCriticalRead(method), method)
+	}
+}
+
+func TestIsHealthCheck(t *testing.T) {
+	require.True(t, isHealthCheck("/grpc.health.v1.Health/Check"))
+	require.True(t, isHealthCheck("/auxpb.DSSAuxService/GetVersion"))
+	require.False(t, isHealthCheck("/auxpb.DSSAuxService/ValidateOauth"))
+	require.False(t, isHealthCheck("/ridpb.DiscoveryAndSynchronizationService/SearchIdentificationServiceAreas"))
+}
+
+func TestRIDV22aRequiresRID(t *testing.T) {
+	setFlag(t, "enable_rid", "false")
+	setFlag(t, "enable_rid_v22a", "true")
+	require.Error(t, RunGRPCServer(context.Background(), func() {}, freeAddress(t), "test"))
+}
+
+type queueNotifier struct{}
+
+func (queueNotifier) Notify(context.Context, scd.EntityChange) error {
+	return nil
+}
+
+func TestSCDNotifierSelectedByFlag(t *testing.T) {
+	scd.Notifiers["queue"] = func(*zap.Logger) (scd.Notifier, error) {
+		return queueNotifier{}, nil
+	}
+	defer delete(scd.Notifiers, "queue")
+
+	notifier, err := newSCDNotifier(zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, scd.ClientNotifier{}, notifier)
+
+	setFlag(t, "scd_notifier", "queue")
+	notifier, err = newSCDNotifier(zap.NewNop())
+	require.NoError(t, err)
+	require.Equal(t, queueNotifier{}, notifier)
+
+	// The SCD server is not set up, and its database not connected to, with
+	// an unknown Notifier.
+	setFlag(t, "scd_notifier", "carrier_pigeon")
+	_, _, err = createSCDServer(context.Background(), zap.NewNop())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "--scd_notifier")
+}
+
+func TestStartMetricsFailsOnlyWhenObservabilityRequired(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Registering the runtime gauges twice makes the second registration fail.
+	registry := prometheus.NewRegistry()
+	require.NoError(t, startMetrics(ctx, zap.NewNop(), registry))
+
+	require.NoError(t, startMetrics(ctx, zap.NewNop(), registry))
+
+	setFlag(t, "require_observability", "true")
+	require.Error(t, startMetrics(ctx, zap.NewNop(), registry))
+}
+
+func TestStartMetricsFailsOnUnavailableAddressOnlyWhenObservabilityRequired(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer l.Close()
+	setFlag(t, "metrics_addr", l.Addr().String())
+
+	require.NoError(t, startMetrics(ctx, zap.NewNop(), prometheus.NewRegistry()))
+
+	setFlag(t, "require_observability", "true")
+	require.Error(t, startMetrics(ctx, zap.NewNop(), prometheus.NewRegistry()))
+}
+
+func TestProfilerConfiguredFromFlags(t *testing.T) {
+	var started []profiler.Config
+	defer func(f func(profiler.Config, ...option.ClientOption) error) { startProfiler = f }(startProfiler)
+	startProfiler = func(config profiler.Config, _ ...option.ClientOption) error {
+		started = append(started, config)
+		return nil
+	}
+
+	// The profiler is only started for a service.
+	require.NoError(t, startProfilerFromFlags())
+	require.Empty(t, started)
+
+	setFlag(t, "gcp_prof_service_name", "dss-backend")
+	setFlag(t, "gcp_prof_service_version", "v0.9.0")
+	setFlag(t, "gcp_prof_mutex", "true")
+	setFlag(t, "gcp_prof_no_heap", "true")
+	require.NoError(t, startProfilerFromFlags())
+	require.Equal(t, []profiler.Config{{
+		Service:         "dss-backend",
+		ServiceVersion:  "v0.9.0",
+		MutexProfiling:  true,
+		NoHeapProfiling: true,
+	}}, started)
+}
+
+func TestStartupFailureLogger(t *testing.T) {
+	var (
+		core, logs = observer.New(zap.InfoLevel)
+		// Hooks run before the logger panics or exits, so exiting the
+		// goroutine logging the failure leaves the test running.
+		logger = zap.New(core, zap.Hooks(func(zapcore.Entry) error {
+			runtime.Goexit()
+			return nil
+		}))
+		failWith = func(panics bool) zapcore.Level {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				startupFailureLogger(logger, panics)("Failed to execute service", zap.Error(os.ErrClosed))
+			}()
+			<-done
+			entries := logs.TakeAll()
+			require.Len(t, entries, 1)
+			return entries[0].Level
+		}
+	)
+
+	require.Equal(t, zapcore.FatalLevel, failWith(false))
+	require.Equal(t, zapcore.PanicLevel, failWith(true))
+}
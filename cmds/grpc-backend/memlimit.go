@@ -0,0 +1,64 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/interuss/stacktrace"
+)
+
+// memoryLimiter sets the soft memory limit of the Go runtime, in bytes.
+type memoryLimiter interface {
+	SetMemoryLimit(limit int64) error
+}
+
+// byteUnits are the units accepted by parseByteCount, as in GOMEMLIMIT.
+var byteUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"KiB", 1 << 10},
+	{"MiB", 1 << 20},
+	{"GiB", 1 << 30},
+	{"TiB", 1 << 40},
+	{"B", 1},
+}
+
+// parseByteCount parses a positive number of bytes, optionally followed by
+// one of the units B, KiB, MiB, GiB or TiB, such as 512MiB.
+func parseByteCount(s string) (int64, error) {
+	var (
+		number = s
+		unit   = int64(1)
+	)
+	for _, u := range byteUnits {
+		if strings.HasSuffix(s, u.suffix) {
+			number, unit = strings.TrimSuffix(s, u.suffix), u.bytes
+			break
+		}
+	}
+	n, err := strconv.ParseInt(number, 10, 64)
+	switch {
+	case err != nil:
+		return 0, stacktrace.NewError("%q is not a number of bytes, such as 512MiB", s)
+	case n <= 0:
+		return 0, stacktrace.NewError("Number of bytes %q is not positive", s)
+	case n > math.MaxInt64/unit:
+		return 0, stacktrace.NewError("Number of bytes %q is too large", s)
+	}
+	return n * unit, nil
+}
+
+// applyMemoryLimit sets the soft memory limit given as a byte count by limit
+// with limiter, unless limit is empty.
+func applyMemoryLimit(limiter memoryLimiter, limit string) error {
+	if limit == "" {
+		return nil
+	}
+	bytes, err := parseByteCount(limit)
+	if err != nil {
+		return stacktrace.Propagate(err, "Invalid memory limit")
+	}
+	return stacktrace.Propagate(limiter.SetMemoryLimit(bytes), "Error setting memory limit to %d bytes", bytes)
+}
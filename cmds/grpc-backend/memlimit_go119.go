@@ -0,0 +1,14 @@
+//go:build go1.19
+// +build go1.19
+
+package main
+
+import "runtime/debug"
+
+// runtimeMemoryLimiter sets the soft memory limit of this process.
+type runtimeMemoryLimiter struct{}
+
+func (runtimeMemoryLimiter) SetMemoryLimit(limit int64) error {
+	debug.SetMemoryLimit(limit)
+	return nil
+}
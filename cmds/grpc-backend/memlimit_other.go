@@ -0,0 +1,18 @@
+//go:build !go1.19
+// +build !go1.19
+
+package main
+
+import (
+	"runtime"
+
+	"github.com/interuss/stacktrace"
+)
+
+// runtimeMemoryLimiter rejects memory limits, which the Go runtime only
+// supports from Go 1.19.
+type runtimeMemoryLimiter struct{}
+
+func (runtimeMemoryLimiter) SetMemoryLimit(limit int64) error {
+	return stacktrace.NewError("Memory limits require a DSS built with Go 1.19 or later, not %s", runtime.Version())
+}
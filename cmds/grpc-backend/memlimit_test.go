@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// recordingMemoryLimiter records the memory limit set, or fails with err.
+type recordingMemoryLimiter struct {
+	limit int64
+	err   error
+}
+
+func (l *recordingMemoryLimiter) SetMemoryLimit(limit int64) error {
+	l.limit = limit
+	return l.err
+}
+
+func TestApplyMemoryLimit(t *testing.T) {
+	for limit, bytes := range map[string]int64{
+		"1048576": 1 << 20,
+		"4096B":   4096,
+		"512MiB":  512 << 20,
+		"2GiB":    2 << 30,
+	} {
+		limiter := &recordingMemoryLimiter{}
+		require.NoError(t, applyMemoryLimit(limiter, limit))
+		require.Equal(t, bytes, limiter.limit, limit)
+	}
+
+	// No limit is set unless one is given.
+	limiter := &recordingMemoryLimiter{}
+	require.NoError(t, applyMemoryLimit(limiter, ""))
+	require.Zero(t, limiter.limit)
+
+	for _, limit := range []string{"0", "-1MiB", "MiB", "1.5GiB", "1GB", "9999999TiB"} {
+		require.Error(t, applyMemoryLimit(limiter, limit), limit)
+	}
+	require.Zero(t, limiter.limit)
+
+	require.Error(t, applyMemoryLimit(&recordingMemoryLimiter{err: errors.New("unsupported")}, "1GiB"))
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"go.uber.org/zap"
+)
+
+// serveMetrics serves handler at /metrics on l until ctx is done, and then
+// shuts down, waiting for up to timeout for scrapes in progress to finish. A
+// non-positive timeout waits for them indefinitely.
+func serveMetrics(ctx context.Context, logger *zap.Logger, l net.Listener, handler http.Handler, timeout time.Duration) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+	server := &http.Server{Handler: mux}
+
+	shutdown := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		shutdownCtx := context.Background()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			shutdownCtx, cancel = context.WithTimeout(shutdownCtx, timeout)
+			defer cancel()
+		}
+		shutdown <- server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info("serving metrics", zap.Stringer("address", l.Addr()))
+	if err := server.Serve(l); err != http.ErrServerClosed {
+		return stacktrace.Propagate(err, "Error serving metrics")
+	}
+	return stacktrace.Propagate(<-shutdown, "Error shutting down metrics server")
+}
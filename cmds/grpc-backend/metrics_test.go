@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestServeMetricsUntilContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	var (
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("dss_up 1\n")) })
+		served  = make(chan error, 1)
+	)
+	go func() { served <- serveMetrics(ctx, zap.NewNop(), l, handler, time.Second) }()
+
+	resp, err := http.Get("http://" + l.Addr().String() + "/metrics")
+	require.NoError(t, err)
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.NoError(t, err)
+	require.Equal(t, "dss_up 1\n", string(body))
+
+	resp, err = http.Get("http://" + l.Addr().String() + "/other")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	cancel()
+	select {
+	case err := <-served:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("metrics server still running after its context was done")
+	}
+	_, err = http.Get("http://" + l.Addr().String() + "/metrics")
+	require.Error(t, err)
+}
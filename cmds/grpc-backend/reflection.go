@@ -0,0 +1,105 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+const reflectionMethod = "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"
+
+// reflectableServices maps the names accepted by --reflect_services to the
+// gRPC services they designate.
+var reflectableServices = map[string]string{
+	"aux": "auxpb.DSSAuxService",
+	"rid": "ridpb.DiscoveryAndSynchronizationService",
+	"scd": "scdpb.UTMAPIUSSDSSAndUSSUSSService",
+}
+
+// parseReflectedServices returns the gRPC services designated by the
+// comma-separated names in s, or nil if s is empty.
+func parseReflectedServices(s string) (map[string]bool, error) {
+	if s == "" {
+		return nil, nil
+	}
+	services := map[string]bool{}
+	for _, name := range strings.Split(s, ",") {
+		service, ok := reflectableServices[strings.TrimSpace(name)]
+		if !ok {
+			return nil, stacktrace.NewError("Unknown service %q to reflect; expected aux, rid or scd", name)
+		}
+		services[service] = true
+	}
+	return services, nil
+}
+
+// reflectionFilter returns a stream interceptor restricting the server
+// reflection service to the DSS services in reflected. The reflection service
+// of this version of gRPC always reflects every service registered, so its
+// responses are filtered instead: other DSS services are left out of service
+// listings, and their files are reported as not found.
+func reflectionFilter(reflected map[string]bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.FullMethod != reflectionMethod {
+			return handler(srv, stream)
+		}
+		return handler(srv, &filteredReflectionStream{ServerStream: stream, reflected: reflected})
+	}
+}
+
+type filteredReflectionStream struct {
+	grpc.ServerStream
+	reflected map[string]bool
+}
+
+// hidden returns true if service is a DSS service not to be reflected.
+func (s *filteredReflectionStream) hidden(service string) bool {
+	for _, dss := range reflectableServices {
+		if service == dss {
+			return !s.reflected[service]
+		}
+	}
+	return false
+}
+
+func (s *filteredReflectionStream) SendMsg(m interface{}) error {
+	resp, ok := m.(*rpb.ServerReflectionResponse)
+	if !ok {
+		return s.ServerStream.SendMsg(m)
+	}
+
+	switch r := resp.MessageResponse.(type) {
+	case *rpb.ServerReflectionResponse_ListServicesResponse:
+		var services []*rpb.ServiceResponse
+		for _, service := range r.ListServicesResponse.GetService() {
+			if !s.hidden(service.GetName()) {
+				services = append(services, service)
+			}
+		}
+		r.ListServicesResponse.Service = services
+	case *rpb.ServerReflectionResponse_FileDescriptorResponse:
+		for _, encoded := range r.FileDescriptorResponse.GetFileDescriptorProto() {
+			fd := &dpb.FileDescriptorProto{}
+			if err := proto.Unmarshal(encoded, fd); err != nil {
+				return stacktrace.Propagate(err, "Error decoding reflected file descriptor")
+			}
+			for _, service := range fd.GetService() {
+				if s.hidden(fd.GetPackage() + "." + service.GetName()) {
+					resp.MessageResponse = &rpb.ServerReflectionResponse_ErrorResponse{
+						ErrorResponse: &rpb.ErrorResponse{
+							ErrorCode:    int32(codes.NotFound),
+							ErrorMessage: "file not found",
+						},
+					}
+					return s.ServerStream.SendMsg(resp)
+				}
+			}
+		}
+	}
+	return s.ServerStream.SendMsg(resp)
+}
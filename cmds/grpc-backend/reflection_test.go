@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/api/v1/ridpb"
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+func TestReflectionRestrictedToAuxService(t *testing.T) {
+	reflected, err := parseReflectedServices("aux")
+	require.NoError(t, err)
+	_, err = parseReflectedServices("aux,dss")
+	require.Error(t, err)
+
+	s := grpc.NewServer(grpc.StreamInterceptor(reflectionFilter(reflected)))
+	reflection.Register(s)
+	auxpb.RegisterDSSAuxServiceServer(s, &auxpb.UnimplementedDSSAuxServiceServer{})
+	ridpb.RegisterDiscoveryAndSynchronizationServiceServer(s, &ridpb.UnimplementedDiscoveryAndSynchronizationServiceServer{})
+	scdpb.RegisterUTMAPIUSSDSSAndUSSUSSServiceServer(s, &scdpb.UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer{})
+
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go s.Serve(l)
+	defer s.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, l.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	reflect := func(req *rpb.ServerReflectionRequest) *rpb.ServerReflectionResponse {
+		require.NoError(t, stream.Send(req))
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		return resp
+	}
+
+	var services []string
+	for _, service := range reflect(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}).GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	require.ElementsMatch(t, []string{"auxpb.DSSAuxService", "grpc.reflection.v1alpha.ServerReflection"}, services)
+
+	resp := reflect(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "auxpb.DSSAuxService"},
+	})
+	require.NotEmpty(t, resp.GetFileDescriptorResponse().GetFileDescriptorProto())
+
+	for _, symbol := range []string{"ridpb.DiscoveryAndSynchronizationService", "scdpb.UTMAPIUSSDSSAndUSSUSSService", "ridpb.GetSubscriptionRequest"} {
+		resp := reflect(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: symbol},
+		})
+		require.Nil(t, resp.GetFileDescriptorResponse(), symbol)
+		require.Equal(t, int32(codes.NotFound), resp.GetErrorResponse().GetErrorCode(), symbol)
+	}
+}
+
+func TestReflectionServedWithoutAccessToken(t *testing.T) {
+	setFlag(t, "reflect_api", "true")
+	_, address := startAuxOnlyServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetListServicesResponse().GetService())
+}
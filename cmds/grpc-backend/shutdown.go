@@ -0,0 +1,39 @@
+package main
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// stopper is implemented by *grpc.Server.
+type stopper interface {
+	GracefulStop()
+	Stop()
+}
+
+// stopWithin stops s gracefully, waiting for pending RPCs to finish for up to
+// timeout, after which s is stopped forcibly, closing remaining connections
+// and cancelling the RPCs still in flight. A non-positive timeout waits for
+// pending RPCs indefinitely.
+func stopWithin(logger *zap.Logger, s stopper, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	if timeout <= 0 {
+		<-stopped
+		return
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+	case <-timer.C:
+		logger.Warn("graceful shutdown timed out, forcibly closing remaining connections", zap.Duration("timeout", timeout))
+		s.Stop()
+		<-stopped
+	}
+}
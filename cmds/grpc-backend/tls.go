@@ -0,0 +1,85 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc/credentials"
+)
+
+// tlsOptions configures TLS on the gRPC listener.
+type tlsOptions struct {
+	// CertFile and KeyFile hold the certificate with which TLS is terminated
+	// and its private key.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile, if set, holds the CAs one of which must have signed the
+	// certificate every client presents.
+	ClientCAFile string
+	// DisableSessionTickets disables TLS session resumption with tickets, so
+	// that every connection has forward secrecy.
+	DisableSessionTickets bool
+	// Require rejects configurations serving plaintext.
+	Require bool
+}
+
+// serverTLSConfig returns the tls.Config terminating TLS on the gRPC listener
+// as configured by o, or nil to serve plaintext if none of its files are set.
+//
+// Setting only some of the files, or options that only apply to TLS without
+// it, is rejected rather than falling back to plaintext, so that a mistyped
+// flag cannot expose the API without TLS.
+func serverTLSConfig(o tlsOptions) (*tls.Config, error) {
+	switch {
+	case o.CertFile == "" && o.KeyFile == "" && o.ClientCAFile == "":
+		if o.Require {
+			return nil, stacktrace.NewError("TLS is required, but neither --tls_cert_file nor --tls_key_file is set")
+		}
+		if o.DisableSessionTickets {
+			return nil, stacktrace.NewError("Session tickets can only be disabled with TLS, but neither --tls_cert_file nor --tls_key_file is set")
+		}
+		return nil, nil
+	case o.CertFile == "" || o.KeyFile == "":
+		return nil, stacktrace.NewError("TLS requires both --tls_cert_file and --tls_key_file")
+	}
+
+	cert, err := tls.LoadX509KeyPair(o.CertFile, o.KeyFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error loading TLS certificate from %s and key from %s", o.CertFile, o.KeyFile)
+	}
+	config := &tls.Config{
+		Certificates:           []tls.Certificate{cert},
+		SessionTicketsDisabled: o.DisableSessionTickets,
+	}
+	if o.ClientCAFile == "" {
+		return config, nil
+	}
+
+	pem, err := ioutil.ReadFile(o.ClientCAFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error reading client CA certificates from %s", o.ClientCAFile)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(pem) {
+		return nil, stacktrace.NewError("No PEM-encoded certificate found in %s", o.ClientCAFile)
+	}
+	config.ClientAuth = tls.RequireAndVerifyClientCert
+	config.ClientCAs = clientCAs
+	return config, nil
+}
+
+// serverCredentials returns credentials terminating TLS on the gRPC listener
+// as configured by o, or nil credentials to serve plaintext; see
+// serverTLSConfig.
+func serverCredentials(o tlsOptions) (credentials.TransportCredentials, error) {
+	config, err := serverTLSConfig(o)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	if config == nil {
+		return nil, nil
+	}
+	return credentials.NewTLS(config), nil
+}
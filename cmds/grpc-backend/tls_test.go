@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// issueCertificate returns a certificate for localhost signed by parent, or
+// self-signed if parent is nil, along with its private key.
+func issueCertificate(t *testing.T, parent *tls.Certificate, isCA bool) tls.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	var (
+		issuer                = template
+		issuerKey interface{} = key
+	)
+	if parent != nil {
+		issuer = parent.Leaf
+		issuerKey = parent.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, issuerKey)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+// writePEM writes the certificate and key of cert to files in dir, and returns
+// their paths.
+func writePEM(t *testing.T, dir, name string, cert tls.Certificate) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{
+		Type: "CERTIFICATE", Bytes: cert.Certificate[0],
+	}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{
+		Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(cert.PrivateKey.(*rsa.PrivateKey)),
+	}), 0600))
+	return certFile, keyFile
+}
+
+// serveHealth serves the health service with creds on a local port, and returns
+// its address.
+func serveHealth(t *testing.T, creds credentials.TransportCredentials) string {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	s := grpc.NewServer(grpc.Creds(creds))
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+	return l.Addr().String()
+}
+
+// check calls the health service at address with the dial options opts.
+func check(address string, opts ...grpc.DialOption) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func TestServerCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		ca                = issueCertificate(t, nil, true)
+		server            = issueCertificate(t, &ca, false)
+		client            = issueCertificate(t, &ca, false)
+		untrusted         = issueCertificate(t, nil, false)
+		caFile, _         = writePEM(t, dir, "ca", ca)
+		certFile, keyFile = writePEM(t, dir, "server", server)
+		roots             = x509.NewCertPool()
+	)
+	roots.AddCert(ca.Leaf)
+
+	// No TLS flags keep serving plaintext, but partial TLS configurations are
+	// rejected rather than silently serving plaintext.
+	creds, err := serverCredentials(tlsOptions{})
+	require.NoError(t, err)
+	require.Nil(t, creds)
+	for _, o := range []tlsOptions{
+		{CertFile: certFile},
+		{KeyFile: keyFile},
+		{ClientCAFile: caFile},
+		{CertFile: certFile, ClientCAFile: caFile},
+		{CertFile: certFile, KeyFile: keyFile, ClientCAFile: keyFile},
+		// Options contradicting plaintext are rejected too.
+		{Require: true},
+		{DisableSessionTickets: true},
+	} {
+		_, err := serverCredentials(o)
+		require.Error(t, err, o)
+	}
+
+	// With TLS, plaintext clients are refused.
+	creds, err = serverCredentials(tlsOptions{CertFile: certFile, KeyFile: keyFile, Require: true})
+	require.NoError(t, err)
+	address := serveHealth(t, creds)
+	require.NoError(t, check(address, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: roots}))))
+	require.Error(t, check(address, grpc.WithInsecure()))
+
+	// With mutual TLS, so are clients without a certificate signed by the
+	// client CA.
+	creds, err = serverCredentials(tlsOptions{CertFile: certFile, KeyFile: keyFile, ClientCAFile: caFile})
+	require.NoError(t, err)
+	address = serveHealth(t, creds)
+	withClientCertificate := func(cert ...tls.Certificate) grpc.DialOption {
+		return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: roots, Certificates: cert}))
+	}
+	require.NoError(t, check(address, withClientCertificate(client)))
+	require.Error(t, check(address, withClientCertificate()))
+	require.Error(t, check(address, withClientCertificate(untrusted)))
+	require.Error(t, check(address, grpc.WithInsecure()))
+}
+
+func TestServerTLSConfigDisablesSessionTickets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	certFile, keyFile := writePEM(t, dir, "server", issueCertificate(t, nil, false))
+
+	for _, disabled := range []bool{false, true} {
+		config, err := serverTLSConfig(tlsOptions{CertFile: certFile, KeyFile: keyFile, DisableSessionTickets: disabled})
+		require.NoError(t, err)
+		require.Equal(t, disabled, config.SessionTicketsDisabled)
+	}
+}
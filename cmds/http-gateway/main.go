@@ -2,20 +2,25 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/textproto"
 	"os"
 	"os/signal"
 	"reflect"
+	"strings"
 	"syscall"
 	"time"
 
 	"cloud.google.com/go/profiler"
 	"github.com/interuss/dss/pkg/api/v1/auxpb"
 	"github.com/interuss/dss/pkg/api/v1/ridpb"
+	"github.com/interuss/dss/pkg/api/v1/ridv22apb"
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
 	"github.com/interuss/dss/pkg/build"
 	"github.com/interuss/dss/pkg/errors"
@@ -26,6 +31,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/status"
 )
@@ -35,7 +41,14 @@ var (
 	traceRequests   = flag.Bool("trace-requests", false, "Logs HTTP request/response pairs to stderr if true")
 	grpcBackend     = flag.String("grpc-backend", "", "Endpoint for grpc backend. Only to be set if run in proxy mode")
 	profServiceName = flag.String("gcp_prof_service_name", "", "Service name for the Go profiler")
+	enableRID       = flag.Bool("enable_rid", true, "Enables the Remote ID API")
+	enableRIDV22a   = flag.Bool("enable_rid_v22a", false, "Also enables the F3411-22a version of the Remote ID API; requires --enable_rid")
 	enableSCD       = flag.Bool("enable_scd", false, "Enables the Strategic Conflict Detection API")
+	accessLog       = flag.String("access_log", "", "File to which one line per request is appended in the Combined Log Format followed by the duration in microseconds, or - for standard output; empty disables access logs")
+	backendCA       = flag.String("grpc_backend_ca_file", "", "Path to PEM-encoded CA certificates against which the certificate of the gRPC backend is verified, connecting to it over TLS; the backend is dialed in plaintext if empty")
+	backendCert     = flag.String("grpc_backend_cert_file", "", "Path to the PEM-encoded client certificate presented to a gRPC backend requiring mutual TLS, along with --grpc_backend_key_file; requires --grpc_backend_ca_file")
+	backendKey      = flag.String("grpc_backend_key_file", "", "Path to the PEM-encoded private key of --grpc_backend_cert_file")
+	emitOrigNames   = flag.Bool("emit_original_names", true, "Name JSON response fields as in the API's proto definitions (snake_case) rather than in lowerCamelCase; requests are accepted with either")
 )
 
 // RunHTTPProxy starts the HTTP proxy for the DSS gRPC service on ctx, listening
@@ -45,6 +58,10 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 		zap.String("address", address), zap.String("endpoint", endpoint),
 	)
 
+	if *enableRIDV22a && !*enableRID {
+		return stacktrace.NewError("--enable_rid_v22a requires --enable_rid")
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -53,26 +70,39 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running properly and accessible
 	grpcMux := runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
-			OrigName:     true,
-			EmitDefaults: true, // Include empty JSON arrays.
-			Indent:       "  ",
-		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, newMarshaler(*emitOrigNames)),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 	)
 
+	transport, err := backendTransport(*backendCA, *backendCert, *backendKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error configuring TLS to the gRPC backend")
+	}
 	opts := []grpc.DialOption{
-		grpc.WithInsecure(),
+		transport,
 		grpc.WithBlock(),
 		//lint:ignore SA1019 This is required as an argument to a generated function.
 		grpc.WithTimeout(10 * time.Second),
 	}
 
-	if err := ridpb.RegisterDiscoveryAndSynchronizationServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
+	if err := auxpb.RegisterDSSAuxServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
 		return err
 	}
 
-	if err := auxpb.RegisterDSSAuxServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
-		return err
+	if *enableRID {
+		if err := ridpb.RegisterDiscoveryAndSynchronizationServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
+			return err
+		}
+		logger.Info("config", zap.Any("rid", "enabled"))
+		if *enableRIDV22a {
+			if err := ridv22apb.RegisterDiscoveryAndSynchronizationServiceHandlerFromEndpoint(ctx, grpcMux, endpoint, opts); err != nil {
+				return err
+			}
+			logger.Info("config", zap.Any("rid_v22a", "enabled"))
+		}
+	} else {
+		logger.Info("config", zap.Any("rid", "disabled"))
 	}
 
 	if *enableSCD {
@@ -98,6 +128,19 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 		handler = logging.HTTPMiddleware(logger, handler)
 	}
 
+	switch *accessLog {
+	case "":
+	case "-":
+		handler = logging.AccessLogMiddleware(os.Stdout, handler)
+	default:
+		f, err := os.OpenFile(*accessLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error opening access log %s", *accessLog)
+		}
+		defer f.Close()
+		handler = logging.AccessLogMiddleware(f, handler)
+	}
+
 	logger.Info("build", zap.Any("description", build.Describe()))
 
 	signals := make(chan os.Signal)
@@ -132,6 +175,77 @@ func RunHTTPProxy(ctx context.Context, ctxCanceler func(), address, endpoint str
 	return server.ListenAndServe()
 }
 
+// backendTransport returns the dial option securing connections to the gRPC
+// backend: TLS verifying the backend's certificate against the CAs in caFile,
+// presenting the client certificate in certFile with its private key in
+// keyFile if they are set, or plaintext if none of the files are set.
+//
+// As on the backend, setting only some of the files is rejected rather than
+// falling back to plaintext.
+func backendTransport(caFile, certFile, keyFile string) (grpc.DialOption, error) {
+	switch {
+	case caFile == "" && certFile == "" && keyFile == "":
+		return grpc.WithInsecure(), nil
+	case caFile == "":
+		return nil, stacktrace.NewError("A client certificate for the gRPC backend requires --grpc_backend_ca_file")
+	case (certFile == "") != (keyFile == ""):
+		return nil, stacktrace.NewError("A client certificate for the gRPC backend requires both --grpc_backend_cert_file and --grpc_backend_key_file")
+	}
+
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error reading gRPC backend CA certificates from %s", caFile)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pem) {
+		return nil, stacktrace.NewError("No PEM-encoded certificate found in %s", caFile)
+	}
+	config := &tls.Config{RootCAs: roots}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error loading client certificate from %s and key from %s", certFile, keyFile)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+// newMarshaler returns the marshaler translating between proto3 JSON and the
+// gRPC backend's messages. Request fields are accepted under either their
+// proto or lowerCamelCase names; response fields are named as in the protos
+// if origName is true, and in lowerCamelCase otherwise.
+func newMarshaler(origName bool) runtime.Marshaler {
+	return &runtime.JSONPb{
+		OrigName:     origName,
+		EmitDefaults: true, // Include empty JSON arrays.
+		Indent:       "  ",
+	}
+}
+
+// dssHeaderPrefix prefixes the DSS-specific headers of requests and responses,
+// such as those paginating remote ID searches, which are passed through the
+// gateway unchanged.
+const dssHeaderPrefix = "x-dss-"
+
+// incomingHeaderMatcher forwards DSS-specific request headers to the gRPC
+// backend under the same name, along with those forwarded by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if lower := strings.ToLower(key); strings.HasPrefix(lower, dssHeaderPrefix) {
+		return lower, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher returns DSS-specific response headers of the gRPC
+// backend under the same name, and others prefixed as they are by default.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if strings.HasPrefix(key, dssHeaderPrefix) {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 func myCodeToHTTPStatus(code codes.Code) int {
 	switch code {
 	case codes.OK:
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/interuss/dss/pkg/api/v1/ridpb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// subscriptionServer records the Subscriptions it is asked to create.
+type subscriptionServer struct {
+	ridpb.UnimplementedDiscoveryAndSynchronizationServiceServer
+	created []*ridpb.CreateSubscriptionRequest
+}
+
+func (s *subscriptionServer) CreateSubscription(ctx context.Context, req *ridpb.CreateSubscriptionRequest) (*ridpb.PutSubscriptionResponse, error) {
+	s.created = append(s.created, req)
+	return &ridpb.PutSubscriptionResponse{
+		Subscription: &ridpb.Subscription{
+			Id:                req.Id,
+			Callbacks:         req.GetParams().GetCallbacks(),
+			NotificationIndex: 1,
+		},
+	}, nil
+}
+
+func TestGatewayAcceptsSnakeAndCamelCaseJSON(t *testing.T) {
+	for _, origName := range []bool{true, false} {
+		var (
+			ctx    = context.Background()
+			server = &subscriptionServer{}
+			mux    = runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, newMarshaler(origName)))
+		)
+		require.NoError(t, ridpb.RegisterDiscoveryAndSynchronizationServiceHandlerServer(ctx, mux, server))
+
+		for _, body := range []string{
+			`{"callbacks": {"identification_service_area_url": "https://example.com/isas"}, "extents": {"time_start": "2020-07-01T12:00:00Z"}}`,
+			`{"callbacks": {"identificationServiceAreaUrl": "https://example.com/isas"}, "extents": {"timeStart": "2020-07-01T12:00:00Z"}}`,
+		} {
+			var (
+				req = httptest.NewRequest(http.MethodPut, "/v1/dss/subscriptions/4348c8e5-0b1c-43cf-9114-2e67a4532765", strings.NewReader(body))
+				rec = httptest.NewRecorder()
+			)
+			mux.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var resp struct {
+				Subscription map[string]interface{}
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			if origName {
+				require.Contains(t, resp.Subscription, "notification_index")
+			} else {
+				require.Contains(t, resp.Subscription, "notificationIndex")
+			}
+		}
+
+		require.Len(t, server.created, 2)
+		for _, created := range server.created {
+			require.Equal(t, "https://example.com/isas", created.GetParams().GetCallbacks().GetIdentificationServiceAreaUrl())
+			require.Equal(t, int64(1593604800), created.GetParams().GetExtents().GetTimeStart().GetSeconds())
+		}
+	}
+}
+
+// pagingServer responds to Subscription searches with a next page token when
+// a page size is requested.
+type pagingServer struct {
+	ridpb.UnimplementedDiscoveryAndSynchronizationServiceServer
+}
+
+func (s *pagingServer) SearchSubscriptions(ctx context.Context, req *ridpb.SearchSubscriptionsRequest) (*ridpb.SearchSubscriptionsResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if sizes := md.Get("x-dss-page-size"); len(sizes) > 0 {
+		if err := grpc.SetHeader(ctx, metadata.Pairs("x-dss-next-page-token", "after-"+sizes[0])); err != nil {
+			return nil, err
+		}
+	}
+	return &ridpb.SearchSubscriptionsResponse{}, nil
+}
+
+func TestGatewayPassesPaginationHeaders(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		listener = bufconn.Listen(1 << 20)
+		s        = grpc.NewServer()
+		mux      = runtime.NewServeMux(
+			runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+			runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		)
+	)
+	ridpb.RegisterDiscoveryAndSynchronizationServiceServer(s, &pagingServer{})
+	go s.Serve(listener)
+	defer s.Stop()
+
+	// Headers only travel between the gateway and a backend it is connected
+	// to, rather than one it calls directly.
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, ridpb.RegisterDiscoveryAndSynchronizationServiceHandler(ctx, mux, conn))
+
+	var (
+		req = httptest.NewRequest(http.MethodGet, "/v1/dss/subscriptions?area=0,0,0,1,1,1", nil)
+		rec = httptest.NewRecorder()
+	)
+	req.Header.Set("X-Dss-Page-Size", "5")
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "after-5", rec.Header().Get("X-Dss-Next-Page-Token"))
+}
+
+func TestBackendTransportRejectsPartialTLS(t *testing.T) {
+	f, err := ioutil.TempFile("", "gateway-ca-*.pem")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("not a certificate")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	transport, err := backendTransport("", "", "")
+	require.NoError(t, err)
+	require.NotNil(t, transport)
+
+	for _, files := range [][3]string{
+		{"", "client.crt", "client.key"},
+		{"", "client.crt", ""},
+		{f.Name(), "client.crt", ""},
+		{f.Name(), "", "client.key"},
+		// CA files must hold a certificate.
+		{f.Name(), "", ""},
+	} {
+		_, err := backendTransport(files[0], files[1], files[2])
+		require.Error(t, err, files)
+	}
+}
@@ -8,7 +8,7 @@ go 1.14
 require (
 	cloud.google.com/go v0.57.0
 	github.com/blang/semver v3.5.1+incompatible
-	github.com/cockroachdb/cockroach-go v0.0.0-20200504194139-73ffeee90b62
+	github.com/cockroachdb/cockroach-go v0.0.0-20200504194139-73ffeee90b62 // indirect
 	github.com/coreos/go-semver v0.3.0
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/dpjacques/clockwork v0.1.0
@@ -18,12 +18,15 @@ require (
 	github.com/google/uuid v1.1.1
 	github.com/grpc-ecosystem/go-grpc-middleware v1.2.0
 	github.com/grpc-ecosystem/grpc-gateway v1.14.3
-	github.com/lib/pq v1.5.2
 	github.com/interuss/stacktrace v0.0.0-20200827180054-b2e58cf48818
+	github.com/lib/pq v1.5.2
+	github.com/prometheus/client_golang v1.7.1
 	github.com/stretchr/testify v1.5.1
 	go.uber.org/multierr v1.5.0
 	go.uber.org/zap v1.15.0
 	golang.org/x/mod v0.2.0
+	golang.org/x/net v0.0.0-20200501053045-e0ff5e5a1de5
+	google.golang.org/api v0.22.0
 	google.golang.org/genproto v0.0.0-20200519141106-08726f379972
 	google.golang.org/grpc v1.29.1
 	google.golang.org/protobuf v1.23.0
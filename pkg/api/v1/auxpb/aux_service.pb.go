@@ -166,6 +166,103 @@ func (x *GetVersionResponse) GetVersion() *Version {
 	return nil
 }
 
+type GetRegionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRegionRequest) Reset() {
+	*x = GetRegionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionRequest) ProtoMessage() {}
+
+func (x *GetRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionRequest.ProtoReflect.Descriptor instead.
+func (*GetRegionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{3}
+}
+
+// Identity of the DSS region this instance serves.
+type GetRegionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the DSS region, or pool, whose data this instance shares
+	// with the other instances of the region.
+	Region string `protobuf:"bytes,1,opt,name=region,proto3" json:"region,omitempty"`
+	// Self-identification of this DSS instance within its region.
+	Locality string `protobuf:"bytes,2,opt,name=locality,proto3" json:"locality,omitempty"`
+}
+
+func (x *GetRegionResponse) Reset() {
+	*x = GetRegionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRegionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionResponse) ProtoMessage() {}
+
+func (x *GetRegionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionResponse.ProtoReflect.Descriptor instead.
+func (*GetRegionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetRegionResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetRegionResponse) GetLocality() string {
+	if x != nil {
+		return x.Locality
+	}
+	return ""
+}
+
 type ValidateOauthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -178,7 +275,7 @@ type ValidateOauthRequest struct {
 func (x *ValidateOauthRequest) Reset() {
 	*x = ValidateOauthRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3]
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -191,7 +288,7 @@ func (x *ValidateOauthRequest) String() string {
 func (*ValidateOauthRequest) ProtoMessage() {}
 
 func (x *ValidateOauthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3]
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -204,7 +301,7 @@ func (x *ValidateOauthRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateOauthRequest.ProtoReflect.Descriptor instead.
 func (*ValidateOauthRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{3}
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ValidateOauthRequest) GetOwner() string {
@@ -224,7 +321,7 @@ type ValidateOauthResponse struct {
 func (x *ValidateOauthResponse) Reset() {
 	*x = ValidateOauthResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4]
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -237,7 +334,7 @@ func (x *ValidateOauthResponse) String() string {
 func (*ValidateOauthResponse) ProtoMessage() {}
 
 func (x *ValidateOauthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4]
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,38 +347,35 @@ func (x *ValidateOauthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateOauthResponse.ProtoReflect.Descriptor instead.
 func (*ValidateOauthResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{4}
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{6}
 }
 
-// Error response format for most errors
-type StandardErrorResponse struct {
+type TestSubscriptionNotificationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error   string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Code    int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	ErrorId string `protobuf:"bytes,4,opt,name=error_id,json=errorId,proto3" json:"error_id,omitempty"`
+	// EntityUUID of the caller's remote ID Subscription to notify.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *StandardErrorResponse) Reset() {
-	*x = StandardErrorResponse{}
+func (x *TestSubscriptionNotificationRequest) Reset() {
+	*x = TestSubscriptionNotificationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5]
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StandardErrorResponse) String() string {
+func (x *TestSubscriptionNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StandardErrorResponse) ProtoMessage() {}
+func (*TestSubscriptionNotificationRequest) ProtoMessage() {}
 
-func (x *StandardErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5]
+func (x *TestSubscriptionNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,138 +386,3866 @@ func (x *StandardErrorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StandardErrorResponse.ProtoReflect.Descriptor instead.
-func (*StandardErrorResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TestSubscriptionNotificationRequest.ProtoReflect.Descriptor instead.
+func (*TestSubscriptionNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *StandardErrorResponse) GetError() string {
+func (x *TestSubscriptionNotificationRequest) GetId() string {
 	if x != nil {
-		return x.Error
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StandardErrorResponse) GetCode() int32 {
+// Result of delivering a test notification to a Subscription's callback.
+type TestSubscriptionNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Callback URL the test notification was delivered to.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP status code returned by the callback, or 0 if no response was
+	// received.
+	StatusCode int32 `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Description of the failure to reach the callback, if any.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TestSubscriptionNotificationResponse) Reset() {
+	*x = TestSubscriptionNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestSubscriptionNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSubscriptionNotificationResponse) ProtoMessage() {}
+
+func (x *TestSubscriptionNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSubscriptionNotificationResponse.ProtoReflect.Descriptor instead.
+func (*TestSubscriptionNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TestSubscriptionNotificationResponse) GetUrl() string {
 	if x != nil {
-		return x.Code
+		return x.Url
+	}
+	return ""
+}
+
+func (x *TestSubscriptionNotificationResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *StandardErrorResponse) GetMessage() string {
+func (x *TestSubscriptionNotificationResponse) GetError() string {
 	if x != nil {
-		return x.Message
+		return x.Error
 	}
 	return ""
 }
 
-func (x *StandardErrorResponse) GetErrorId() string {
+type GetRequiredScopesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRequiredScopesRequest) Reset() {
+	*x = GetRequiredScopesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRequiredScopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequiredScopesRequest) ProtoMessage() {}
+
+func (x *GetRequiredScopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequiredScopesRequest.ProtoReflect.Descriptor instead.
+func (*GetRequiredScopesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{9}
+}
+
+// Scopes an access token must claim to be authorized for an operation.
+type OperationScopes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Fully-qualified gRPC method name of the operation, e.g.
+	// /ridpb.DiscoveryAndSynchronizationService/GetSubscription.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Every one of these scopes must be claimed.
+	AllOf []string `protobuf:"bytes,2,rep,name=all_of,json=allOf,proto3" json:"all_of,omitempty"`
+	// At least one of these scopes must be claimed.
+	AnyOf []string `protobuf:"bytes,3,rep,name=any_of,json=anyOf,proto3" json:"any_of,omitempty"`
+}
+
+func (x *OperationScopes) Reset() {
+	*x = OperationScopes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationScopes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationScopes) ProtoMessage() {}
+
+func (x *OperationScopes) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationScopes.ProtoReflect.Descriptor instead.
+func (*OperationScopes) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *OperationScopes) GetOperation() string {
 	if x != nil {
-		return x.ErrorId
+		return x.Operation
 	}
 	return ""
 }
 
-var File_pkg_api_v1_auxpb_aux_service_proto protoreflect.FileDescriptor
+func (x *OperationScopes) GetAllOf() []string {
+	if x != nil {
+		return x.AllOf
+	}
+	return nil
+}
 
-var file_pkg_api_v1_auxpb_aux_service_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x78,
-	0x70, 0x62, 0x2f, 0x61, 0x75, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x75, 0x78, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x07, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x76, 0x0a,
-	0x15, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x49, 0x64, 0x32, 0xd7, 0x01, 0x0a, 0x0d, 0x44, 0x53, 0x53, 0x41, 0x75, 0x78,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x6a, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f,
-	0x61, 0x75, 0x74, 0x68, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31,
-	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x42,
-	0x12, 0x5a, 0x10, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75,
-	0x78, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *OperationScopes) GetAnyOf() []string {
+	if x != nil {
+		return x.AnyOf
+	}
+	return nil
 }
 
-var (
-	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce sync.Once
-	file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = file_pkg_api_v1_auxpb_aux_service_proto_rawDesc
-)
+type GetRequiredScopesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP() []byte {
-	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce.Do(func() {
-		file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_v1_auxpb_aux_service_proto_rawDescData)
-	})
-	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescData
+	// Scope requirements of every operation served by this DSS instance that
+	// requires any, ordered by operation.
+	Operations []*OperationScopes `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-var file_pkg_api_v1_auxpb_aux_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_pkg_api_v1_auxpb_aux_service_proto_goTypes = []interface{}{
-	(*Version)(nil),               // 0: auxpb.Version
-	(*GetVersionRequest)(nil),     // 1: auxpb.GetVersionRequest
-	(*GetVersionResponse)(nil),    // 2: auxpb.GetVersionResponse
-	(*ValidateOauthRequest)(nil),  // 3: auxpb.ValidateOauthRequest
-	(*ValidateOauthResponse)(nil), // 4: auxpb.ValidateOauthResponse
-	(*StandardErrorResponse)(nil), // 5: auxpb.StandardErrorResponse
+func (x *GetRequiredScopesResponse) Reset() {
+	*x = GetRequiredScopesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_pkg_api_v1_auxpb_aux_service_proto_depIdxs = []int32{
-	0, // 0: auxpb.GetVersionResponse.version:type_name -> auxpb.Version
-	1, // 1: auxpb.DSSAuxService.GetVersion:input_type -> auxpb.GetVersionRequest
-	3, // 2: auxpb.DSSAuxService.ValidateOauth:input_type -> auxpb.ValidateOauthRequest
-	2, // 3: auxpb.DSSAuxService.GetVersion:output_type -> auxpb.GetVersionResponse
-	4, // 4: auxpb.DSSAuxService.ValidateOauth:output_type -> auxpb.ValidateOauthResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+func (x *GetRequiredScopesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_pkg_api_v1_auxpb_aux_service_proto_init() }
-func file_pkg_api_v1_auxpb_aux_service_proto_init() {
-	if File_pkg_api_v1_auxpb_aux_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Version); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*GetRequiredScopesResponse) ProtoMessage() {}
+
+func (x *GetRequiredScopesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequiredScopesResponse.ProtoReflect.Descriptor instead.
+func (*GetRequiredScopesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRequiredScopesResponse) GetOperations() []*OperationScopes {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type ListModifiedIdentificationServiceAreasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only ISAs written after this RFC3339 time are listed.  Ignored when
+	// page_token is set.
+	Since string `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	// Maximum number of ISAs to return; defaults to 100, and may not exceed
+	// 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous response, to continue listing after its
+	// last ISA.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListModifiedIdentificationServiceAreasRequest) Reset() {
+	*x = ListModifiedIdentificationServiceAreasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModifiedIdentificationServiceAreasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModifiedIdentificationServiceAreasRequest) ProtoMessage() {}
+
+func (x *ListModifiedIdentificationServiceAreasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModifiedIdentificationServiceAreasRequest.ProtoReflect.Descriptor instead.
+func (*ListModifiedIdentificationServiceAreasRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListModifiedIdentificationServiceAreasRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *ListModifiedIdentificationServiceAreasRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListModifiedIdentificationServiceAreasRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Summary of a remote ID Identification Service Area written after the time
+// requested in a ListModifiedIdentificationServiceAreasRequest.
+type ModifiedIdentificationServiceArea struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner      string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	FlightsUrl string `protobuf:"bytes,3,opt,name=flights_url,json=flightsUrl,proto3" json:"flights_url,omitempty"`
+	// Current version of the ISA, as reported by the remote ID API.
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// RFC3339 times bounding the ISA, and at which it was last written.
+	TimeStart string `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd   string `protobuf:"bytes,6,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	UpdatedAt string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ModifiedIdentificationServiceArea) Reset() {
+	*x = ModifiedIdentificationServiceArea{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifiedIdentificationServiceArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifiedIdentificationServiceArea) ProtoMessage() {}
+
+func (x *ModifiedIdentificationServiceArea) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifiedIdentificationServiceArea.ProtoReflect.Descriptor instead.
+func (*ModifiedIdentificationServiceArea) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ModifiedIdentificationServiceArea) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModifiedIdentificationServiceArea) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ModifiedIdentificationServiceArea) GetFlightsUrl() string {
+	if x != nil {
+		return x.FlightsUrl
+	}
+	return ""
+}
+
+func (x *ModifiedIdentificationServiceArea) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ModifiedIdentificationServiceArea) GetTimeStart() string {
+	if x != nil {
+		return x.TimeStart
+	}
+	return ""
+}
+
+func (x *ModifiedIdentificationServiceArea) GetTimeEnd() string {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return ""
+}
+
+func (x *ModifiedIdentificationServiceArea) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListModifiedIdentificationServiceAreasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ISAs ordered by the time at which they were last written.
+	ServiceAreas []*ModifiedIdentificationServiceArea `protobuf:"bytes,1,rep,name=service_areas,json=serviceAreas,proto3" json:"service_areas,omitempty"`
+	// Token to pass in the next request to continue listing; always set, so
+	// that a client polling for further changes can resume from it.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListModifiedIdentificationServiceAreasResponse) Reset() {
+	*x = ListModifiedIdentificationServiceAreasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModifiedIdentificationServiceAreasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModifiedIdentificationServiceAreasResponse) ProtoMessage() {}
+
+func (x *ListModifiedIdentificationServiceAreasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModifiedIdentificationServiceAreasResponse.ProtoReflect.Descriptor instead.
+func (*ListModifiedIdentificationServiceAreasResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListModifiedIdentificationServiceAreasResponse) GetServiceAreas() []*ModifiedIdentificationServiceArea {
+	if x != nil {
+		return x.ServiceAreas
+	}
+	return nil
+}
+
+func (x *ListModifiedIdentificationServiceAreasResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetIdentificationServiceAreaCoverageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The area in which to look for Identification Service Areas, in the
+	// format of the area of a remote ID search.
+	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	// Tokens of the S2 cells in which to look for Identification Service
+	// Areas, for clients having already covered their area; may be set instead
+	// of area.  Every cell must be at the level of the cells covering stored
+	// Identification Service Areas.
+	Cells []string `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *GetIdentificationServiceAreaCoverageRequest) Reset() {
+	*x = GetIdentificationServiceAreaCoverageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdentificationServiceAreaCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentificationServiceAreaCoverageRequest) ProtoMessage() {}
+
+func (x *GetIdentificationServiceAreaCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentificationServiceAreaCoverageRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentificationServiceAreaCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetIdentificationServiceAreaCoverageRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *GetIdentificationServiceAreaCoverageRequest) GetCells() []string {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type GetIdentificationServiceAreaCoverageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether any Identification Service Area that has not ended covers part
+	// of the area requested.  As for remote ID searches, Identification
+	// Service Areas near the area but wholly outside it may be counted.
+	Covered bool `protobuf:"varint,1,opt,name=covered,proto3" json:"covered,omitempty"`
+}
+
+func (x *GetIdentificationServiceAreaCoverageResponse) Reset() {
+	*x = GetIdentificationServiceAreaCoverageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdentificationServiceAreaCoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentificationServiceAreaCoverageResponse) ProtoMessage() {}
+
+func (x *GetIdentificationServiceAreaCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentificationServiceAreaCoverageResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentificationServiceAreaCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetIdentificationServiceAreaCoverageResponse) GetCovered() bool {
+	if x != nil {
+		return x.Covered
+	}
+	return false
+}
+
+type TriggerGarbageCollectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerGarbageCollectionRequest) Reset() {
+	*x = TriggerGarbageCollectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerGarbageCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerGarbageCollectionRequest) ProtoMessage() {}
+
+func (x *TriggerGarbageCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerGarbageCollectionRequest.ProtoReflect.Descriptor instead.
+func (*TriggerGarbageCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{17}
+}
+
+// Number of expired entities removed by a garbage collection pass.
+type TriggerGarbageCollectionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdentificationServiceAreasRemoved int32 `protobuf:"varint,1,opt,name=identification_service_areas_removed,json=identificationServiceAreasRemoved,proto3" json:"identification_service_areas_removed,omitempty"`
+	SubscriptionsRemoved              int32 `protobuf:"varint,2,opt,name=subscriptions_removed,json=subscriptionsRemoved,proto3" json:"subscriptions_removed,omitempty"`
+}
+
+func (x *TriggerGarbageCollectionResponse) Reset() {
+	*x = TriggerGarbageCollectionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerGarbageCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerGarbageCollectionResponse) ProtoMessage() {}
+
+func (x *TriggerGarbageCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerGarbageCollectionResponse.ProtoReflect.Descriptor instead.
+func (*TriggerGarbageCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TriggerGarbageCollectionResponse) GetIdentificationServiceAreasRemoved() int32 {
+	if x != nil {
+		return x.IdentificationServiceAreasRemoved
+	}
+	return 0
+}
+
+func (x *TriggerGarbageCollectionResponse) GetSubscriptionsRemoved() int32 {
+	if x != nil {
+		return x.SubscriptionsRemoved
+	}
+	return 0
+}
+
+type CountIdentificationServiceAreasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RFC 3339 time of the start of the first bucket.
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// RFC 3339 time of the end of the last bucket.
+	End string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Length of each bucket, such as "1h" or "900s". The last bucket is
+	// shortened to end at `end`.
+	Interval string `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *CountIdentificationServiceAreasRequest) Reset() {
+	*x = CountIdentificationServiceAreasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountIdentificationServiceAreasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountIdentificationServiceAreasRequest) ProtoMessage() {}
+
+func (x *CountIdentificationServiceAreasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountIdentificationServiceAreasRequest.ProtoReflect.Descriptor instead.
+func (*CountIdentificationServiceAreasRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CountIdentificationServiceAreasRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CountIdentificationServiceAreasRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *CountIdentificationServiceAreasRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+// Number of remote ID Identification Service Areas active at any point
+// during a bucket of time.
+type IdentificationServiceAreaCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RFC 3339 times bounding the bucket.
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Count int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *IdentificationServiceAreaCount) Reset() {
+	*x = IdentificationServiceAreaCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentificationServiceAreaCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationServiceAreaCount) ProtoMessage() {}
+
+func (x *IdentificationServiceAreaCount) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationServiceAreaCount.ProtoReflect.Descriptor instead.
+func (*IdentificationServiceAreaCount) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IdentificationServiceAreaCount) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *IdentificationServiceAreaCount) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *IdentificationServiceAreaCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type CountIdentificationServiceAreasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Consecutive buckets from `start` to `end` of the request.
+	Buckets []*IdentificationServiceAreaCount `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *CountIdentificationServiceAreasResponse) Reset() {
+	*x = CountIdentificationServiceAreasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountIdentificationServiceAreasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountIdentificationServiceAreasResponse) ProtoMessage() {}
+
+func (x *CountIdentificationServiceAreasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountIdentificationServiceAreasResponse.ProtoReflect.Descriptor instead.
+func (*CountIdentificationServiceAreasResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CountIdentificationServiceAreasResponse) GetBuckets() []*IdentificationServiceAreaCount {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type GetSubscriptionWithOwnerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the strategic conflict detection Subscription to retrieve.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubscriptionWithOwnerRequest) Reset() {
+	*x = GetSubscriptionWithOwnerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionWithOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionWithOwnerRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionWithOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionWithOwnerRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionWithOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSubscriptionWithOwnerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// A strategic conflict detection Subscription along with the identity of the
+// USS owning it, which is withheld from the USS-facing API.
+type SubscriptionWithOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Version              int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	NotificationIndex    int32  `protobuf:"varint,4,opt,name=notification_index,json=notificationIndex,proto3" json:"notification_index,omitempty"`
+	UssBaseUrl           string `protobuf:"bytes,5,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+	NotifyForOperations  bool   `protobuf:"varint,6,opt,name=notify_for_operations,json=notifyForOperations,proto3" json:"notify_for_operations,omitempty"`
+	NotifyForConstraints bool   `protobuf:"varint,7,opt,name=notify_for_constraints,json=notifyForConstraints,proto3" json:"notify_for_constraints,omitempty"`
+	ImplicitSubscription bool   `protobuf:"varint,8,opt,name=implicit_subscription,json=implicitSubscription,proto3" json:"implicit_subscription,omitempty"`
+	// RFC 3339 times; empty if unbounded.
+	TimeStart string `protobuf:"bytes,9,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd   string `protobuf:"bytes,10,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+}
+
+func (x *SubscriptionWithOwner) Reset() {
+	*x = SubscriptionWithOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionWithOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionWithOwner) ProtoMessage() {}
+
+func (x *SubscriptionWithOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionWithOwner.ProtoReflect.Descriptor instead.
+func (*SubscriptionWithOwner) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubscriptionWithOwner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubscriptionWithOwner) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SubscriptionWithOwner) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SubscriptionWithOwner) GetNotificationIndex() int32 {
+	if x != nil {
+		return x.NotificationIndex
+	}
+	return 0
+}
+
+func (x *SubscriptionWithOwner) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+func (x *SubscriptionWithOwner) GetNotifyForOperations() bool {
+	if x != nil {
+		return x.NotifyForOperations
+	}
+	return false
+}
+
+func (x *SubscriptionWithOwner) GetNotifyForConstraints() bool {
+	if x != nil {
+		return x.NotifyForConstraints
+	}
+	return false
+}
+
+func (x *SubscriptionWithOwner) GetImplicitSubscription() bool {
+	if x != nil {
+		return x.ImplicitSubscription
+	}
+	return false
+}
+
+func (x *SubscriptionWithOwner) GetTimeStart() string {
+	if x != nil {
+		return x.TimeStart
+	}
+	return ""
+}
+
+func (x *SubscriptionWithOwner) GetTimeEnd() string {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return ""
+}
+
+type GetSubscriptionWithOwnerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *SubscriptionWithOwner `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *GetSubscriptionWithOwnerResponse) Reset() {
+	*x = GetSubscriptionWithOwnerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionWithOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionWithOwnerResponse) ProtoMessage() {}
+
+func (x *GetSubscriptionWithOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionWithOwnerResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionWithOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetSubscriptionWithOwnerResponse) GetSubscription() *SubscriptionWithOwner {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type ListOperationsTouchingSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the strategic conflict detection Subscription whose area and time
+	// the Operations listed overlap.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListOperationsTouchingSubscriptionRequest) Reset() {
+	*x = ListOperationsTouchingSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsTouchingSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsTouchingSubscriptionRequest) ProtoMessage() {}
+
+func (x *ListOperationsTouchingSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsTouchingSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationsTouchingSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListOperationsTouchingSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// A strategic conflict detection Operation reference along with the identity
+// of the USS owning it. Its OVN is withheld, as it is from every USS but its
+// owner.
+type OperationWithOwner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner          string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Version        int32  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	UssBaseUrl     string `protobuf:"bytes,4,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+	SubscriptionId string `protobuf:"bytes,5,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// RFC 3339 times; empty if unbounded.
+	TimeStart string `protobuf:"bytes,6,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd   string `protobuf:"bytes,7,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+}
+
+func (x *OperationWithOwner) Reset() {
+	*x = OperationWithOwner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationWithOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationWithOwner) ProtoMessage() {}
+
+func (x *OperationWithOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationWithOwner.ProtoReflect.Descriptor instead.
+func (*OperationWithOwner) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OperationWithOwner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OperationWithOwner) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OperationWithOwner) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OperationWithOwner) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+func (x *OperationWithOwner) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *OperationWithOwner) GetTimeStart() string {
+	if x != nil {
+		return x.TimeStart
+	}
+	return ""
+}
+
+func (x *OperationWithOwner) GetTimeEnd() string {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return ""
+}
+
+type ListOperationsTouchingSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Operations ordered by ID.
+	Operations []*OperationWithOwner `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *ListOperationsTouchingSubscriptionResponse) Reset() {
+	*x = ListOperationsTouchingSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOperationsTouchingSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationsTouchingSubscriptionResponse) ProtoMessage() {}
+
+func (x *ListOperationsTouchingSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationsTouchingSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationsTouchingSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListOperationsTouchingSubscriptionResponse) GetOperations() []*OperationWithOwner {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type GetCurrentKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the strategic conflict detection Operation the client is creating
+	// or updating, which does not conflict with its own prior version; may be
+	// empty.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// IDs of the Operations and Constraints reported in the entity_conflicts
+	// of the AirspaceConflictResponse rejecting the attempt.
+	OperationIds  []string `protobuf:"bytes,2,rep,name=operation_ids,json=operationIds,proto3" json:"operation_ids,omitempty"`
+	ConstraintIds []string `protobuf:"bytes,3,rep,name=constraint_ids,json=constraintIds,proto3" json:"constraint_ids,omitempty"`
+}
+
+func (x *GetCurrentKeyRequest) Reset() {
+	*x = GetCurrentKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentKeyRequest) ProtoMessage() {}
+
+func (x *GetCurrentKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentKeyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCurrentKeyRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *GetCurrentKeyRequest) GetOperationIds() []string {
+	if x != nil {
+		return x.OperationIds
+	}
+	return nil
+}
+
+func (x *GetCurrentKeyRequest) GetConstraintIds() []string {
+	if x != nil {
+		return x.ConstraintIds
+	}
+	return nil
+}
+
+// A strategic conflict detection Operation or Constraint whose current OVN
+// is withheld from the client, as it is from every USS but its owner.
+type WithheldOVN struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Base URL of the USS owning the entity, from which its details, OVN
+	// included, are to be obtained.
+	UssBaseUrl string `protobuf:"bytes,2,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+}
+
+func (x *WithheldOVN) Reset() {
+	*x = WithheldOVN{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithheldOVN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithheldOVN) ProtoMessage() {}
+
+func (x *WithheldOVN) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithheldOVN.ProtoReflect.Descriptor instead.
+func (*WithheldOVN) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WithheldOVN) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WithheldOVN) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+type GetCurrentKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Current OVNs of the Operations and Constraints requested that the client
+	// owns. Entities that no longer exist are omitted, as they no longer
+	// conflict.
+	Key []string `protobuf:"bytes,1,rep,name=key,proto3" json:"key,omitempty"`
+	// Operations and Constraints requested that other USSs own, ordered by ID.
+	// Their current OVNs must be obtained from their owners and added to `key`
+	// for the retry to succeed.
+	WithheldOperations  []*WithheldOVN `protobuf:"bytes,2,rep,name=withheld_operations,json=withheldOperations,proto3" json:"withheld_operations,omitempty"`
+	WithheldConstraints []*WithheldOVN `protobuf:"bytes,3,rep,name=withheld_constraints,json=withheldConstraints,proto3" json:"withheld_constraints,omitempty"`
+}
+
+func (x *GetCurrentKeyResponse) Reset() {
+	*x = GetCurrentKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentKeyResponse) ProtoMessage() {}
+
+func (x *GetCurrentKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentKeyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetCurrentKeyResponse) GetKey() []string {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *GetCurrentKeyResponse) GetWithheldOperations() []*WithheldOVN {
+	if x != nil {
+		return x.WithheldOperations
+	}
+	return nil
+}
+
+func (x *GetCurrentKeyResponse) GetWithheldConstraints() []*WithheldOVN {
+	if x != nil {
+		return x.WithheldConstraints
+	}
+	return nil
+}
+
+type ScanRemoteIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of entities to return; defaults to 100, and may not exceed
+	// 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous response, to continue after its last
+	// entity; empty to start from the first.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ScanRemoteIDRequest) Reset() {
+	*x = ScanRemoteIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanRemoteIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRemoteIDRequest) ProtoMessage() {}
+
+func (x *ScanRemoteIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRemoteIDRequest.ProtoReflect.Descriptor instead.
+func (*ScanRemoteIDRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScanRemoteIDRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ScanRemoteIDRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// A remote ID Identification Service Area as stored, for migration tooling.
+type StoredIdentificationServiceArea struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner      string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	FlightsUrl string `protobuf:"bytes,3,opt,name=flights_url,json=flightsUrl,proto3" json:"flights_url,omitempty"`
+	// S2 cell IDs covering the ISA.
+	Cells []uint64 `protobuf:"varint,4,rep,packed,name=cells,proto3" json:"cells,omitempty"`
+	// RFC 3339 times; empty if unbounded.
+	TimeStart string `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd   string `protobuf:"bytes,6,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Version   string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	Writer    string `protobuf:"bytes,8,opt,name=writer,proto3" json:"writer,omitempty"`
+}
+
+func (x *StoredIdentificationServiceArea) Reset() {
+	*x = StoredIdentificationServiceArea{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoredIdentificationServiceArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredIdentificationServiceArea) ProtoMessage() {}
+
+func (x *StoredIdentificationServiceArea) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredIdentificationServiceArea.ProtoReflect.Descriptor instead.
+func (*StoredIdentificationServiceArea) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StoredIdentificationServiceArea) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StoredIdentificationServiceArea) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *StoredIdentificationServiceArea) GetFlightsUrl() string {
+	if x != nil {
+		return x.FlightsUrl
+	}
+	return ""
+}
+
+func (x *StoredIdentificationServiceArea) GetCells() []uint64 {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *StoredIdentificationServiceArea) GetTimeStart() string {
+	if x != nil {
+		return x.TimeStart
+	}
+	return ""
+}
+
+func (x *StoredIdentificationServiceArea) GetTimeEnd() string {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return ""
+}
+
+func (x *StoredIdentificationServiceArea) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *StoredIdentificationServiceArea) GetWriter() string {
+	if x != nil {
+		return x.Writer
+	}
+	return ""
+}
+
+type ScanIdentificationServiceAreasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ISAs ordered by ID.
+	ServiceAreas []*StoredIdentificationServiceArea `protobuf:"bytes,1,rep,name=service_areas,json=serviceAreas,proto3" json:"service_areas,omitempty"`
+	// Token to pass in the next request to continue scanning; empty once every
+	// ISA has been returned.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ScanIdentificationServiceAreasResponse) Reset() {
+	*x = ScanIdentificationServiceAreasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanIdentificationServiceAreasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanIdentificationServiceAreasResponse) ProtoMessage() {}
+
+func (x *ScanIdentificationServiceAreasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanIdentificationServiceAreasResponse.ProtoReflect.Descriptor instead.
+func (*ScanIdentificationServiceAreasResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ScanIdentificationServiceAreasResponse) GetServiceAreas() []*StoredIdentificationServiceArea {
+	if x != nil {
+		return x.ServiceAreas
+	}
+	return nil
+}
+
+func (x *ScanIdentificationServiceAreasResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A remote ID Subscription as stored, for migration tooling.
+type StoredSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner             string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	CallbackUrl       string `protobuf:"bytes,3,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	NotificationIndex int32  `protobuf:"varint,4,opt,name=notification_index,json=notificationIndex,proto3" json:"notification_index,omitempty"`
+	// S2 cell IDs covering the Subscription.
+	Cells []uint64 `protobuf:"varint,5,rep,packed,name=cells,proto3" json:"cells,omitempty"`
+	// RFC 3339 times; empty if unbounded.
+	TimeStart string `protobuf:"bytes,6,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	TimeEnd   string `protobuf:"bytes,7,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Version   string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+	Writer    string `protobuf:"bytes,9,opt,name=writer,proto3" json:"writer,omitempty"`
+}
+
+func (x *StoredSubscription) Reset() {
+	*x = StoredSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoredSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredSubscription) ProtoMessage() {}
+
+func (x *StoredSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredSubscription.ProtoReflect.Descriptor instead.
+func (*StoredSubscription) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StoredSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StoredSubscription) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *StoredSubscription) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *StoredSubscription) GetNotificationIndex() int32 {
+	if x != nil {
+		return x.NotificationIndex
+	}
+	return 0
+}
+
+func (x *StoredSubscription) GetCells() []uint64 {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *StoredSubscription) GetTimeStart() string {
+	if x != nil {
+		return x.TimeStart
+	}
+	return ""
+}
+
+func (x *StoredSubscription) GetTimeEnd() string {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return ""
+}
+
+func (x *StoredSubscription) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *StoredSubscription) GetWriter() string {
+	if x != nil {
+		return x.Writer
+	}
+	return ""
+}
+
+type ScanSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriptions ordered by ID.
+	Subscriptions []*StoredSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// Token to pass in the next request to continue scanning; empty once every
+	// Subscription has been returned.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ScanSubscriptionsResponse) Reset() {
+	*x = ScanSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ScanSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ScanSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ScanSubscriptionsResponse) GetSubscriptions() []*StoredSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ScanSubscriptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetSubscriptionNotificationIndicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityUUID of the remote ID Subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubscriptionNotificationIndicesRequest) Reset() {
+	*x = GetSubscriptionNotificationIndicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionNotificationIndicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionNotificationIndicesRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionNotificationIndicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionNotificationIndicesRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionNotificationIndicesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetSubscriptionNotificationIndicesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSubscriptionNotificationIndicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Notification index of the last notification of the Subscription.
+	CurrentIndex int32 `protobuf:"varint,1,opt,name=current_index,json=currentIndex,proto3" json:"current_index,omitempty"`
+	// Notification index its next notification will carry.
+	NextIndex int32 `protobuf:"varint,2,opt,name=next_index,json=nextIndex,proto3" json:"next_index,omitempty"`
+}
+
+func (x *GetSubscriptionNotificationIndicesResponse) Reset() {
+	*x = GetSubscriptionNotificationIndicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionNotificationIndicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionNotificationIndicesResponse) ProtoMessage() {}
+
+func (x *GetSubscriptionNotificationIndicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionNotificationIndicesResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionNotificationIndicesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetSubscriptionNotificationIndicesResponse) GetCurrentIndex() int32 {
+	if x != nil {
+		return x.CurrentIndex
+	}
+	return 0
+}
+
+func (x *GetSubscriptionNotificationIndicesResponse) GetNextIndex() int32 {
+	if x != nil {
+		return x.NextIndex
+	}
+	return 0
+}
+
+type StreamLogEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Least severe level of the events to stream, such as "warn"; defaults to
+	// "info".
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *StreamLogEventsRequest) Reset() {
+	*x = StreamLogEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamLogEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogEventsRequest) ProtoMessage() {}
+
+func (x *StreamLogEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StreamLogEventsRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// An entry logged by this DSS instance.
+type LogEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// RFC 3339 time the entry was logged.
+	Time    string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Level   string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Logger  string `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// The complete entry, with its fields, as JSON.
+	Entry string `protobuf:"bytes,5,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Number of events dropped before this one because the client did not
+	// keep up with them, or because they exceeded the maximum message size.
+	Dropped int32 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// Set if the event exceeded the maximum message size, in which case its
+	// message and entry are omitted.
+	Oversized bool `protobuf:"varint,7,opt,name=oversized,proto3" json:"oversized,omitempty"`
+}
+
+func (x *LogEvent) Reset() {
+	*x = LogEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogEvent) ProtoMessage() {}
+
+func (x *LogEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogEvent.ProtoReflect.Descriptor instead.
+func (*LogEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LogEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *LogEvent) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogEvent) GetLogger() string {
+	if x != nil {
+		return x.Logger
+	}
+	return ""
+}
+
+func (x *LogEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogEvent) GetEntry() string {
+	if x != nil {
+		return x.Entry
+	}
+	return ""
+}
+
+func (x *LogEvent) GetDropped() int32 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *LogEvent) GetOversized() bool {
+	if x != nil {
+		return x.Oversized
+	}
+	return false
+}
+
+type RefreshStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RefreshStatisticsRequest) Reset() {
+	*x = RefreshStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshStatisticsRequest) ProtoMessage() {}
+
+func (x *RefreshStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*RefreshStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{40}
+}
+
+type RefreshStatisticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names of the tables whose statistics were refreshed.
+	Tables []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+}
+
+func (x *RefreshStatisticsResponse) Reset() {
+	*x = RefreshStatisticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshStatisticsResponse) ProtoMessage() {}
+
+func (x *RefreshStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*RefreshStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RefreshStatisticsResponse) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type GetDiagnosticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDiagnosticsRequest) Reset() {
+	*x = GetDiagnosticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{42}
+}
+
+type BuildDescription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Timestamp of the build.
+	Time string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Commit hash of the build.
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Host where the build happened.
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *BuildDescription) Reset() {
+	*x = BuildDescription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildDescription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildDescription) ProtoMessage() {}
+
+func (x *BuildDescription) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildDescription.ProtoReflect.Descriptor instead.
+func (*BuildDescription) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *BuildDescription) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *BuildDescription) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BuildDescription) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ConfigurationFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value of the flag, or REDACTED if it holds a secret.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// True if value was redacted, in part or in whole.
+	Redacted bool `protobuf:"varint,3,opt,name=redacted,proto3" json:"redacted,omitempty"`
+}
+
+func (x *ConfigurationFlag) Reset() {
+	*x = ConfigurationFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigurationFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationFlag) ProtoMessage() {}
+
+func (x *ConfigurationFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationFlag.ProtoReflect.Descriptor instead.
+func (*ConfigurationFlag) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ConfigurationFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigurationFlag) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConfigurationFlag) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+type SchemaVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// API whose database has this schema version, such as rid or scd.
+	Api     string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *SchemaVersion) Reset() {
+	*x = SchemaVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchemaVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchemaVersion) ProtoMessage() {}
+
+func (x *SchemaVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchemaVersion.ProtoReflect.Descriptor instead.
+func (*SchemaVersion) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SchemaVersion) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *SchemaVersion) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type DatabasePoolStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// API whose database is reached through this pool, such as rid or scd.
+	Api                string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	MaxOpenConnections int32  `protobuf:"varint,2,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	OpenConnections    int32  `protobuf:"varint,3,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	InUse              int32  `protobuf:"varint,4,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Idle               int32  `protobuf:"varint,5,opt,name=idle,proto3" json:"idle,omitempty"`
+	// Number of connections waited for, and for how long in total.
+	WaitCount      int64 `protobuf:"varint,6,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDurationMs int64 `protobuf:"varint,7,opt,name=wait_duration_ms,json=waitDurationMs,proto3" json:"wait_duration_ms,omitempty"`
+	// Number of connections closed for being idle, or too old.
+	MaxIdleClosed     int64 `protobuf:"varint,8,opt,name=max_idle_closed,json=maxIdleClosed,proto3" json:"max_idle_closed,omitempty"`
+	MaxLifetimeClosed int64 `protobuf:"varint,9,opt,name=max_lifetime_closed,json=maxLifetimeClosed,proto3" json:"max_lifetime_closed,omitempty"`
+}
+
+func (x *DatabasePoolStats) Reset() {
+	*x = DatabasePoolStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DatabasePoolStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabasePoolStats) ProtoMessage() {}
+
+func (x *DatabasePoolStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabasePoolStats.ProtoReflect.Descriptor instead.
+func (*DatabasePoolStats) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DatabasePoolStats) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *DatabasePoolStats) GetMaxOpenConnections() int32 {
+	if x != nil {
+		return x.MaxOpenConnections
+	}
+	return 0
+}
+
+func (x *DatabasePoolStats) GetOpenConnections() int32 {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return 0
+}
+
+func (x *DatabasePoolStats) GetInUse() int32 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *DatabasePoolStats) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *DatabasePoolStats) GetWaitCount() int64 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *DatabasePoolStats) GetWaitDurationMs() int64 {
+	if x != nil {
+		return x.WaitDurationMs
+	}
+	return 0
+}
+
+func (x *DatabasePoolStats) GetMaxIdleClosed() int64 {
+	if x != nil {
+		return x.MaxIdleClosed
+	}
+	return 0
+}
+
+func (x *DatabasePoolStats) GetMaxLifetimeClosed() int64 {
+	if x != nil {
+		return x.MaxLifetimeClosed
+	}
+	return 0
+}
+
+type GetDiagnosticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string            `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Build   *BuildDescription `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+	// Every command line flag of this DSS instance, ordered by name.
+	Configuration  []*ConfigurationFlag `protobuf:"bytes,3,rep,name=configuration,proto3" json:"configuration,omitempty"`
+	SchemaVersions []*SchemaVersion     `protobuf:"bytes,4,rep,name=schema_versions,json=schemaVersions,proto3" json:"schema_versions,omitempty"`
+	Pools          []*DatabasePoolStats `protobuf:"bytes,5,rep,name=pools,proto3" json:"pools,omitempty"`
+}
+
+func (x *GetDiagnosticsResponse) Reset() {
+	*x = GetDiagnosticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetDiagnosticsResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetBuild() *BuildDescription {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *GetDiagnosticsResponse) GetConfiguration() []*ConfigurationFlag {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+func (x *GetDiagnosticsResponse) GetSchemaVersions() []*SchemaVersion {
+	if x != nil {
+		return x.SchemaVersions
+	}
+	return nil
+}
+
+func (x *GetDiagnosticsResponse) GetPools() []*DatabasePoolStats {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+// Error response format for most errors
+type StandardErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Human-readable error message; should be identical to `message` content.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Code for error category; uses standard gRPC response codes*, plus some
+	// additional custom codes defined for this project (see
+	// pkg/errors/errors.go).
+	//
+	// *https://developers.google.com/maps-booking/reference/grpc-api/status_codes
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Human-readable error message.  Required by ASTM standards' APIs in most
+	// error responses.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// ID of error in the format E:<UUID> which allows fast lookups in the DSS
+	// logs to relate a client's observed error response from the DSS to the
+	// detailed logs related to that error in the internal DSS logs.
+	ErrorId string `protobuf:"bytes,4,opt,name=error_id,json=errorId,proto3" json:"error_id,omitempty"`
+}
+
+func (x *StandardErrorResponse) Reset() {
+	*x = StandardErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StandardErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StandardErrorResponse) ProtoMessage() {}
+
+func (x *StandardErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StandardErrorResponse.ProtoReflect.Descriptor instead.
+func (*StandardErrorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StandardErrorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StandardErrorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *StandardErrorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StandardErrorResponse) GetErrorId() string {
+	if x != nil {
+		return x.ErrorId
+	}
+	return ""
+}
+
+// Failure of one item of a batch operation.
+type ItemErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the item that failed.
+	Item string `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// Code for error category, as in StandardErrorResponse.
+	Code int32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Human-readable error message.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ItemErrorResponse) Reset() {
+	*x = ItemErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemErrorResponse) ProtoMessage() {}
+
+func (x *ItemErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemErrorResponse.ProtoReflect.Descriptor instead.
+func (*ItemErrorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ItemErrorResponse) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *ItemErrorResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ItemErrorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Error response detail reporting every failed item of a batch operation.
+type BatchErrorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ItemErrorResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *BatchErrorResponse) Reset() {
+	*x = BatchErrorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchErrorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchErrorResponse) ProtoMessage() {}
+
+func (x *BatchErrorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchErrorResponse.ProtoReflect.Descriptor instead.
+func (*BatchErrorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BatchErrorResponse) GetItems() []*ItemErrorResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_pkg_api_v1_auxpb_aux_service_proto protoreflect.FileDescriptor
+
+var file_pkg_api_v1_auxpb_aux_service_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2f, 0x61, 0x75, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x61, 0x75, 0x78, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x22, 0x2c, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x22, 0x17, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x61, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x0a, 0x23, 0x54, 0x65,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x6f, 0x0a, 0x24, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d,
+	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x15, 0x0a, 0x06, 0x61, 0x6c, 0x6c, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x6c, 0x4f, 0x66, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x6e, 0x79, 0x5f, 0x6f, 0x66,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6e, 0x79, 0x4f, 0x66, 0x22, 0x53, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x2d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xdd, 0x01, 0x0a, 0x21, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x57, 0x0a, 0x2b, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
+	0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x72, 0x65, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x48, 0x0a, 0x2c, 0x47, 0x65, 0x74,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x47, 0x61,
+	0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x20, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x24, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x21, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x15,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x22, 0x6c, 0x0a, 0x26, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0x5e, 0x0a, 0x1e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x6a, 0x0a, 0x27, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x31, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x81,
+	0x03, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57,
+	0x69, 0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75,
+	0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x46, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45,
+	0x6e, 0x64, 0x22, 0x64, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x29, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x69,
+	0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c,
+	0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65,
+	0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e,
+	0x64, 0x22, 0x67, 0x0a, 0x2a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0a,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x49,
+	0x64, 0x73, 0x22, 0x3f, 0x0a, 0x0b, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x4f, 0x56,
+	0x4e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65,
+	0x55, 0x72, 0x6c, 0x22, 0xb5, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x43, 0x0a, 0x13, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x4f, 0x56, 0x4e,
+	0x52, 0x12, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x68,
+	0x65, 0x6c, 0x64, 0x4f, 0x56, 0x4e, 0x52, 0x13, 0x77, 0x69, 0x74, 0x68, 0x68, 0x65, 0x6c, 0x64,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x51, 0x0a, 0x13, 0x53,
+	0x63, 0x61, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xea,
+	0x01, 0x0a, 0x1f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x22, 0x9d, 0x01, 0x0a, 0x26,
+	0x53, 0x63, 0x61, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e, 0x02, 0x0a, 0x12,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x22, 0x84, 0x01, 0x0a,
+	0x19, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x3b, 0x0a, 0x29, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x70, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x2e, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0xb4, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x67,
+	0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x19, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x59, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74,
+	0x65, 0x64, 0x22, 0x3b, 0x0a, 0x0d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xce, 0x02, 0x0a, 0x11, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x64, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x61, 0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x77, 0x61, 0x69, 0x74, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x69, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d,
+	0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x22, 0x90, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x70, 0x6f,
+	0x6f, 0x6c, 0x73, 0x22, 0x76, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x11, 0x49,
+	0x74, 0x65, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x44, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x32, 0xc9, 0x14, 0x0a, 0x0d, 0x44, 0x53, 0x53,
+	0x41, 0x75, 0x78, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
+	0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x6a,
+	0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x12,
+	0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x61, 0x75,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x61, 0x75, 0x74, 0x68, 0x12, 0xad, 0x01, 0x0a, 0x1c, 0x54,
+	0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x22, 0x2c, 0x2f, 0x61,
+	0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6e, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x1f, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x61, 0x75, 0x78,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12, 0xd6, 0x01, 0x0a, 0x26, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x73, 0x12, 0x34, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x61, 0x75, 0x78,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x2f, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0xca, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x32, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65,
+	0x61, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x33, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f,
+	0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x2f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x63, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4b, 0x65,
+	0x79, 0x12, 0x1b, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63,
+	0x64, 0x2f, 0x6b, 0x65, 0x79, 0x12, 0x85, 0x01, 0x0a, 0x18, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x78,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x47, 0x61, 0x72, 0x62, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x10, 0x2f, 0x61, 0x75,
+	0x78, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x67, 0x63, 0x12, 0xbf, 0x01,
+	0x0a, 0x1f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
+	0x73, 0x12, 0x2d, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x2f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12,
+	0x99, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x61,
+	0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x74, 0x68,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x63, 0x64, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xc2, 0x01, 0x0a, 0x22,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f,
+	0x75, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x69, 0x6e,
+	0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x54, 0x6f, 0x75, 0x63, 0x68,
+	0x69, 0x6e, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12,
+	0x2f, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73,
+	0x63, 0x64, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0xa3, 0x01, 0x0a, 0x1e, 0x53, 0x63, 0x61, 0x6e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e,
+	0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x12, 0x7a, 0x0a, 0x11, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e,
+	0x53, 0x63, 0x61, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x21, 0x12, 0x1f, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x72, 0x69, 0x64, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0xcc, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x61, 0x75, 0x78, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x61, 0x75,
+	0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x65, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61,
+	0x75, 0x78, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6c, 0x6f, 0x67, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x30, 0x01, 0x12, 0x78, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1f, 0x2e,
+	0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x18, 0x2f, 0x61, 0x75, 0x78, 0x2f, 0x76,
+	0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x70, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x78, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x75, 0x78, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x42, 0x12, 0x5a, 0x10, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x78, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce sync.Once
+	file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = file_pkg_api_v1_auxpb_aux_service_proto_rawDesc
+)
+
+func file_pkg_api_v1_auxpb_aux_service_proto_rawDescGZIP() []byte {
+	file_pkg_api_v1_auxpb_aux_service_proto_rawDescOnce.Do(func() {
+		file_pkg_api_v1_auxpb_aux_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_v1_auxpb_aux_service_proto_rawDescData)
+	})
+	return file_pkg_api_v1_auxpb_aux_service_proto_rawDescData
+}
+
+var file_pkg_api_v1_auxpb_aux_service_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_pkg_api_v1_auxpb_aux_service_proto_goTypes = []interface{}{
+	(*Version)(nil),                                        // 0: auxpb.Version
+	(*GetVersionRequest)(nil),                              // 1: auxpb.GetVersionRequest
+	(*GetVersionResponse)(nil),                             // 2: auxpb.GetVersionResponse
+	(*GetRegionRequest)(nil),                               // 3: auxpb.GetRegionRequest
+	(*GetRegionResponse)(nil),                              // 4: auxpb.GetRegionResponse
+	(*ValidateOauthRequest)(nil),                           // 5: auxpb.ValidateOauthRequest
+	(*ValidateOauthResponse)(nil),                          // 6: auxpb.ValidateOauthResponse
+	(*TestSubscriptionNotificationRequest)(nil),            // 7: auxpb.TestSubscriptionNotificationRequest
+	(*TestSubscriptionNotificationResponse)(nil),           // 8: auxpb.TestSubscriptionNotificationResponse
+	(*GetRequiredScopesRequest)(nil),                       // 9: auxpb.GetRequiredScopesRequest
+	(*OperationScopes)(nil),                                // 10: auxpb.OperationScopes
+	(*GetRequiredScopesResponse)(nil),                      // 11: auxpb.GetRequiredScopesResponse
+	(*ListModifiedIdentificationServiceAreasRequest)(nil),  // 12: auxpb.ListModifiedIdentificationServiceAreasRequest
+	(*ModifiedIdentificationServiceArea)(nil),              // 13: auxpb.ModifiedIdentificationServiceArea
+	(*ListModifiedIdentificationServiceAreasResponse)(nil), // 14: auxpb.ListModifiedIdentificationServiceAreasResponse
+	(*GetIdentificationServiceAreaCoverageRequest)(nil),    // 15: auxpb.GetIdentificationServiceAreaCoverageRequest
+	(*GetIdentificationServiceAreaCoverageResponse)(nil),   // 16: auxpb.GetIdentificationServiceAreaCoverageResponse
+	(*TriggerGarbageCollectionRequest)(nil),                // 17: auxpb.TriggerGarbageCollectionRequest
+	(*TriggerGarbageCollectionResponse)(nil),               // 18: auxpb.TriggerGarbageCollectionResponse
+	(*CountIdentificationServiceAreasRequest)(nil),         // 19: auxpb.CountIdentificationServiceAreasRequest
+	(*IdentificationServiceAreaCount)(nil),                 // 20: auxpb.IdentificationServiceAreaCount
+	(*CountIdentificationServiceAreasResponse)(nil),        // 21: auxpb.CountIdentificationServiceAreasResponse
+	(*GetSubscriptionWithOwnerRequest)(nil),                // 22: auxpb.GetSubscriptionWithOwnerRequest
+	(*SubscriptionWithOwner)(nil),                          // 23: auxpb.SubscriptionWithOwner
+	(*GetSubscriptionWithOwnerResponse)(nil),               // 24: auxpb.GetSubscriptionWithOwnerResponse
+	(*ListOperationsTouchingSubscriptionRequest)(nil),      // 25: auxpb.ListOperationsTouchingSubscriptionRequest
+	(*OperationWithOwner)(nil),                             // 26: auxpb.OperationWithOwner
+	(*ListOperationsTouchingSubscriptionResponse)(nil),     // 27: auxpb.ListOperationsTouchingSubscriptionResponse
+	(*GetCurrentKeyRequest)(nil),                           // 28: auxpb.GetCurrentKeyRequest
+	(*WithheldOVN)(nil),                                    // 29: auxpb.WithheldOVN
+	(*GetCurrentKeyResponse)(nil),                          // 30: auxpb.GetCurrentKeyResponse
+	(*ScanRemoteIDRequest)(nil),                            // 31: auxpb.ScanRemoteIDRequest
+	(*StoredIdentificationServiceArea)(nil),                // 32: auxpb.StoredIdentificationServiceArea
+	(*ScanIdentificationServiceAreasResponse)(nil),         // 33: auxpb.ScanIdentificationServiceAreasResponse
+	(*StoredSubscription)(nil),                             // 34: auxpb.StoredSubscription
+	(*ScanSubscriptionsResponse)(nil),                      // 35: auxpb.ScanSubscriptionsResponse
+	(*GetSubscriptionNotificationIndicesRequest)(nil),      // 36: auxpb.GetSubscriptionNotificationIndicesRequest
+	(*GetSubscriptionNotificationIndicesResponse)(nil),     // 37: auxpb.GetSubscriptionNotificationIndicesResponse
+	(*StreamLogEventsRequest)(nil),                         // 38: auxpb.StreamLogEventsRequest
+	(*LogEvent)(nil),                                       // 39: auxpb.LogEvent
+	(*RefreshStatisticsRequest)(nil),                       // 40: auxpb.RefreshStatisticsRequest
+	(*RefreshStatisticsResponse)(nil),                      // 41: auxpb.RefreshStatisticsResponse
+	(*GetDiagnosticsRequest)(nil),                          // 42: auxpb.GetDiagnosticsRequest
+	(*BuildDescription)(nil),                               // 43: auxpb.BuildDescription
+	(*ConfigurationFlag)(nil),                              // 44: auxpb.ConfigurationFlag
+	(*SchemaVersion)(nil),                                  // 45: auxpb.SchemaVersion
+	(*DatabasePoolStats)(nil),                              // 46: auxpb.DatabasePoolStats
+	(*GetDiagnosticsResponse)(nil),                         // 47: auxpb.GetDiagnosticsResponse
+	(*StandardErrorResponse)(nil),                          // 48: auxpb.StandardErrorResponse
+	(*ItemErrorResponse)(nil),                              // 49: auxpb.ItemErrorResponse
+	(*BatchErrorResponse)(nil),                             // 50: auxpb.BatchErrorResponse
+}
+var file_pkg_api_v1_auxpb_aux_service_proto_depIdxs = []int32{
+	0,  // 0: auxpb.GetVersionResponse.version:type_name -> auxpb.Version
+	10, // 1: auxpb.GetRequiredScopesResponse.operations:type_name -> auxpb.OperationScopes
+	13, // 2: auxpb.ListModifiedIdentificationServiceAreasResponse.service_areas:type_name -> auxpb.ModifiedIdentificationServiceArea
+	20, // 3: auxpb.CountIdentificationServiceAreasResponse.buckets:type_name -> auxpb.IdentificationServiceAreaCount
+	23, // 4: auxpb.GetSubscriptionWithOwnerResponse.subscription:type_name -> auxpb.SubscriptionWithOwner
+	26, // 5: auxpb.ListOperationsTouchingSubscriptionResponse.operations:type_name -> auxpb.OperationWithOwner
+	29, // 6: auxpb.GetCurrentKeyResponse.withheld_operations:type_name -> auxpb.WithheldOVN
+	29, // 7: auxpb.GetCurrentKeyResponse.withheld_constraints:type_name -> auxpb.WithheldOVN
+	32, // 8: auxpb.ScanIdentificationServiceAreasResponse.service_areas:type_name -> auxpb.StoredIdentificationServiceArea
+	34, // 9: auxpb.ScanSubscriptionsResponse.subscriptions:type_name -> auxpb.StoredSubscription
+	43, // 10: auxpb.GetDiagnosticsResponse.build:type_name -> auxpb.BuildDescription
+	44, // 11: auxpb.GetDiagnosticsResponse.configuration:type_name -> auxpb.ConfigurationFlag
+	45, // 12: auxpb.GetDiagnosticsResponse.schema_versions:type_name -> auxpb.SchemaVersion
+	46, // 13: auxpb.GetDiagnosticsResponse.pools:type_name -> auxpb.DatabasePoolStats
+	49, // 14: auxpb.BatchErrorResponse.items:type_name -> auxpb.ItemErrorResponse
+	1,  // 15: auxpb.DSSAuxService.GetVersion:input_type -> auxpb.GetVersionRequest
+	3,  // 16: auxpb.DSSAuxService.GetRegion:input_type -> auxpb.GetRegionRequest
+	5,  // 17: auxpb.DSSAuxService.ValidateOauth:input_type -> auxpb.ValidateOauthRequest
+	7,  // 18: auxpb.DSSAuxService.TestSubscriptionNotification:input_type -> auxpb.TestSubscriptionNotificationRequest
+	9,  // 19: auxpb.DSSAuxService.GetRequiredScopes:input_type -> auxpb.GetRequiredScopesRequest
+	12, // 20: auxpb.DSSAuxService.ListModifiedIdentificationServiceAreas:input_type -> auxpb.ListModifiedIdentificationServiceAreasRequest
+	15, // 21: auxpb.DSSAuxService.GetIdentificationServiceAreaCoverage:input_type -> auxpb.GetIdentificationServiceAreaCoverageRequest
+	28, // 22: auxpb.DSSAuxService.GetCurrentKey:input_type -> auxpb.GetCurrentKeyRequest
+	17, // 23: auxpb.DSSAuxService.TriggerGarbageCollection:input_type -> auxpb.TriggerGarbageCollectionRequest
+	19, // 24: auxpb.DSSAuxService.CountIdentificationServiceAreas:input_type -> auxpb.CountIdentificationServiceAreasRequest
+	22, // 25: auxpb.DSSAuxService.GetSubscriptionWithOwner:input_type -> auxpb.GetSubscriptionWithOwnerRequest
+	25, // 26: auxpb.DSSAuxService.ListOperationsTouchingSubscription:input_type -> auxpb.ListOperationsTouchingSubscriptionRequest
+	31, // 27: auxpb.DSSAuxService.ScanIdentificationServiceAreas:input_type -> auxpb.ScanRemoteIDRequest
+	31, // 28: auxpb.DSSAuxService.ScanSubscriptions:input_type -> auxpb.ScanRemoteIDRequest
+	36, // 29: auxpb.DSSAuxService.GetSubscriptionNotificationIndices:input_type -> auxpb.GetSubscriptionNotificationIndicesRequest
+	38, // 30: auxpb.DSSAuxService.StreamLogEvents:input_type -> auxpb.StreamLogEventsRequest
+	40, // 31: auxpb.DSSAuxService.RefreshStatistics:input_type -> auxpb.RefreshStatisticsRequest
+	42, // 32: auxpb.DSSAuxService.GetDiagnostics:input_type -> auxpb.GetDiagnosticsRequest
+	2,  // 33: auxpb.DSSAuxService.GetVersion:output_type -> auxpb.GetVersionResponse
+	4,  // 34: auxpb.DSSAuxService.GetRegion:output_type -> auxpb.GetRegionResponse
+	6,  // 35: auxpb.DSSAuxService.ValidateOauth:output_type -> auxpb.ValidateOauthResponse
+	8,  // 36: auxpb.DSSAuxService.TestSubscriptionNotification:output_type -> auxpb.TestSubscriptionNotificationResponse
+	11, // 37: auxpb.DSSAuxService.GetRequiredScopes:output_type -> auxpb.GetRequiredScopesResponse
+	14, // 38: auxpb.DSSAuxService.ListModifiedIdentificationServiceAreas:output_type -> auxpb.ListModifiedIdentificationServiceAreasResponse
+	16, // 39: auxpb.DSSAuxService.GetIdentificationServiceAreaCoverage:output_type -> auxpb.GetIdentificationServiceAreaCoverageResponse
+	30, // 40: auxpb.DSSAuxService.GetCurrentKey:output_type -> auxpb.GetCurrentKeyResponse
+	18, // 41: auxpb.DSSAuxService.TriggerGarbageCollection:output_type -> auxpb.TriggerGarbageCollectionResponse
+	21, // 42: auxpb.DSSAuxService.CountIdentificationServiceAreas:output_type -> auxpb.CountIdentificationServiceAreasResponse
+	24, // 43: auxpb.DSSAuxService.GetSubscriptionWithOwner:output_type -> auxpb.GetSubscriptionWithOwnerResponse
+	27, // 44: auxpb.DSSAuxService.ListOperationsTouchingSubscription:output_type -> auxpb.ListOperationsTouchingSubscriptionResponse
+	33, // 45: auxpb.DSSAuxService.ScanIdentificationServiceAreas:output_type -> auxpb.ScanIdentificationServiceAreasResponse
+	35, // 46: auxpb.DSSAuxService.ScanSubscriptions:output_type -> auxpb.ScanSubscriptionsResponse
+	37, // 47: auxpb.DSSAuxService.GetSubscriptionNotificationIndices:output_type -> auxpb.GetSubscriptionNotificationIndicesResponse
+	39, // 48: auxpb.DSSAuxService.StreamLogEvents:output_type -> auxpb.LogEvent
+	41, // 49: auxpb.DSSAuxService.RefreshStatistics:output_type -> auxpb.RefreshStatisticsResponse
+	47, // 50: auxpb.DSSAuxService.GetDiagnostics:output_type -> auxpb.GetDiagnosticsResponse
+	33, // [33:51] is the sub-list for method output_type
+	15, // [15:33] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_pkg_api_v1_auxpb_aux_service_proto_init() }
+func file_pkg_api_v1_auxpb_aux_service_proto_init() {
+	if File_pkg_api_v1_auxpb_aux_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRegionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateOauthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateOauthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSubscriptionNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSubscriptionNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequiredScopesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationScopes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRequiredScopesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModifiedIdentificationServiceAreasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifiedIdentificationServiceArea); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModifiedIdentificationServiceAreasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentificationServiceAreaCoverageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentificationServiceAreaCoverageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerGarbageCollectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerGarbageCollectionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountIdentificationServiceAreasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentificationServiceAreaCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountIdentificationServiceAreasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionWithOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionWithOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionWithOwnerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsTouchingSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationWithOwner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOperationsTouchingSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithheldOVN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCurrentKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanRemoteIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoredIdentificationServiceArea); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -434,8 +4256,8 @@ func file_pkg_api_v1_auxpb_aux_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionResponse); i {
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanIdentificationServiceAreasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -446,8 +4268,8 @@ func file_pkg_api_v1_auxpb_aux_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateOauthRequest); i {
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoredSubscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -458,8 +4280,8 @@ func file_pkg_api_v1_auxpb_aux_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidateOauthResponse); i {
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanSubscriptionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -470,7 +4292,151 @@ func file_pkg_api_v1_auxpb_aux_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionNotificationIndicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionNotificationIndicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamLogEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshStatisticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshStatisticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiagnosticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildDescription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigurationFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchemaVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DatabasePoolStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiagnosticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StandardErrorResponse); i {
 			case 0:
 				return &v.state
@@ -482,6 +4448,30 @@ func file_pkg_api_v1_auxpb_aux_service_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_auxpb_aux_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchErrorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -489,7 +4479,7 @@ func file_pkg_api_v1_auxpb_aux_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_api_v1_auxpb_aux_service_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -519,10 +4509,67 @@ type DSSAuxServiceClient interface {
 	//
 	// Queries the version of the DSS.
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	// Queries the DSS region, or pool, this DSS instance belongs to.
+	GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error)
 	// /dss/validate_oauth
 	//
 	// Validate Oauth token against the DSS.
 	ValidateOauth(ctx context.Context, in *ValidateOauthRequest, opts ...grpc.CallOption) (*ValidateOauthResponse, error)
+	// Deliver a test notification to the callback URL of one of the caller's
+	// own remote ID Subscriptions, and report the outcome.
+	TestSubscriptionNotification(ctx context.Context, in *TestSubscriptionNotificationRequest, opts ...grpc.CallOption) (*TestSubscriptionNotificationResponse, error)
+	// Lists the scopes required by each operation served by this DSS instance.
+	GetRequiredScopes(ctx context.Context, in *GetRequiredScopesRequest, opts ...grpc.CallOption) (*GetRequiredScopesResponse, error)
+	// Lists the remote ID Identification Service Areas of any USS written
+	// since a given time, oldest first, for tools synchronizing changes
+	// incrementally.  Deleted ISAs are not reported.
+	//
+	// ISAs are stamped with the time their write began rather than when it was
+	// committed, so an ISA committed after a page was listed may be stamped
+	// before that page's token and is then not listed by later pages.  Clients
+	// needing every change should periodically list again from a time before
+	// their last token, by more than the longest write.
+	ListModifiedIdentificationServiceAreas(ctx context.Context, in *ListModifiedIdentificationServiceAreasRequest, opts ...grpc.CallOption) (*ListModifiedIdentificationServiceAreasResponse, error)
+	// Reports whether any remote ID Identification Service Area covers part of
+	// an area, for clients deciding whether to search it in detail.
+	GetIdentificationServiceAreaCoverage(ctx context.Context, in *GetIdentificationServiceAreaCoverageRequest, opts ...grpc.CallOption) (*GetIdentificationServiceAreaCoverageResponse, error)
+	// Returns, in one call, the current OVNs of the strategic conflict
+	// detection entities reported as missing from the key of a rejected
+	// Operation, for clients retrying after a conflict.
+	GetCurrentKey(ctx context.Context, in *GetCurrentKeyRequest, opts ...grpc.CallOption) (*GetCurrentKeyResponse, error)
+	// Runs one garbage collection pass over expired remote ID entities, after
+	// any pass already in progress, and reports how many were removed.
+	TriggerGarbageCollection(ctx context.Context, in *TriggerGarbageCollectionRequest, opts ...grpc.CallOption) (*TriggerGarbageCollectionResponse, error)
+	// Counts the remote ID Identification Service Areas active during each of
+	// a series of time buckets, for load analysis.
+	CountIdentificationServiceAreas(ctx context.Context, in *CountIdentificationServiceAreasRequest, opts ...grpc.CallOption) (*CountIdentificationServiceAreasResponse, error)
+	// Retrieves a strategic conflict detection Subscription of any USS along
+	// with the identity of its owner, for operators of this DSS instance.
+	GetSubscriptionWithOwner(ctx context.Context, in *GetSubscriptionWithOwnerRequest, opts ...grpc.CallOption) (*GetSubscriptionWithOwnerResponse, error)
+	// Lists the strategic conflict detection Operations of any USS sharing a
+	// cell with a Subscription while it is active, for operators debugging
+	// which Operations its owner is notified of.
+	ListOperationsTouchingSubscription(ctx context.Context, in *ListOperationsTouchingSubscriptionRequest, opts ...grpc.CallOption) (*ListOperationsTouchingSubscriptionResponse, error)
+	// Lists every remote ID Identification Service Area in pages ordered by
+	// ID, for tools migrating data between DSS instances.
+	ScanIdentificationServiceAreas(ctx context.Context, in *ScanRemoteIDRequest, opts ...grpc.CallOption) (*ScanIdentificationServiceAreasResponse, error)
+	// Lists every remote ID Subscription in pages ordered by ID, for tools
+	// migrating data between DSS instances.
+	ScanSubscriptions(ctx context.Context, in *ScanRemoteIDRequest, opts ...grpc.CallOption) (*ScanSubscriptionsResponse, error)
+	// Returns the current notification index of a remote ID Subscription and
+	// the index its next notification will carry, for reconciling the
+	// notifications a USS received with those the DSS sent.
+	GetSubscriptionNotificationIndices(ctx context.Context, in *GetSubscriptionNotificationIndicesRequest, opts ...grpc.CallOption) (*GetSubscriptionNotificationIndicesResponse, error)
+	// Streams the most recent events logged by this DSS instance, followed by
+	// those logged until the client disconnects, for debugging live incidents.
+	StreamLogEvents(ctx context.Context, in *StreamLogEventsRequest, opts ...grpc.CallOption) (DSSAuxService_StreamLogEventsClient, error)
+	// Refreshes the statistics the database plans queries from on the tables
+	// of every enabled API, for operators to run after bulk loads.
+	RefreshStatistics(ctx context.Context, in *RefreshStatisticsRequest, opts ...grpc.CallOption) (*RefreshStatisticsResponse, error)
+	// Returns the version, build, configuration with secrets redacted, schema
+	// versions and database pool statistics of this DSS instance, for
+	// operators to attach to support tickets.
+	GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*GetDiagnosticsResponse, error)
 }
 
 type dSSAuxServiceClient struct {
@@ -542,6 +4589,15 @@ func (c *dSSAuxServiceClient) GetVersion(ctx context.Context, in *GetVersionRequ
 	return out, nil
 }
 
+func (c *dSSAuxServiceClient) GetRegion(ctx context.Context, in *GetRegionRequest, opts ...grpc.CallOption) (*GetRegionResponse, error) {
+	out := new(GetRegionResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetRegion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dSSAuxServiceClient) ValidateOauth(ctx context.Context, in *ValidateOauthRequest, opts ...grpc.CallOption) (*ValidateOauthResponse, error) {
 	out := new(ValidateOauthResponse)
 	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ValidateOauth", in, out, opts...)
@@ -551,16 +4607,231 @@ func (c *dSSAuxServiceClient) ValidateOauth(ctx context.Context, in *ValidateOau
 	return out, nil
 }
 
+func (c *dSSAuxServiceClient) TestSubscriptionNotification(ctx context.Context, in *TestSubscriptionNotificationRequest, opts ...grpc.CallOption) (*TestSubscriptionNotificationResponse, error) {
+	out := new(TestSubscriptionNotificationResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/TestSubscriptionNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetRequiredScopes(ctx context.Context, in *GetRequiredScopesRequest, opts ...grpc.CallOption) (*GetRequiredScopesResponse, error) {
+	out := new(GetRequiredScopesResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetRequiredScopes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ListModifiedIdentificationServiceAreas(ctx context.Context, in *ListModifiedIdentificationServiceAreasRequest, opts ...grpc.CallOption) (*ListModifiedIdentificationServiceAreasResponse, error) {
+	out := new(ListModifiedIdentificationServiceAreasResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ListModifiedIdentificationServiceAreas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetIdentificationServiceAreaCoverage(ctx context.Context, in *GetIdentificationServiceAreaCoverageRequest, opts ...grpc.CallOption) (*GetIdentificationServiceAreaCoverageResponse, error) {
+	out := new(GetIdentificationServiceAreaCoverageResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetIdentificationServiceAreaCoverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetCurrentKey(ctx context.Context, in *GetCurrentKeyRequest, opts ...grpc.CallOption) (*GetCurrentKeyResponse, error) {
+	out := new(GetCurrentKeyResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetCurrentKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) TriggerGarbageCollection(ctx context.Context, in *TriggerGarbageCollectionRequest, opts ...grpc.CallOption) (*TriggerGarbageCollectionResponse, error) {
+	out := new(TriggerGarbageCollectionResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/TriggerGarbageCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) CountIdentificationServiceAreas(ctx context.Context, in *CountIdentificationServiceAreasRequest, opts ...grpc.CallOption) (*CountIdentificationServiceAreasResponse, error) {
+	out := new(CountIdentificationServiceAreasResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/CountIdentificationServiceAreas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetSubscriptionWithOwner(ctx context.Context, in *GetSubscriptionWithOwnerRequest, opts ...grpc.CallOption) (*GetSubscriptionWithOwnerResponse, error) {
+	out := new(GetSubscriptionWithOwnerResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetSubscriptionWithOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ListOperationsTouchingSubscription(ctx context.Context, in *ListOperationsTouchingSubscriptionRequest, opts ...grpc.CallOption) (*ListOperationsTouchingSubscriptionResponse, error) {
+	out := new(ListOperationsTouchingSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ListOperationsTouchingSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ScanIdentificationServiceAreas(ctx context.Context, in *ScanRemoteIDRequest, opts ...grpc.CallOption) (*ScanIdentificationServiceAreasResponse, error) {
+	out := new(ScanIdentificationServiceAreasResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ScanIdentificationServiceAreas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) ScanSubscriptions(ctx context.Context, in *ScanRemoteIDRequest, opts ...grpc.CallOption) (*ScanSubscriptionsResponse, error) {
+	out := new(ScanSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/ScanSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetSubscriptionNotificationIndices(ctx context.Context, in *GetSubscriptionNotificationIndicesRequest, opts ...grpc.CallOption) (*GetSubscriptionNotificationIndicesResponse, error) {
+	out := new(GetSubscriptionNotificationIndicesResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetSubscriptionNotificationIndices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) StreamLogEvents(ctx context.Context, in *StreamLogEventsRequest, opts ...grpc.CallOption) (DSSAuxService_StreamLogEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DSSAuxService_serviceDesc.Streams[0], "/auxpb.DSSAuxService/StreamLogEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dSSAuxServiceStreamLogEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DSSAuxService_StreamLogEventsClient interface {
+	Recv() (*LogEvent, error)
+	grpc.ClientStream
+}
+
+type dSSAuxServiceStreamLogEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *dSSAuxServiceStreamLogEventsClient) Recv() (*LogEvent, error) {
+	m := new(LogEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dSSAuxServiceClient) RefreshStatistics(ctx context.Context, in *RefreshStatisticsRequest, opts ...grpc.CallOption) (*RefreshStatisticsResponse, error) {
+	out := new(RefreshStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/RefreshStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dSSAuxServiceClient) GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*GetDiagnosticsResponse, error) {
+	out := new(GetDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/auxpb.DSSAuxService/GetDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DSSAuxServiceServer is the server API for DSSAuxService service.
 type DSSAuxServiceServer interface {
 	// /dss/version
 	//
 	// Queries the version of the DSS.
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	// Queries the DSS region, or pool, this DSS instance belongs to.
+	GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error)
 	// /dss/validate_oauth
 	//
 	// Validate Oauth token against the DSS.
 	ValidateOauth(context.Context, *ValidateOauthRequest) (*ValidateOauthResponse, error)
+	// Deliver a test notification to the callback URL of one of the caller's
+	// own remote ID Subscriptions, and report the outcome.
+	TestSubscriptionNotification(context.Context, *TestSubscriptionNotificationRequest) (*TestSubscriptionNotificationResponse, error)
+	// Lists the scopes required by each operation served by this DSS instance.
+	GetRequiredScopes(context.Context, *GetRequiredScopesRequest) (*GetRequiredScopesResponse, error)
+	// Lists the remote ID Identification Service Areas of any USS written
+	// since a given time, oldest first, for tools synchronizing changes
+	// incrementally.  Deleted ISAs are not reported.
+	//
+	// ISAs are stamped with the time their write began rather than when it was
+	// committed, so an ISA committed after a page was listed may be stamped
+	// before that page's token and is then not listed by later pages.  Clients
+	// needing every change should periodically list again from a time before
+	// their last token, by more than the longest write.
+	ListModifiedIdentificationServiceAreas(context.Context, *ListModifiedIdentificationServiceAreasRequest) (*ListModifiedIdentificationServiceAreasResponse, error)
+	// Reports whether any remote ID Identification Service Area covers part of
+	// an area, for clients deciding whether to search it in detail.
+	GetIdentificationServiceAreaCoverage(context.Context, *GetIdentificationServiceAreaCoverageRequest) (*GetIdentificationServiceAreaCoverageResponse, error)
+	// Returns, in one call, the current OVNs of the strategic conflict
+	// detection entities reported as missing from the key of a rejected
+	// Operation, for clients retrying after a conflict.
+	GetCurrentKey(context.Context, *GetCurrentKeyRequest) (*GetCurrentKeyResponse, error)
+	// Runs one garbage collection pass over expired remote ID entities, after
+	// any pass already in progress, and reports how many were removed.
+	TriggerGarbageCollection(context.Context, *TriggerGarbageCollectionRequest) (*TriggerGarbageCollectionResponse, error)
+	// Counts the remote ID Identification Service Areas active during each of
+	// a series of time buckets, for load analysis.
+	CountIdentificationServiceAreas(context.Context, *CountIdentificationServiceAreasRequest) (*CountIdentificationServiceAreasResponse, error)
+	// Retrieves a strategic conflict detection Subscription of any USS along
+	// with the identity of its owner, for operators of this DSS instance.
+	GetSubscriptionWithOwner(context.Context, *GetSubscriptionWithOwnerRequest) (*GetSubscriptionWithOwnerResponse, error)
+	// Lists the strategic conflict detection Operations of any USS sharing a
+	// cell with a Subscription while it is active, for operators debugging
+	// which Operations its owner is notified of.
+	ListOperationsTouchingSubscription(context.Context, *ListOperationsTouchingSubscriptionRequest) (*ListOperationsTouchingSubscriptionResponse, error)
+	// Lists every remote ID Identification Service Area in pages ordered by
+	// ID, for tools migrating data between DSS instances.
+	ScanIdentificationServiceAreas(context.Context, *ScanRemoteIDRequest) (*ScanIdentificationServiceAreasResponse, error)
+	// Lists every remote ID Subscription in pages ordered by ID, for tools
+	// migrating data between DSS instances.
+	ScanSubscriptions(context.Context, *ScanRemoteIDRequest) (*ScanSubscriptionsResponse, error)
+	// Returns the current notification index of a remote ID Subscription and
+	// the index its next notification will carry, for reconciling the
+	// notifications a USS received with those the DSS sent.
+	GetSubscriptionNotificationIndices(context.Context, *GetSubscriptionNotificationIndicesRequest) (*GetSubscriptionNotificationIndicesResponse, error)
+	// Streams the most recent events logged by this DSS instance, followed by
+	// those logged until the client disconnects, for debugging live incidents.
+	StreamLogEvents(*StreamLogEventsRequest, DSSAuxService_StreamLogEventsServer) error
+	// Refreshes the statistics the database plans queries from on the tables
+	// of every enabled API, for operators to run after bulk loads.
+	RefreshStatistics(context.Context, *RefreshStatisticsRequest) (*RefreshStatisticsResponse, error)
+	// Returns the version, build, configuration with secrets redacted, schema
+	// versions and database pool statistics of this DSS instance, for
+	// operators to attach to support tickets.
+	GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*GetDiagnosticsResponse, error)
 }
 
 // UnimplementedDSSAuxServiceServer can be embedded to have forward compatible implementations.
@@ -570,9 +4841,57 @@ type UnimplementedDSSAuxServiceServer struct {
 func (*UnimplementedDSSAuxServiceServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
+func (*UnimplementedDSSAuxServiceServer) GetRegion(context.Context, *GetRegionRequest) (*GetRegionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegion not implemented")
+}
 func (*UnimplementedDSSAuxServiceServer) ValidateOauth(context.Context, *ValidateOauthRequest) (*ValidateOauthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateOauth not implemented")
 }
+func (*UnimplementedDSSAuxServiceServer) TestSubscriptionNotification(context.Context, *TestSubscriptionNotificationRequest) (*TestSubscriptionNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestSubscriptionNotification not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetRequiredScopes(context.Context, *GetRequiredScopesRequest) (*GetRequiredScopesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequiredScopes not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ListModifiedIdentificationServiceAreas(context.Context, *ListModifiedIdentificationServiceAreasRequest) (*ListModifiedIdentificationServiceAreasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModifiedIdentificationServiceAreas not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetIdentificationServiceAreaCoverage(context.Context, *GetIdentificationServiceAreaCoverageRequest) (*GetIdentificationServiceAreaCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentificationServiceAreaCoverage not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetCurrentKey(context.Context, *GetCurrentKeyRequest) (*GetCurrentKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentKey not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) TriggerGarbageCollection(context.Context, *TriggerGarbageCollectionRequest) (*TriggerGarbageCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerGarbageCollection not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) CountIdentificationServiceAreas(context.Context, *CountIdentificationServiceAreasRequest) (*CountIdentificationServiceAreasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountIdentificationServiceAreas not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetSubscriptionWithOwner(context.Context, *GetSubscriptionWithOwnerRequest) (*GetSubscriptionWithOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionWithOwner not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ListOperationsTouchingSubscription(context.Context, *ListOperationsTouchingSubscriptionRequest) (*ListOperationsTouchingSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperationsTouchingSubscription not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ScanIdentificationServiceAreas(context.Context, *ScanRemoteIDRequest) (*ScanIdentificationServiceAreasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanIdentificationServiceAreas not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) ScanSubscriptions(context.Context, *ScanRemoteIDRequest) (*ScanSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanSubscriptions not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetSubscriptionNotificationIndices(context.Context, *GetSubscriptionNotificationIndicesRequest) (*GetSubscriptionNotificationIndicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscriptionNotificationIndices not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) StreamLogEvents(*StreamLogEventsRequest, DSSAuxService_StreamLogEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogEvents not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) RefreshStatistics(context.Context, *RefreshStatisticsRequest) (*RefreshStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshStatistics not implemented")
+}
+func (*UnimplementedDSSAuxServiceServer) GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*GetDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnostics not implemented")
+}
 
 func RegisterDSSAuxServiceServer(s *grpc.Server, srv DSSAuxServiceServer) {
 	s.RegisterService(&_DSSAuxService_serviceDesc, srv)
@@ -596,6 +4915,24 @@ func _DSSAuxService_GetVersion_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DSSAuxService_GetRegion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetRegion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetRegion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetRegion(ctx, req.(*GetRegionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DSSAuxService_ValidateOauth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateOauthRequest)
 	if err := dec(in); err != nil {
@@ -614,6 +4951,279 @@ func _DSSAuxService_ValidateOauth_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DSSAuxService_TestSubscriptionNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestSubscriptionNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).TestSubscriptionNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/TestSubscriptionNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).TestSubscriptionNotification(ctx, req.(*TestSubscriptionNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetRequiredScopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequiredScopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetRequiredScopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetRequiredScopes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetRequiredScopes(ctx, req.(*GetRequiredScopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ListModifiedIdentificationServiceAreas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModifiedIdentificationServiceAreasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ListModifiedIdentificationServiceAreas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ListModifiedIdentificationServiceAreas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ListModifiedIdentificationServiceAreas(ctx, req.(*ListModifiedIdentificationServiceAreasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetIdentificationServiceAreaCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentificationServiceAreaCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetIdentificationServiceAreaCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetIdentificationServiceAreaCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetIdentificationServiceAreaCoverage(ctx, req.(*GetIdentificationServiceAreaCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetCurrentKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetCurrentKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetCurrentKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetCurrentKey(ctx, req.(*GetCurrentKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_TriggerGarbageCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerGarbageCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).TriggerGarbageCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/TriggerGarbageCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).TriggerGarbageCollection(ctx, req.(*TriggerGarbageCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_CountIdentificationServiceAreas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountIdentificationServiceAreasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).CountIdentificationServiceAreas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/CountIdentificationServiceAreas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).CountIdentificationServiceAreas(ctx, req.(*CountIdentificationServiceAreasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetSubscriptionWithOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionWithOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetSubscriptionWithOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetSubscriptionWithOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetSubscriptionWithOwner(ctx, req.(*GetSubscriptionWithOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ListOperationsTouchingSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsTouchingSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ListOperationsTouchingSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ListOperationsTouchingSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ListOperationsTouchingSubscription(ctx, req.(*ListOperationsTouchingSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ScanIdentificationServiceAreas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRemoteIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ScanIdentificationServiceAreas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ScanIdentificationServiceAreas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ScanIdentificationServiceAreas(ctx, req.(*ScanRemoteIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_ScanSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanRemoteIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).ScanSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/ScanSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).ScanSubscriptions(ctx, req.(*ScanRemoteIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetSubscriptionNotificationIndices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionNotificationIndicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetSubscriptionNotificationIndices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetSubscriptionNotificationIndices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetSubscriptionNotificationIndices(ctx, req.(*GetSubscriptionNotificationIndicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_StreamLogEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DSSAuxServiceServer).StreamLogEvents(m, &dSSAuxServiceStreamLogEventsServer{stream})
+}
+
+type DSSAuxService_StreamLogEventsServer interface {
+	Send(*LogEvent) error
+	grpc.ServerStream
+}
+
+type dSSAuxServiceStreamLogEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *dSSAuxServiceStreamLogEventsServer) Send(m *LogEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DSSAuxService_RefreshStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).RefreshStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/RefreshStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).RefreshStatistics(ctx, req.(*RefreshStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DSSAuxService_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DSSAuxServiceServer).GetDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auxpb.DSSAuxService/GetDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DSSAuxServiceServer).GetDiagnostics(ctx, req.(*GetDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DSSAuxService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "auxpb.DSSAuxService",
 	HandlerType: (*DSSAuxServiceServer)(nil),
@@ -622,11 +5232,77 @@ var _DSSAuxService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _DSSAuxService_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetRegion",
+			Handler:    _DSSAuxService_GetRegion_Handler,
+		},
 		{
 			MethodName: "ValidateOauth",
 			Handler:    _DSSAuxService_ValidateOauth_Handler,
 		},
+		{
+			MethodName: "TestSubscriptionNotification",
+			Handler:    _DSSAuxService_TestSubscriptionNotification_Handler,
+		},
+		{
+			MethodName: "GetRequiredScopes",
+			Handler:    _DSSAuxService_GetRequiredScopes_Handler,
+		},
+		{
+			MethodName: "ListModifiedIdentificationServiceAreas",
+			Handler:    _DSSAuxService_ListModifiedIdentificationServiceAreas_Handler,
+		},
+		{
+			MethodName: "GetIdentificationServiceAreaCoverage",
+			Handler:    _DSSAuxService_GetIdentificationServiceAreaCoverage_Handler,
+		},
+		{
+			MethodName: "GetCurrentKey",
+			Handler:    _DSSAuxService_GetCurrentKey_Handler,
+		},
+		{
+			MethodName: "TriggerGarbageCollection",
+			Handler:    _DSSAuxService_TriggerGarbageCollection_Handler,
+		},
+		{
+			MethodName: "CountIdentificationServiceAreas",
+			Handler:    _DSSAuxService_CountIdentificationServiceAreas_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionWithOwner",
+			Handler:    _DSSAuxService_GetSubscriptionWithOwner_Handler,
+		},
+		{
+			MethodName: "ListOperationsTouchingSubscription",
+			Handler:    _DSSAuxService_ListOperationsTouchingSubscription_Handler,
+		},
+		{
+			MethodName: "ScanIdentificationServiceAreas",
+			Handler:    _DSSAuxService_ScanIdentificationServiceAreas_Handler,
+		},
+		{
+			MethodName: "ScanSubscriptions",
+			Handler:    _DSSAuxService_ScanSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionNotificationIndices",
+			Handler:    _DSSAuxService_GetSubscriptionNotificationIndices_Handler,
+		},
+		{
+			MethodName: "RefreshStatistics",
+			Handler:    _DSSAuxService_RefreshStatistics_Handler,
+		},
+		{
+			MethodName: "GetDiagnostics",
+			Handler:    _DSSAuxService_GetDiagnostics_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogEvents",
+			Handler:       _DSSAuxService_StreamLogEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/v1/auxpb/aux_service.proto",
 }
@@ -0,0 +1,3456 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.22.0
+// 	protoc        v3.11.2
+// source: pkg/api/v1/ridv22apb/rid.proto
+
+package ridv22apb
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Altitude struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A code indicating the reference for a vertical distance. See AIXM 5.1 and FIXM 4.2.0. Currently, UTM only allows WGS84 with no immediate plans to allow other options. FIXM and AIXM allow for 'SFC' which is equivalent to AGL.
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	// The reference quantities used to express the value of altitude. See FIXM 4.2. Currently, UTM only allows meters with no immediate plans to allow other options.
+	Units string `protobuf:"bytes,2,opt,name=units,proto3" json:"units,omitempty"`
+	// The numeric value of the altitude. Note that min and max values are added as a sanity check. As use cases evolve and more options are made available in terms of units of measure or reference systems, these bounds should be re-evaluated.
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Altitude) Reset() {
+	*x = Altitude{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Altitude) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Altitude) ProtoMessage() {}
+
+func (x *Altitude) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Altitude.ProtoReflect.Descriptor instead.
+func (*Altitude) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Altitude) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Altitude) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *Altitude) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Circle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Center *LatLngPoint `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Radius *Radius      `protobuf:"bytes,2,opt,name=radius,proto3" json:"radius,omitempty"`
+}
+
+func (x *Circle) Reset() {
+	*x = Circle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Circle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Circle) ProtoMessage() {}
+
+func (x *Circle) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Circle.ProtoReflect.Descriptor instead.
+func (*Circle) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Circle) GetCenter() *LatLngPoint {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *Circle) GetRadius() *Radius {
+	if x != nil {
+		return x.Radius
+	}
+	return nil
+}
+
+// Parameters for a request to create an Identification Service Area in the DSS.
+type CreateIdentificationServiceAreaParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bounding spacetime extents of this Identification Service Area.  End time must be specified.  If start time is not specified, it will be set to the current time.  Start times in the past should be rejected by the DSS, except that it may adjust very recent start times to the current time.
+	//
+	// These extents should not reveal any sensitive information about the flight or flights within them.  This means, for instance, that extents should not tightly-wrap a flight path, nor should they generally be centered around the takeoff point of a single flight.
+	Extents *Volume4D `protobuf:"bytes,1,opt,name=extents,proto3" json:"extents,omitempty"`
+	// The base URL of a USS implementation that implements the parts of the USS-USS API necessary for providing the details of this Identification Service Area, and telemetry during flights.
+	UssBaseUrl string `protobuf:"bytes,2,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+}
+
+func (x *CreateIdentificationServiceAreaParameters) Reset() {
+	*x = CreateIdentificationServiceAreaParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIdentificationServiceAreaParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIdentificationServiceAreaParameters) ProtoMessage() {}
+
+func (x *CreateIdentificationServiceAreaParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIdentificationServiceAreaParameters.ProtoReflect.Descriptor instead.
+func (*CreateIdentificationServiceAreaParameters) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateIdentificationServiceAreaParameters) GetExtents() *Volume4D {
+	if x != nil {
+		return x.Extents
+	}
+	return nil
+}
+
+func (x *CreateIdentificationServiceAreaParameters) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+type CreateIdentificationServiceAreaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityID of the Identification Service Area.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Request body.
+	Params *CreateIdentificationServiceAreaParameters `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *CreateIdentificationServiceAreaRequest) Reset() {
+	*x = CreateIdentificationServiceAreaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateIdentificationServiceAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIdentificationServiceAreaRequest) ProtoMessage() {}
+
+func (x *CreateIdentificationServiceAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIdentificationServiceAreaRequest.ProtoReflect.Descriptor instead.
+func (*CreateIdentificationServiceAreaRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateIdentificationServiceAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateIdentificationServiceAreaRequest) GetParams() *CreateIdentificationServiceAreaParameters {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Parameters for a request to create a subscription in the DSS.
+type CreateSubscriptionParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spacetime extents of the volume to subscribe to.
+	//
+	// This subscription will automatically be deleted after its end time if it has not been refreshed by then.  If end time is not specified, the value will be chosen automatically by the DSS.
+	//
+	// Note that some Entities triggering notifications may lie entirely outside the requested area.
+	Extents *Volume4D `protobuf:"bytes,1,opt,name=extents,proto3" json:"extents,omitempty"`
+	// The base URL of a USS implementation that implements the parts of the USS-USS API necessary for receiving the notifications requested by this subscription.
+	UssBaseUrl string `protobuf:"bytes,2,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+}
+
+func (x *CreateSubscriptionParameters) Reset() {
+	*x = CreateSubscriptionParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscriptionParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionParameters) ProtoMessage() {}
+
+func (x *CreateSubscriptionParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionParameters.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionParameters) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateSubscriptionParameters) GetExtents() *Volume4D {
+	if x != nil {
+		return x.Extents
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionParameters) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+type CreateSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SubscriptionID of the subscription of interest.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Request body.
+	Params *CreateSubscriptionParameters `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetParams() *CreateSubscriptionParameters {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type DeleteIdentificationServiceAreaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityID of the Identification Service Area.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version string used to reference an Identification Service Area at a particular point in time. Any updates to an existing Identification Service Area must contain the corresponding version to maintain idempotent updates.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DeleteIdentificationServiceAreaRequest) Reset() {
+	*x = DeleteIdentificationServiceAreaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIdentificationServiceAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIdentificationServiceAreaRequest) ProtoMessage() {}
+
+func (x *DeleteIdentificationServiceAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIdentificationServiceAreaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIdentificationServiceAreaRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteIdentificationServiceAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteIdentificationServiceAreaRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Response for a request to delete an Identification Service Area.
+type DeleteIdentificationServiceAreaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identification Service Area that was just deleted.
+	ServiceArea *IdentificationServiceArea `protobuf:"bytes,1,opt,name=service_area,json=serviceArea,proto3" json:"service_area,omitempty"`
+	// DSS subscribers that this client now has the obligation to notify of the Identification Service Area just deleted.  This client must call POST for each provided URL according to the `/uss/identification_service_areas/{id}` path API.
+	Subscribers []*SubscriberToNotify `protobuf:"bytes,2,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+}
+
+func (x *DeleteIdentificationServiceAreaResponse) Reset() {
+	*x = DeleteIdentificationServiceAreaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteIdentificationServiceAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIdentificationServiceAreaResponse) ProtoMessage() {}
+
+func (x *DeleteIdentificationServiceAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIdentificationServiceAreaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIdentificationServiceAreaResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteIdentificationServiceAreaResponse) GetServiceArea() *IdentificationServiceArea {
+	if x != nil {
+		return x.ServiceArea
+	}
+	return nil
+}
+
+func (x *DeleteIdentificationServiceAreaResponse) GetSubscribers() []*SubscriberToNotify {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+type DeleteSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SubscriptionID of the subscription of interest.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version string used to reference a Subscription at a particular point in time. Any updates to an existing Subscription must contain the corresponding version to maintain idempotent updates.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSubscriptionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Response for a successful request to delete a Subscription.
+type DeleteSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Subscription which was deleted.
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *DeleteSubscriptionResponse) Reset() {
+	*x = DeleteSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type GetIdentificationServiceAreaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityID of the Identification Service Area.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetIdentificationServiceAreaRequest) Reset() {
+	*x = GetIdentificationServiceAreaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdentificationServiceAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentificationServiceAreaRequest) ProtoMessage() {}
+
+func (x *GetIdentificationServiceAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentificationServiceAreaRequest.ProtoReflect.Descriptor instead.
+func (*GetIdentificationServiceAreaRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetIdentificationServiceAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response to DSS request for the identification service area with the given ID.
+type GetIdentificationServiceAreaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceArea *IdentificationServiceArea `protobuf:"bytes,1,opt,name=service_area,json=serviceArea,proto3" json:"service_area,omitempty"`
+}
+
+func (x *GetIdentificationServiceAreaResponse) Reset() {
+	*x = GetIdentificationServiceAreaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdentificationServiceAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentificationServiceAreaResponse) ProtoMessage() {}
+
+func (x *GetIdentificationServiceAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentificationServiceAreaResponse.ProtoReflect.Descriptor instead.
+func (*GetIdentificationServiceAreaResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetIdentificationServiceAreaResponse) GetServiceArea() *IdentificationServiceArea {
+	if x != nil {
+		return x.ServiceArea
+	}
+	return nil
+}
+
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SubscriptionID of the subscription of interest.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response to DSS request for the subscription with the given id.
+type GetSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *GetSubscriptionResponse) Reset() {
+	*x = GetSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionResponse) ProtoMessage() {}
+
+func (x *GetSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// An Identification Service Area (area in which remote ID services are being provided).  The DSS reports only these declarations and clients must exchange flight information peer-to-peer.
+type IdentificationServiceArea struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique identifier for this Identification Service Area.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Assigned by the DSS based on creating client’s ID (via access token).  Used for restricting mutation and deletion operations to owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The base URL of a USS implementation that implements the parts of the USS-USS API necessary for providing the details of this Identification Service Area, and telemetry during flights.
+	UssBaseUrl string `protobuf:"bytes,3,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+	// Beginning time of this Identification Service Area.
+	TimeStart *Time `protobuf:"bytes,4,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	// End time of this Identification Service Area.  The Identification Service Area is automatically removed after this time.
+	TimeEnd *Time  `protobuf:"bytes,5,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Version string `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *IdentificationServiceArea) Reset() {
+	*x = IdentificationServiceArea{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentificationServiceArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentificationServiceArea) ProtoMessage() {}
+
+func (x *IdentificationServiceArea) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentificationServiceArea.ProtoReflect.Descriptor instead.
+func (*IdentificationServiceArea) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IdentificationServiceArea) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IdentificationServiceArea) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *IdentificationServiceArea) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+func (x *IdentificationServiceArea) GetTimeStart() *Time {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *IdentificationServiceArea) GetTimeEnd() *Time {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *IdentificationServiceArea) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type LatLngPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lat float64 `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lng float64 `protobuf:"fixed64,2,opt,name=lng,proto3" json:"lng,omitempty"`
+}
+
+func (x *LatLngPoint) Reset() {
+	*x = LatLngPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatLngPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatLngPoint) ProtoMessage() {}
+
+func (x *LatLngPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatLngPoint.ProtoReflect.Descriptor instead.
+func (*LatLngPoint) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LatLngPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *LatLngPoint) GetLng() float64 {
+	if x != nil {
+		return x.Lng
+	}
+	return 0
+}
+
+// An enclosed area on the earth. The bounding edges of this polygon are defined to be the shortest paths between connected vertices.  This means, for instance, that the edge between two points both defined at a particular latitude is not generally contained at that latitude. The winding order must be interpreted as the order which produces the smaller area. The path between two vertices is defined to be the shortest possible path between those vertices. Edges may not cross. Vertices may not be duplicated.  In particular, the final polygon vertex must not be identical to the first vertex.
+type Polygon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vertices []*LatLngPoint `protobuf:"bytes,1,rep,name=vertices,proto3" json:"vertices,omitempty"`
+}
+
+func (x *Polygon) Reset() {
+	*x = Polygon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Polygon) ProtoMessage() {}
+
+func (x *Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Polygon) GetVertices() []*LatLngPoint {
+	if x != nil {
+		return x.Vertices
+	}
+	return nil
+}
+
+// Response to a request to create or update a reference to an Identification Service Area in the DSS.
+type PutIdentificationServiceAreaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resulting service area stored in DSS.
+	ServiceArea *IdentificationServiceArea `protobuf:"bytes,1,opt,name=service_area,json=serviceArea,proto3" json:"service_area,omitempty"`
+	// DSS subscribers that this client now has the obligation to notify of the Identification Service Area changes just made.  This client must call POST for each provided URL according to the `/uss/identification_service_areas/{id}` path API.
+	Subscribers []*SubscriberToNotify `protobuf:"bytes,2,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+}
+
+func (x *PutIdentificationServiceAreaResponse) Reset() {
+	*x = PutIdentificationServiceAreaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutIdentificationServiceAreaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutIdentificationServiceAreaResponse) ProtoMessage() {}
+
+func (x *PutIdentificationServiceAreaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutIdentificationServiceAreaResponse.ProtoReflect.Descriptor instead.
+func (*PutIdentificationServiceAreaResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PutIdentificationServiceAreaResponse) GetServiceArea() *IdentificationServiceArea {
+	if x != nil {
+		return x.ServiceArea
+	}
+	return nil
+}
+
+func (x *PutIdentificationServiceAreaResponse) GetSubscribers() []*SubscriberToNotify {
+	if x != nil {
+		return x.Subscribers
+	}
+	return nil
+}
+
+// Response for a request to create or update a subscription.
+type PutSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identification Service Areas in or near the subscription area at the time of creation/update, if `identification_service_area_url` callback was specified.
+	ServiceAreas []*IdentificationServiceArea `protobuf:"bytes,1,rep,name=service_areas,json=serviceAreas,proto3" json:"service_areas,omitempty"`
+	// Result of the operation on the subscription.
+	Subscription *Subscription `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *PutSubscriptionResponse) Reset() {
+	*x = PutSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutSubscriptionResponse) ProtoMessage() {}
+
+func (x *PutSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*PutSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PutSubscriptionResponse) GetServiceAreas() []*IdentificationServiceArea {
+	if x != nil {
+		return x.ServiceAreas
+	}
+	return nil
+}
+
+func (x *PutSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type Radius struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// FIXM-compatible units.  Only meters ("M") are acceptable for UTM.
+	Units string `protobuf:"bytes,1,opt,name=units,proto3" json:"units,omitempty"`
+	// Distance from the centerpoint of a circular area, along the WGS84 ellipsoid.
+	Value float32 `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Radius) Reset() {
+	*x = Radius{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Radius) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Radius) ProtoMessage() {}
+
+func (x *Radius) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Radius.ProtoReflect.Descriptor instead.
+func (*Radius) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Radius) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *Radius) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SearchIdentificationServiceAreasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The area in which to search for Identification Service Areas.  Some Identification Service Areas near this area but wholly outside it may also be returned.
+	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+	// If specified, indicates non-interest in any Identification Service Areas that end before this time.  RFC 3339 format, per OpenAPI specification.
+	EarliestTime string `protobuf:"bytes,2,opt,name=earliest_time,json=earliestTime,proto3" json:"earliest_time,omitempty"`
+	// If specified, indicates non-interest in any Identification Service Areas that start after this time.  RFC 3339 format, per OpenAPI specification.
+	LatestTime string `protobuf:"bytes,3,opt,name=latest_time,json=latestTime,proto3" json:"latest_time,omitempty"`
+}
+
+func (x *SearchIdentificationServiceAreasRequest) Reset() {
+	*x = SearchIdentificationServiceAreasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchIdentificationServiceAreasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIdentificationServiceAreasRequest) ProtoMessage() {}
+
+func (x *SearchIdentificationServiceAreasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIdentificationServiceAreasRequest.ProtoReflect.Descriptor instead.
+func (*SearchIdentificationServiceAreasRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SearchIdentificationServiceAreasRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+func (x *SearchIdentificationServiceAreasRequest) GetEarliestTime() string {
+	if x != nil {
+		return x.EarliestTime
+	}
+	return ""
+}
+
+func (x *SearchIdentificationServiceAreasRequest) GetLatestTime() string {
+	if x != nil {
+		return x.LatestTime
+	}
+	return ""
+}
+
+// Response to DSS query for Identification Service Areas in an area of interest.
+type SearchIdentificationServiceAreasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identification Service Areas in the area of interest.
+	ServiceAreas []*IdentificationServiceArea `protobuf:"bytes,1,rep,name=service_areas,json=serviceAreas,proto3" json:"service_areas,omitempty"`
+}
+
+func (x *SearchIdentificationServiceAreasResponse) Reset() {
+	*x = SearchIdentificationServiceAreasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchIdentificationServiceAreasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIdentificationServiceAreasResponse) ProtoMessage() {}
+
+func (x *SearchIdentificationServiceAreasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIdentificationServiceAreasResponse.ProtoReflect.Descriptor instead.
+func (*SearchIdentificationServiceAreasResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchIdentificationServiceAreasResponse) GetServiceAreas() []*IdentificationServiceArea {
+	if x != nil {
+		return x.ServiceAreas
+	}
+	return nil
+}
+
+type SearchSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The area in which to search for Subscriptions.  Some Subscriptions near this area but wholly outside it may also be returned.
+	Area string `protobuf:"bytes,1,opt,name=area,proto3" json:"area,omitempty"`
+}
+
+func (x *SearchSubscriptionsRequest) Reset() {
+	*x = SearchSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSubscriptionsRequest) ProtoMessage() {}
+
+func (x *SearchSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchSubscriptionsRequest) GetArea() string {
+	if x != nil {
+		return x.Area
+	}
+	return ""
+}
+
+// Response to DSS query for subscriptions in a particular area.
+type SearchSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriptions that overlap the specified area.
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *SearchSubscriptionsResponse) Reset() {
+	*x = SearchSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSubscriptionsResponse) ProtoMessage() {}
+
+func (x *SearchSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SearchSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// Subscriber to notify of a creation/change/deletion of a change in the airspace.  This is provided by the DSS to a client changing the airspace, and it is the responsibility of the client changing the airspace (they will receive a set of these notification requests) to send a notification to each specified `url`.
+type SubscriberToNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscription(s) prompting this notification.
+	Subscriptions []*SubscriptionState `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// The base URL of a USS implementation of the parts of the USS-USS API necessary for receiving the notification.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *SubscriberToNotify) Reset() {
+	*x = SubscriberToNotify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriberToNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriberToNotify) ProtoMessage() {}
+
+func (x *SubscriberToNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriberToNotify.ProtoReflect.Descriptor instead.
+func (*SubscriberToNotify) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubscriberToNotify) GetSubscriptions() []*SubscriptionState {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *SubscriberToNotify) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Specification of a geographic area that a client is interested in on an ongoing basis (e.g., “planning area”).  Internal to the DSS.
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique identifier for this subscription.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Assigned by the DSS based on creating client’s ID (via access token).  Used for restricting mutation and deletion operations to owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The base URL of a USS implementation that implements the parts of the USS-USS API necessary for receiving the notifications requested by this subscription.
+	UssBaseUrl string `protobuf:"bytes,3,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+	// Tracks the notifications sent for this subscription so the subscriber can detect missed notifications more easily.
+	NotificationIndex int32 `protobuf:"varint,4,opt,name=notification_index,json=notificationIndex,proto3" json:"notification_index,omitempty"`
+	// If set, this Subscription will not generate any notifications before this time.
+	TimeStart *Time `protobuf:"bytes,5,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	// If set, this subscription will be automatically removed after this time.
+	TimeEnd *Time  `protobuf:"bytes,6,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Version string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Subscription) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+func (x *Subscription) GetNotificationIndex() int32 {
+	if x != nil {
+		return x.NotificationIndex
+	}
+	return 0
+}
+
+func (x *Subscription) GetTimeStart() *Time {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *Subscription) GetTimeEnd() *Time {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *Subscription) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// State of subscription which is causing a notification to be sent.
+type SubscriptionState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationIndex int32  `protobuf:"varint,1,opt,name=notification_index,json=notificationIndex,proto3" json:"notification_index,omitempty"`
+	SubscriptionId    string `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (x *SubscriptionState) Reset() {
+	*x = SubscriptionState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionState) ProtoMessage() {}
+
+func (x *SubscriptionState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionState.ProtoReflect.Descriptor instead.
+func (*SubscriptionState) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubscriptionState) GetNotificationIndex() int32 {
+	if x != nil {
+		return x.NotificationIndex
+	}
+	return 0
+}
+
+func (x *SubscriptionState) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type Time struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// RFC3339-formatted time/date string.  The time zone must be 'Z'.
+	Value *timestamp.Timestamp `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Time) Reset() {
+	*x = Time{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Time) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Time) ProtoMessage() {}
+
+func (x *Time) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Time.ProtoReflect.Descriptor instead.
+func (*Time) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Time) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Time) GetValue() *timestamp.Timestamp {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Parameters for a request to update an Identification Service Area in the DSS.
+type UpdateIdentificationServiceAreaParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The bounding spacetime extents of this Identification Service Area.  End time must be specified.  If start time is not specified, it will remain unchanged.  Start times in the past should be rejected by the DSS unless they are unchanged from the Identification Service Area's current start time.
+	//
+	// These extents should not reveal any sensitive information about the flight or flights within them.  This means, for instance, that extents should not tightly-wrap a flight path, nor should they generally be centered around the takeoff point of a single flight.
+	Extents *Volume4D `protobuf:"bytes,1,opt,name=extents,proto3" json:"extents,omitempty"`
+	// The base URL of a USS implementation that implements the parts of the USS-USS API necessary for providing the details of this Identification Service Area, and telemetry during flights.
+	UssBaseUrl string `protobuf:"bytes,2,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+}
+
+func (x *UpdateIdentificationServiceAreaParameters) Reset() {
+	*x = UpdateIdentificationServiceAreaParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIdentificationServiceAreaParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIdentificationServiceAreaParameters) ProtoMessage() {}
+
+func (x *UpdateIdentificationServiceAreaParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIdentificationServiceAreaParameters.ProtoReflect.Descriptor instead.
+func (*UpdateIdentificationServiceAreaParameters) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateIdentificationServiceAreaParameters) GetExtents() *Volume4D {
+	if x != nil {
+		return x.Extents
+	}
+	return nil
+}
+
+func (x *UpdateIdentificationServiceAreaParameters) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+type UpdateIdentificationServiceAreaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// EntityID of the Identification Service Area.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Request body.
+	Params *UpdateIdentificationServiceAreaParameters `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// Version string used to reference an Identification Service Area at a particular point in time. Any updates to an existing Identification Service Area must contain the corresponding version to maintain idempotent updates.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateIdentificationServiceAreaRequest) Reset() {
+	*x = UpdateIdentificationServiceAreaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIdentificationServiceAreaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIdentificationServiceAreaRequest) ProtoMessage() {}
+
+func (x *UpdateIdentificationServiceAreaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIdentificationServiceAreaRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIdentificationServiceAreaRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateIdentificationServiceAreaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateIdentificationServiceAreaRequest) GetParams() *UpdateIdentificationServiceAreaParameters {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *UpdateIdentificationServiceAreaRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// Parameters for a request to update a subscription in the DSS.
+type UpdateSubscriptionParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The spacetime extents of the volume to subscribe to.
+	//
+	// This subscription will automatically be deleted after its end time if it has not been refreshed by then.  If end time is not specified, the value will be chosen automatically by the DSS.
+	//
+	// Note that some Entities triggering notifications may lie entirely outside the requested area.
+	Extents *Volume4D `protobuf:"bytes,1,opt,name=extents,proto3" json:"extents,omitempty"`
+	// The base URL of a USS implementation that implements the parts of the USS-USS API necessary for receiving the notifications requested by this subscription.
+	UssBaseUrl string `protobuf:"bytes,2,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+}
+
+func (x *UpdateSubscriptionParameters) Reset() {
+	*x = UpdateSubscriptionParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSubscriptionParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionParameters) ProtoMessage() {}
+
+func (x *UpdateSubscriptionParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionParameters.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionParameters) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateSubscriptionParameters) GetExtents() *Volume4D {
+	if x != nil {
+		return x.Extents
+	}
+	return nil
+}
+
+func (x *UpdateSubscriptionParameters) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+type UpdateSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SubscriptionID of the subscription of interest.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Request body.
+	Params *UpdateSubscriptionParameters `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// Version string used to reference a Subscription at a particular point in time. Any updates to an existing Subscription must contain the corresponding version to maintain idempotent updates.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateSubscriptionRequest) Reset() {
+	*x = UpdateSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSubscriptionRequest) GetParams() *UpdateSubscriptionParameters {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *UpdateSubscriptionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// A three-dimensional geographic volume consisting of a vertically-extruded shape. Exactly one outline must be specified.
+type Volume3D struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minimum bounding altitude of this volume. Must be less than altitude_upper, if specified.
+	AltitudeLower *Altitude `protobuf:"bytes,1,opt,name=altitude_lower,json=altitudeLower,proto3" json:"altitude_lower,omitempty"`
+	// Maximum bounding altitude of this volume. Must be greater than altitude_lower, if specified.
+	AltitudeUpper *Altitude `protobuf:"bytes,2,opt,name=altitude_upper,json=altitudeUpper,proto3" json:"altitude_upper,omitempty"`
+	// A circular geographic shape on the surface of the earth.
+	OutlineCircle *Circle `protobuf:"bytes,3,opt,name=outline_circle,json=outlineCircle,proto3" json:"outline_circle,omitempty"`
+	// A polygonal geographic shape on the surface of the earth.
+	OutlinePolygon *Polygon `protobuf:"bytes,4,opt,name=outline_polygon,json=outlinePolygon,proto3" json:"outline_polygon,omitempty"`
+}
+
+func (x *Volume3D) Reset() {
+	*x = Volume3D{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Volume3D) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume3D) ProtoMessage() {}
+
+func (x *Volume3D) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume3D.ProtoReflect.Descriptor instead.
+func (*Volume3D) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Volume3D) GetAltitudeLower() *Altitude {
+	if x != nil {
+		return x.AltitudeLower
+	}
+	return nil
+}
+
+func (x *Volume3D) GetAltitudeUpper() *Altitude {
+	if x != nil {
+		return x.AltitudeUpper
+	}
+	return nil
+}
+
+func (x *Volume3D) GetOutlineCircle() *Circle {
+	if x != nil {
+		return x.OutlineCircle
+	}
+	return nil
+}
+
+func (x *Volume3D) GetOutlinePolygon() *Polygon {
+	if x != nil {
+		return x.OutlinePolygon
+	}
+	return nil
+}
+
+// Contiguous block of geographic spacetime.
+type Volume4D struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// End time of this volume.
+	TimeEnd *Time `protobuf:"bytes,1,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	// Beginning time of this volume.
+	TimeStart *Time     `protobuf:"bytes,2,opt,name=time_start,json=timeStart,proto3" json:"time_start,omitempty"`
+	Volume    *Volume3D `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *Volume4D) Reset() {
+	*x = Volume4D{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Volume4D) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume4D) ProtoMessage() {}
+
+func (x *Volume4D) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume4D.ProtoReflect.Descriptor instead.
+func (*Volume4D) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Volume4D) GetTimeEnd() *Time {
+	if x != nil {
+		return x.TimeEnd
+	}
+	return nil
+}
+
+func (x *Volume4D) GetTimeStart() *Time {
+	if x != nil {
+		return x.TimeStart
+	}
+	return nil
+}
+
+func (x *Volume4D) GetVolume() *Volume3D {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+var File_pkg_api_v1_ridv22apb_rid_proto protoreflect.FileDescriptor
+
+var file_pkg_api_v1_ridv22apb_rid_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2f, 0x72, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x09, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x54, 0x0a, 0x08, 0x41, 0x6c,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x63, 0x0a, 0x06, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x74, 0x4c, 0x6e, 0x67, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x72, 0x61,
+	0x64, 0x69, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x52, 0x06, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0x7c, 0x0a, 0x29, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65,
+	0x55, 0x72, 0x6c, 0x22, 0x86, 0x01, 0x0a, 0x26, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4c,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x6f, 0x0a, 0x1c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x07,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75,
+	0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x6c, 0x0a,
+	0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x52, 0x0a, 0x26, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xb3, 0x01, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
+	0x72, 0x65, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x64, 0x76,
+	0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72,
+	0x54, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x59, 0x0a, 0x1a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6f,
+	0x0a, 0x24, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72,
+	0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x22,
+	0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x19, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73,
+	0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69,
+	0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x64, 0x76,
+	0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a,
+	0x0b, 0x4c, 0x61, 0x74, 0x4c, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6c, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6e, 0x67,
+	0x22, 0x3d, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x74, 0x4c, 0x6e, 0x67,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x22,
+	0xb0, 0x01, 0x0a, 0x24, 0x50, 0x75, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65,
+	0x61, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x54, 0x6f, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x72, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70,
+	0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a, 0x06, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x83, 0x01, 0x0a,
+	0x27, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x75, 0x0a, 0x28, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70,
+	0x62, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x22, 0x30, 0x0a, 0x1a, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0x5c, 0x0a, 0x1b, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12,
+	0x42, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xfb, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c,
+	0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2d,
+	0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a,
+	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x50, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x7c, 0x0a, 0x29, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x2d, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c,
+	0x22, 0xa0, 0x01, 0x0a, 0x26, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4c, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x72, 0x69,
+	0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62,
+	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73,
+	0x65, 0x55, 0x72, 0x6c, 0x22, 0x86, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x01,
+	0x0a, 0x08, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x33, 0x44, 0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x6c,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x41,
+	0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x55, 0x70, 0x70,
+	0x65, 0x72, 0x12, 0x38, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x69,
+	0x72, 0x63, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x0d, 0x6f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x3b, 0x0a, 0x0f,
+	0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70,
+	0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x08, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32,
+	0x32, 0x61, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45,
+	0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61,
+	0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x33, 0x44, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x32,
+	0xd0, 0x0d, 0x0a, 0x22, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x41, 0x6e, 0x64,
+	0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc4, 0x01, 0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x31, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x1a, 0x2d, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f,
+	0x64, 0x73, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x8e, 0x01,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x1a, 0x1e, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f,
+	0x64, 0x73, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xc9,
+	0x01, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x31, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70,
+	0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x39, 0x2a, 0x37, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x7b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0x93, 0x01, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32,
+	0x61, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x2a, 0x28, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f,
+	0x64, 0x73, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d,
+	0x12, 0xb6, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65,
+	0x61, 0x12, 0x2e, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x72, 0x69, 0x64,
+	0x2f, 0x76, 0x32, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61,
+	0x72, 0x65, 0x61, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
+	0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x72,
+	0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbd, 0x01, 0x0a,
+	0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61,
+	0x73, 0x12, 0x32, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2a, 0x12, 0x28, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x73, 0x73, 0x2f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73, 0x12, 0x87, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x72, 0x69,
+	0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x72, 0x69,
+	0x64, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xce, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x31, 0x2e, 0x72, 0x69, 0x64,
+	0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x1a, 0x37, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f,
+	0x64, 0x73, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x3a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x69, 0x64, 0x76, 0x32, 0x32, 0x61, 0x70, 0x62,
+	0x2e, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32,
+	0x1a, 0x28, 0x2f, 0x72, 0x69, 0x64, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x2f, 0x7b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_api_v1_ridv22apb_rid_proto_rawDescOnce sync.Once
+	file_pkg_api_v1_ridv22apb_rid_proto_rawDescData = file_pkg_api_v1_ridv22apb_rid_proto_rawDesc
+)
+
+func file_pkg_api_v1_ridv22apb_rid_proto_rawDescGZIP() []byte {
+	file_pkg_api_v1_ridv22apb_rid_proto_rawDescOnce.Do(func() {
+		file_pkg_api_v1_ridv22apb_rid_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_api_v1_ridv22apb_rid_proto_rawDescData)
+	})
+	return file_pkg_api_v1_ridv22apb_rid_proto_rawDescData
+}
+
+var file_pkg_api_v1_ridv22apb_rid_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_pkg_api_v1_ridv22apb_rid_proto_goTypes = []interface{}{
+	(*Altitude)(nil), // 0: ridv22apb.Altitude
+	(*Circle)(nil),   // 1: ridv22apb.Circle
+	(*CreateIdentificationServiceAreaParameters)(nil), // 2: ridv22apb.CreateIdentificationServiceAreaParameters
+	(*CreateIdentificationServiceAreaRequest)(nil),    // 3: ridv22apb.CreateIdentificationServiceAreaRequest
+	(*CreateSubscriptionParameters)(nil),              // 4: ridv22apb.CreateSubscriptionParameters
+	(*CreateSubscriptionRequest)(nil),                 // 5: ridv22apb.CreateSubscriptionRequest
+	(*DeleteIdentificationServiceAreaRequest)(nil),    // 6: ridv22apb.DeleteIdentificationServiceAreaRequest
+	(*DeleteIdentificationServiceAreaResponse)(nil),   // 7: ridv22apb.DeleteIdentificationServiceAreaResponse
+	(*DeleteSubscriptionRequest)(nil),                 // 8: ridv22apb.DeleteSubscriptionRequest
+	(*DeleteSubscriptionResponse)(nil),                // 9: ridv22apb.DeleteSubscriptionResponse
+	(*GetIdentificationServiceAreaRequest)(nil),       // 10: ridv22apb.GetIdentificationServiceAreaRequest
+	(*GetIdentificationServiceAreaResponse)(nil),      // 11: ridv22apb.GetIdentificationServiceAreaResponse
+	(*GetSubscriptionRequest)(nil),                    // 12: ridv22apb.GetSubscriptionRequest
+	(*GetSubscriptionResponse)(nil),                   // 13: ridv22apb.GetSubscriptionResponse
+	(*IdentificationServiceArea)(nil),                 // 14: ridv22apb.IdentificationServiceArea
+	(*LatLngPoint)(nil),                               // 15: ridv22apb.LatLngPoint
+	(*Polygon)(nil),                                   // 16: ridv22apb.Polygon
+	(*PutIdentificationServiceAreaResponse)(nil),      // 17: ridv22apb.PutIdentificationServiceAreaResponse
+	(*PutSubscriptionResponse)(nil),                   // 18: ridv22apb.PutSubscriptionResponse
+	(*Radius)(nil),                                    // 19: ridv22apb.Radius
+	(*SearchIdentificationServiceAreasRequest)(nil),   // 20: ridv22apb.SearchIdentificationServiceAreasRequest
+	(*SearchIdentificationServiceAreasResponse)(nil),  // 21: ridv22apb.SearchIdentificationServiceAreasResponse
+	(*SearchSubscriptionsRequest)(nil),                // 22: ridv22apb.SearchSubscriptionsRequest
+	(*SearchSubscriptionsResponse)(nil),               // 23: ridv22apb.SearchSubscriptionsResponse
+	(*SubscriberToNotify)(nil),                        // 24: ridv22apb.SubscriberToNotify
+	(*Subscription)(nil),                              // 25: ridv22apb.Subscription
+	(*SubscriptionState)(nil),                         // 26: ridv22apb.SubscriptionState
+	(*Time)(nil),                                      // 27: ridv22apb.Time
+	(*UpdateIdentificationServiceAreaParameters)(nil), // 28: ridv22apb.UpdateIdentificationServiceAreaParameters
+	(*UpdateIdentificationServiceAreaRequest)(nil),    // 29: ridv22apb.UpdateIdentificationServiceAreaRequest
+	(*UpdateSubscriptionParameters)(nil),              // 30: ridv22apb.UpdateSubscriptionParameters
+	(*UpdateSubscriptionRequest)(nil),                 // 31: ridv22apb.UpdateSubscriptionRequest
+	(*Volume3D)(nil),                                  // 32: ridv22apb.Volume3D
+	(*Volume4D)(nil),                                  // 33: ridv22apb.Volume4D
+	(*timestamp.Timestamp)(nil),                       // 34: google.protobuf.Timestamp
+}
+var file_pkg_api_v1_ridv22apb_rid_proto_depIdxs = []int32{
+	15, // 0: ridv22apb.Circle.center:type_name -> ridv22apb.LatLngPoint
+	19, // 1: ridv22apb.Circle.radius:type_name -> ridv22apb.Radius
+	33, // 2: ridv22apb.CreateIdentificationServiceAreaParameters.extents:type_name -> ridv22apb.Volume4D
+	2,  // 3: ridv22apb.CreateIdentificationServiceAreaRequest.params:type_name -> ridv22apb.CreateIdentificationServiceAreaParameters
+	33, // 4: ridv22apb.CreateSubscriptionParameters.extents:type_name -> ridv22apb.Volume4D
+	4,  // 5: ridv22apb.CreateSubscriptionRequest.params:type_name -> ridv22apb.CreateSubscriptionParameters
+	14, // 6: ridv22apb.DeleteIdentificationServiceAreaResponse.service_area:type_name -> ridv22apb.IdentificationServiceArea
+	24, // 7: ridv22apb.DeleteIdentificationServiceAreaResponse.subscribers:type_name -> ridv22apb.SubscriberToNotify
+	25, // 8: ridv22apb.DeleteSubscriptionResponse.subscription:type_name -> ridv22apb.Subscription
+	14, // 9: ridv22apb.GetIdentificationServiceAreaResponse.service_area:type_name -> ridv22apb.IdentificationServiceArea
+	25, // 10: ridv22apb.GetSubscriptionResponse.subscription:type_name -> ridv22apb.Subscription
+	27, // 11: ridv22apb.IdentificationServiceArea.time_start:type_name -> ridv22apb.Time
+	27, // 12: ridv22apb.IdentificationServiceArea.time_end:type_name -> ridv22apb.Time
+	15, // 13: ridv22apb.Polygon.vertices:type_name -> ridv22apb.LatLngPoint
+	14, // 14: ridv22apb.PutIdentificationServiceAreaResponse.service_area:type_name -> ridv22apb.IdentificationServiceArea
+	24, // 15: ridv22apb.PutIdentificationServiceAreaResponse.subscribers:type_name -> ridv22apb.SubscriberToNotify
+	14, // 16: ridv22apb.PutSubscriptionResponse.service_areas:type_name -> ridv22apb.IdentificationServiceArea
+	25, // 17: ridv22apb.PutSubscriptionResponse.subscription:type_name -> ridv22apb.Subscription
+	14, // 18: ridv22apb.SearchIdentificationServiceAreasResponse.service_areas:type_name -> ridv22apb.IdentificationServiceArea
+	25, // 19: ridv22apb.SearchSubscriptionsResponse.subscriptions:type_name -> ridv22apb.Subscription
+	26, // 20: ridv22apb.SubscriberToNotify.subscriptions:type_name -> ridv22apb.SubscriptionState
+	27, // 21: ridv22apb.Subscription.time_start:type_name -> ridv22apb.Time
+	27, // 22: ridv22apb.Subscription.time_end:type_name -> ridv22apb.Time
+	34, // 23: ridv22apb.Time.value:type_name -> google.protobuf.Timestamp
+	33, // 24: ridv22apb.UpdateIdentificationServiceAreaParameters.extents:type_name -> ridv22apb.Volume4D
+	28, // 25: ridv22apb.UpdateIdentificationServiceAreaRequest.params:type_name -> ridv22apb.UpdateIdentificationServiceAreaParameters
+	33, // 26: ridv22apb.UpdateSubscriptionParameters.extents:type_name -> ridv22apb.Volume4D
+	30, // 27: ridv22apb.UpdateSubscriptionRequest.params:type_name -> ridv22apb.UpdateSubscriptionParameters
+	0,  // 28: ridv22apb.Volume3D.altitude_lower:type_name -> ridv22apb.Altitude
+	0,  // 29: ridv22apb.Volume3D.altitude_upper:type_name -> ridv22apb.Altitude
+	1,  // 30: ridv22apb.Volume3D.outline_circle:type_name -> ridv22apb.Circle
+	16, // 31: ridv22apb.Volume3D.outline_polygon:type_name -> ridv22apb.Polygon
+	27, // 32: ridv22apb.Volume4D.time_end:type_name -> ridv22apb.Time
+	27, // 33: ridv22apb.Volume4D.time_start:type_name -> ridv22apb.Time
+	32, // 34: ridv22apb.Volume4D.volume:type_name -> ridv22apb.Volume3D
+	3,  // 35: ridv22apb.DiscoveryAndSynchronizationService.CreateIdentificationServiceArea:input_type -> ridv22apb.CreateIdentificationServiceAreaRequest
+	5,  // 36: ridv22apb.DiscoveryAndSynchronizationService.CreateSubscription:input_type -> ridv22apb.CreateSubscriptionRequest
+	6,  // 37: ridv22apb.DiscoveryAndSynchronizationService.DeleteIdentificationServiceArea:input_type -> ridv22apb.DeleteIdentificationServiceAreaRequest
+	8,  // 38: ridv22apb.DiscoveryAndSynchronizationService.DeleteSubscription:input_type -> ridv22apb.DeleteSubscriptionRequest
+	10, // 39: ridv22apb.DiscoveryAndSynchronizationService.GetIdentificationServiceArea:input_type -> ridv22apb.GetIdentificationServiceAreaRequest
+	12, // 40: ridv22apb.DiscoveryAndSynchronizationService.GetSubscription:input_type -> ridv22apb.GetSubscriptionRequest
+	20, // 41: ridv22apb.DiscoveryAndSynchronizationService.SearchIdentificationServiceAreas:input_type -> ridv22apb.SearchIdentificationServiceAreasRequest
+	22, // 42: ridv22apb.DiscoveryAndSynchronizationService.SearchSubscriptions:input_type -> ridv22apb.SearchSubscriptionsRequest
+	29, // 43: ridv22apb.DiscoveryAndSynchronizationService.UpdateIdentificationServiceArea:input_type -> ridv22apb.UpdateIdentificationServiceAreaRequest
+	31, // 44: ridv22apb.DiscoveryAndSynchronizationService.UpdateSubscription:input_type -> ridv22apb.UpdateSubscriptionRequest
+	17, // 45: ridv22apb.DiscoveryAndSynchronizationService.CreateIdentificationServiceArea:output_type -> ridv22apb.PutIdentificationServiceAreaResponse
+	18, // 46: ridv22apb.DiscoveryAndSynchronizationService.CreateSubscription:output_type -> ridv22apb.PutSubscriptionResponse
+	7,  // 47: ridv22apb.DiscoveryAndSynchronizationService.DeleteIdentificationServiceArea:output_type -> ridv22apb.DeleteIdentificationServiceAreaResponse
+	9,  // 48: ridv22apb.DiscoveryAndSynchronizationService.DeleteSubscription:output_type -> ridv22apb.DeleteSubscriptionResponse
+	11, // 49: ridv22apb.DiscoveryAndSynchronizationService.GetIdentificationServiceArea:output_type -> ridv22apb.GetIdentificationServiceAreaResponse
+	13, // 50: ridv22apb.DiscoveryAndSynchronizationService.GetSubscription:output_type -> ridv22apb.GetSubscriptionResponse
+	21, // 51: ridv22apb.DiscoveryAndSynchronizationService.SearchIdentificationServiceAreas:output_type -> ridv22apb.SearchIdentificationServiceAreasResponse
+	23, // 52: ridv22apb.DiscoveryAndSynchronizationService.SearchSubscriptions:output_type -> ridv22apb.SearchSubscriptionsResponse
+	17, // 53: ridv22apb.DiscoveryAndSynchronizationService.UpdateIdentificationServiceArea:output_type -> ridv22apb.PutIdentificationServiceAreaResponse
+	18, // 54: ridv22apb.DiscoveryAndSynchronizationService.UpdateSubscription:output_type -> ridv22apb.PutSubscriptionResponse
+	45, // [45:55] is the sub-list for method output_type
+	35, // [35:45] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_pkg_api_v1_ridv22apb_rid_proto_init() }
+func file_pkg_api_v1_ridv22apb_rid_proto_init() {
+	if File_pkg_api_v1_ridv22apb_rid_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Altitude); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Circle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIdentificationServiceAreaParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateIdentificationServiceAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscriptionParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteIdentificationServiceAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteIdentificationServiceAreaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentificationServiceAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentificationServiceAreaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentificationServiceArea); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatLngPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Polygon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutIdentificationServiceAreaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Radius); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchIdentificationServiceAreasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchIdentificationServiceAreasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriberToNotify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Time); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIdentificationServiceAreaParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIdentificationServiceAreaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSubscriptionParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Volume3D); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_ridv22apb_rid_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Volume4D); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_api_v1_ridv22apb_rid_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   34,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_api_v1_ridv22apb_rid_proto_goTypes,
+		DependencyIndexes: file_pkg_api_v1_ridv22apb_rid_proto_depIdxs,
+		MessageInfos:      file_pkg_api_v1_ridv22apb_rid_proto_msgTypes,
+	}.Build()
+	File_pkg_api_v1_ridv22apb_rid_proto = out.File
+	file_pkg_api_v1_ridv22apb_rid_proto_rawDesc = nil
+	file_pkg_api_v1_ridv22apb_rid_proto_goTypes = nil
+	file_pkg_api_v1_ridv22apb_rid_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DiscoveryAndSynchronizationServiceClient is the client API for DiscoveryAndSynchronizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DiscoveryAndSynchronizationServiceClient interface {
+	// /dss/identification_service_areas/{id}
+	//
+	// Create a new Identification Service Area.  This call will fail if an Identification Service Area with the same ID already exists.
+	//
+	// The DSS assumes the USS has already added the appropriate retention period to operation end time in `time_end` field before storing it.
+	CreateIdentificationServiceArea(ctx context.Context, in *CreateIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*PutIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}
+	//
+	// Create a subscription.  This call will fail if a Subscription with the same ID already exists.
+	//
+	// Subscription notifications are only triggered by (and contain full information of) changes to, creation of, or deletion of, Entities referenced by or stored in the DSS; they do not involve any data transfer (such as remote ID telemetry updates) apart from Entity information.
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*PutSubscriptionResponse, error)
+	// /dss/identification_service_areas/{id}/{version}
+	//
+	// Delete an Identification Service Area.  USSs should not delete Identification Service Areas before the end of the last managed flight plus the retention period.
+	DeleteIdentificationServiceArea(ctx context.Context, in *DeleteIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*DeleteIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}/{version}
+	//
+	// Delete a subscription.
+	DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error)
+	// /dss/identification_service_areas/{id}
+	//
+	// Retrieve full information of an Identification Service Area owned by the client.
+	GetIdentificationServiceArea(ctx context.Context, in *GetIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*GetIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}
+	//
+	// Verify the existence/valdity and state of a particular subscription.
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*GetSubscriptionResponse, error)
+	// /dss/identification_service_areas
+	//
+	// Retrieve all Identification Service Areas in the DAR for a given area during the given time.  Note that some Identification Service Areas returned may lie entirely outside the requested area.
+	SearchIdentificationServiceAreas(ctx context.Context, in *SearchIdentificationServiceAreasRequest, opts ...grpc.CallOption) (*SearchIdentificationServiceAreasResponse, error)
+	// /dss/subscriptions
+	//
+	// Retrieve subscriptions intersecting an area of interest.  Subscription notifications are only triggered by (and contain full information of) changes to, creation of, or deletion of, Entities referenced by or stored in the DSS; they do not involve any data transfer (such as remote ID telemetry updates) apart from Entity information.
+	//
+	// Only Subscriptions belonging to the caller are returned.  This endpoint would be used if a USS lost track of Subscriptions they had created and/or wanted to resolve an error indicating that they had too many existing Subscriptions in an area.
+	SearchSubscriptions(ctx context.Context, in *SearchSubscriptionsRequest, opts ...grpc.CallOption) (*SearchSubscriptionsResponse, error)
+	// /dss/identification_service_areas/{id}/{version}
+	//
+	// Update an Identification Service Area.  The full content of the existing Identification Service Area will be replaced with the provided information as only the most recent version is retained.
+	//
+	// The DSS assumes the USS has already added the appropriate retention period to operation end time in `time_end` field before storing it.  Updating `time_start` is not allowed if it is before the current time.
+	UpdateIdentificationServiceArea(ctx context.Context, in *UpdateIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*PutIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}/{version}
+	//
+	// Update a Subscription.  The full content of the existing Subscription will be replaced with the provided information as only the most recent version is retained.
+	//
+	// Subscription notifications are only triggered by (and contain full information of) changes to, creation of, or deletion of, Entities referenced by or stored in the DSS; they do not involve any data transfer (such as remote ID telemetry updates) apart from Entity information.
+	UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*PutSubscriptionResponse, error)
+}
+
+type discoveryAndSynchronizationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiscoveryAndSynchronizationServiceClient(cc grpc.ClientConnInterface) DiscoveryAndSynchronizationServiceClient {
+	return &discoveryAndSynchronizationServiceClient{cc}
+}
+
+func (c *discoveryAndSynchronizationServiceClient) CreateIdentificationServiceArea(ctx context.Context, in *CreateIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*PutIdentificationServiceAreaResponse, error) {
+	out := new(PutIdentificationServiceAreaResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/CreateIdentificationServiceArea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*PutSubscriptionResponse, error) {
+	out := new(PutSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/CreateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) DeleteIdentificationServiceArea(ctx context.Context, in *DeleteIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*DeleteIdentificationServiceAreaResponse, error) {
+	out := new(DeleteIdentificationServiceAreaResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/DeleteIdentificationServiceArea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error) {
+	out := new(DeleteSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/DeleteSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) GetIdentificationServiceArea(ctx context.Context, in *GetIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*GetIdentificationServiceAreaResponse, error) {
+	out := new(GetIdentificationServiceAreaResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/GetIdentificationServiceArea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*GetSubscriptionResponse, error) {
+	out := new(GetSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/GetSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) SearchIdentificationServiceAreas(ctx context.Context, in *SearchIdentificationServiceAreasRequest, opts ...grpc.CallOption) (*SearchIdentificationServiceAreasResponse, error) {
+	out := new(SearchIdentificationServiceAreasResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/SearchIdentificationServiceAreas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) SearchSubscriptions(ctx context.Context, in *SearchSubscriptionsRequest, opts ...grpc.CallOption) (*SearchSubscriptionsResponse, error) {
+	out := new(SearchSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/SearchSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) UpdateIdentificationServiceArea(ctx context.Context, in *UpdateIdentificationServiceAreaRequest, opts ...grpc.CallOption) (*PutIdentificationServiceAreaResponse, error) {
+	out := new(PutIdentificationServiceAreaResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/UpdateIdentificationServiceArea", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discoveryAndSynchronizationServiceClient) UpdateSubscription(ctx context.Context, in *UpdateSubscriptionRequest, opts ...grpc.CallOption) (*PutSubscriptionResponse, error) {
+	out := new(PutSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/ridv22apb.DiscoveryAndSynchronizationService/UpdateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiscoveryAndSynchronizationServiceServer is the server API for DiscoveryAndSynchronizationService service.
+type DiscoveryAndSynchronizationServiceServer interface {
+	// /dss/identification_service_areas/{id}
+	//
+	// Create a new Identification Service Area.  This call will fail if an Identification Service Area with the same ID already exists.
+	//
+	// The DSS assumes the USS has already added the appropriate retention period to operation end time in `time_end` field before storing it.
+	CreateIdentificationServiceArea(context.Context, *CreateIdentificationServiceAreaRequest) (*PutIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}
+	//
+	// Create a subscription.  This call will fail if a Subscription with the same ID already exists.
+	//
+	// Subscription notifications are only triggered by (and contain full information of) changes to, creation of, or deletion of, Entities referenced by or stored in the DSS; they do not involve any data transfer (such as remote ID telemetry updates) apart from Entity information.
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*PutSubscriptionResponse, error)
+	// /dss/identification_service_areas/{id}/{version}
+	//
+	// Delete an Identification Service Area.  USSs should not delete Identification Service Areas before the end of the last managed flight plus the retention period.
+	DeleteIdentificationServiceArea(context.Context, *DeleteIdentificationServiceAreaRequest) (*DeleteIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}/{version}
+	//
+	// Delete a subscription.
+	DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error)
+	// /dss/identification_service_areas/{id}
+	//
+	// Retrieve full information of an Identification Service Area owned by the client.
+	GetIdentificationServiceArea(context.Context, *GetIdentificationServiceAreaRequest) (*GetIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}
+	//
+	// Verify the existence/valdity and state of a particular subscription.
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*GetSubscriptionResponse, error)
+	// /dss/identification_service_areas
+	//
+	// Retrieve all Identification Service Areas in the DAR for a given area during the given time.  Note that some Identification Service Areas returned may lie entirely outside the requested area.
+	SearchIdentificationServiceAreas(context.Context, *SearchIdentificationServiceAreasRequest) (*SearchIdentificationServiceAreasResponse, error)
+	// /dss/subscriptions
+	//
+	// Retrieve subscriptions intersecting an area of interest.  Subscription notifications are only triggered by (and contain full information of) changes to, creation of, or deletion of, Entities referenced by or stored in the DSS; they do not involve any data transfer (such as remote ID telemetry updates) apart from Entity information.
+	//
+	// Only Subscriptions belonging to the caller are returned.  This endpoint would be used if a USS lost track of Subscriptions they had created and/or wanted to resolve an error indicating that they had too many existing Subscriptions in an area.
+	SearchSubscriptions(context.Context, *SearchSubscriptionsRequest) (*SearchSubscriptionsResponse, error)
+	// /dss/identification_service_areas/{id}/{version}
+	//
+	// Update an Identification Service Area.  The full content of the existing Identification Service Area will be replaced with the provided information as only the most recent version is retained.
+	//
+	// The DSS assumes the USS has already added the appropriate retention period to operation end time in `time_end` field before storing it.  Updating `time_start` is not allowed if it is before the current time.
+	UpdateIdentificationServiceArea(context.Context, *UpdateIdentificationServiceAreaRequest) (*PutIdentificationServiceAreaResponse, error)
+	// /dss/subscriptions/{id}/{version}
+	//
+	// Update a Subscription.  The full content of the existing Subscription will be replaced with the provided information as only the most recent version is retained.
+	//
+	// Subscription notifications are only triggered by (and contain full information of) changes to, creation of, or deletion of, Entities referenced by or stored in the DSS; they do not involve any data transfer (such as remote ID telemetry updates) apart from Entity information.
+	UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*PutSubscriptionResponse, error)
+}
+
+// UnimplementedDiscoveryAndSynchronizationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedDiscoveryAndSynchronizationServiceServer struct {
+}
+
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) CreateIdentificationServiceArea(context.Context, *CreateIdentificationServiceAreaRequest) (*PutIdentificationServiceAreaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIdentificationServiceArea not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*PutSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) DeleteIdentificationServiceArea(context.Context, *DeleteIdentificationServiceAreaRequest) (*DeleteIdentificationServiceAreaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIdentificationServiceArea not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) GetIdentificationServiceArea(context.Context, *GetIdentificationServiceAreaRequest) (*GetIdentificationServiceAreaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentificationServiceArea not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*GetSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) SearchIdentificationServiceAreas(context.Context, *SearchIdentificationServiceAreasRequest) (*SearchIdentificationServiceAreasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchIdentificationServiceAreas not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) SearchSubscriptions(context.Context, *SearchSubscriptionsRequest) (*SearchSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSubscriptions not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) UpdateIdentificationServiceArea(context.Context, *UpdateIdentificationServiceAreaRequest) (*PutIdentificationServiceAreaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIdentificationServiceArea not implemented")
+}
+func (*UnimplementedDiscoveryAndSynchronizationServiceServer) UpdateSubscription(context.Context, *UpdateSubscriptionRequest) (*PutSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubscription not implemented")
+}
+
+func RegisterDiscoveryAndSynchronizationServiceServer(s *grpc.Server, srv DiscoveryAndSynchronizationServiceServer) {
+	s.RegisterService(&_DiscoveryAndSynchronizationService_serviceDesc, srv)
+}
+
+func _DiscoveryAndSynchronizationService_CreateIdentificationServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIdentificationServiceAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).CreateIdentificationServiceArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/CreateIdentificationServiceArea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).CreateIdentificationServiceArea(ctx, req.(*CreateIdentificationServiceAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/CreateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_DeleteIdentificationServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIdentificationServiceAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).DeleteIdentificationServiceArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/DeleteIdentificationServiceArea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).DeleteIdentificationServiceArea(ctx, req.(*DeleteIdentificationServiceAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/DeleteSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).DeleteSubscription(ctx, req.(*DeleteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_GetIdentificationServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentificationServiceAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).GetIdentificationServiceArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/GetIdentificationServiceArea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).GetIdentificationServiceArea(ctx, req.(*GetIdentificationServiceAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/GetSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_SearchIdentificationServiceAreas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIdentificationServiceAreasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).SearchIdentificationServiceAreas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/SearchIdentificationServiceAreas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).SearchIdentificationServiceAreas(ctx, req.(*SearchIdentificationServiceAreasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_SearchSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).SearchSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/SearchSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).SearchSubscriptions(ctx, req.(*SearchSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_UpdateIdentificationServiceArea_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIdentificationServiceAreaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).UpdateIdentificationServiceArea(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/UpdateIdentificationServiceArea",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).UpdateIdentificationServiceArea(ctx, req.(*UpdateIdentificationServiceAreaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscoveryAndSynchronizationService_UpdateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscoveryAndSynchronizationServiceServer).UpdateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ridv22apb.DiscoveryAndSynchronizationService/UpdateSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscoveryAndSynchronizationServiceServer).UpdateSubscription(ctx, req.(*UpdateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DiscoveryAndSynchronizationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ridv22apb.DiscoveryAndSynchronizationService",
+	HandlerType: (*DiscoveryAndSynchronizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateIdentificationServiceArea",
+			Handler:    _DiscoveryAndSynchronizationService_CreateIdentificationServiceArea_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _DiscoveryAndSynchronizationService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteIdentificationServiceArea",
+			Handler:    _DiscoveryAndSynchronizationService_DeleteIdentificationServiceArea_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _DiscoveryAndSynchronizationService_DeleteSubscription_Handler,
+		},
+		{
+			MethodName: "GetIdentificationServiceArea",
+			Handler:    _DiscoveryAndSynchronizationService_GetIdentificationServiceArea_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _DiscoveryAndSynchronizationService_GetSubscription_Handler,
+		},
+		{
+			MethodName: "SearchIdentificationServiceAreas",
+			Handler:    _DiscoveryAndSynchronizationService_SearchIdentificationServiceAreas_Handler,
+		},
+		{
+			MethodName: "SearchSubscriptions",
+			Handler:    _DiscoveryAndSynchronizationService_SearchSubscriptions_Handler,
+		},
+		{
+			MethodName: "UpdateIdentificationServiceArea",
+			Handler:    _DiscoveryAndSynchronizationService_UpdateIdentificationServiceArea_Handler,
+		},
+		{
+			MethodName: "UpdateSubscription",
+			Handler:    _DiscoveryAndSynchronizationService_UpdateSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/v1/ridv22apb/rid.proto",
+}
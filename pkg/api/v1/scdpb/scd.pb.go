@@ -9,7 +9,9 @@ package scdpb
 import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -475,6 +477,9 @@ type ConstraintReference struct {
 	// may use this version to determine which Constraint references have new details that
 	// must be retrieved.
 	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// Time remaining until the end of this Constraint, computed by the DSS from its clock at the time of
+	// the response; 0 once it has ended.  Only provided by DSSs configured to compute it.
+	TimeToExpiry *duration.Duration `protobuf:"bytes,8,opt,name=time_to_expiry,json=timeToExpiry,proto3" json:"time_to_expiry,omitempty"`
 }
 
 func (x *ConstraintReference) Reset() {
@@ -558,6 +563,13 @@ func (x *ConstraintReference) GetVersion() int32 {
 	return 0
 }
 
+func (x *ConstraintReference) GetTimeToExpiry() *duration.Duration {
+	if x != nil {
+		return x.TimeToExpiry
+	}
+	return nil
+}
+
 type DeleteConstraintReferenceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1409,6 +1421,55 @@ func (x *GetSubscriptionResponse) GetSubscription() *Subscription {
 	return nil
 }
 
+// Request for the availability of a USS.
+type GetUssAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Client ID (matching the subject of its access tokens) of the USS of interest.
+	UssId string `protobuf:"bytes,1,opt,name=uss_id,json=ussId,proto3" json:"uss_id,omitempty"`
+}
+
+func (x *GetUssAvailabilityRequest) Reset() {
+	*x = GetUssAvailabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUssAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUssAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetUssAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUssAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetUssAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUssAvailabilityRequest) GetUssId() string {
+	if x != nil {
+		return x.UssId
+	}
+	return ""
+}
+
 // Information necessary to create a Subscription to serve a single Operation's notification needs.
 type ImplicitSubscriptionParameters struct {
 	state         protoimpl.MessageState
@@ -1426,7 +1487,7 @@ type ImplicitSubscriptionParameters struct {
 func (x *ImplicitSubscriptionParameters) Reset() {
 	*x = ImplicitSubscriptionParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[24]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1439,7 +1500,7 @@ func (x *ImplicitSubscriptionParameters) String() string {
 func (*ImplicitSubscriptionParameters) ProtoMessage() {}
 
 func (x *ImplicitSubscriptionParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[24]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1452,7 +1513,7 @@ func (x *ImplicitSubscriptionParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplicitSubscriptionParameters.ProtoReflect.Descriptor instead.
 func (*ImplicitSubscriptionParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{24}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ImplicitSubscriptionParameters) GetNotifyForConstraints() bool {
@@ -1482,7 +1543,7 @@ type LatLngPoint struct {
 func (x *LatLngPoint) Reset() {
 	*x = LatLngPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[25]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1495,7 +1556,7 @@ func (x *LatLngPoint) String() string {
 func (*LatLngPoint) ProtoMessage() {}
 
 func (x *LatLngPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[25]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1508,7 +1569,7 @@ func (x *LatLngPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LatLngPoint.ProtoReflect.Descriptor instead.
 func (*LatLngPoint) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{25}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *LatLngPoint) GetLat() float64 {
@@ -1537,7 +1598,7 @@ type MakeDssReportRequest struct {
 func (x *MakeDssReportRequest) Reset() {
 	*x = MakeDssReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[26]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1550,7 +1611,7 @@ func (x *MakeDssReportRequest) String() string {
 func (*MakeDssReportRequest) ProtoMessage() {}
 
 func (x *MakeDssReportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[26]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1563,7 +1624,7 @@ func (x *MakeDssReportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MakeDssReportRequest.ProtoReflect.Descriptor instead.
 func (*MakeDssReportRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{26}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *MakeDssReportRequest) GetParams() *ErrorReport {
@@ -1586,7 +1647,7 @@ type Operation struct {
 func (x *Operation) Reset() {
 	*x = Operation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[27]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1599,7 +1660,7 @@ func (x *Operation) String() string {
 func (*Operation) ProtoMessage() {}
 
 func (x *Operation) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[27]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1612,7 +1673,7 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Operation.ProtoReflect.Descriptor instead.
 func (*Operation) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{27}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Operation) GetDetails() *OperationDetails {
@@ -1645,7 +1706,7 @@ type OperationDetails struct {
 func (x *OperationDetails) Reset() {
 	*x = OperationDetails{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[28]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1658,7 +1719,7 @@ func (x *OperationDetails) String() string {
 func (*OperationDetails) ProtoMessage() {}
 
 func (x *OperationDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[28]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,7 +1732,7 @@ func (x *OperationDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationDetails.ProtoReflect.Descriptor instead.
 func (*OperationDetails) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{28}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *OperationDetails) GetState() string {
@@ -1726,12 +1787,18 @@ type OperationReference struct {
 	// may use this version to determine which Operation references have new details that
 	// must be retrieved.
 	Version int32 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	// Priority of the Operation, used by USSs to resolve conflicts between Operations: higher
+	// priority Operations take precedence over lower priority Operations.
+	Priority int32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Time remaining until the end of this Operation, computed by the DSS from its clock at the time of
+	// the response; 0 once it has ended.  Only provided by DSSs configured to compute it.
+	TimeToExpiry *duration.Duration `protobuf:"bytes,10,opt,name=time_to_expiry,json=timeToExpiry,proto3" json:"time_to_expiry,omitempty"`
 }
 
 func (x *OperationReference) Reset() {
 	*x = OperationReference{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[29]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1744,7 +1811,7 @@ func (x *OperationReference) String() string {
 func (*OperationReference) ProtoMessage() {}
 
 func (x *OperationReference) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[29]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1757,7 +1824,7 @@ func (x *OperationReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperationReference.ProtoReflect.Descriptor instead.
 func (*OperationReference) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{29}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *OperationReference) GetId() string {
@@ -1816,6 +1883,20 @@ func (x *OperationReference) GetVersion() int32 {
 	return 0
 }
 
+func (x *OperationReference) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *OperationReference) GetTimeToExpiry() *duration.Duration {
+	if x != nil {
+		return x.TimeToExpiry
+	}
+	return nil
+}
+
 // An enclosed area on the earth.
 // The bounding edges of this polygon shall be the shortest paths between connected vertices.  This means, for instance, that the edge between two points both defined at a particular latitude is not generally contained at that latitude.
 // The winding order shall be interpreted as the order which produces the smaller area.
@@ -1833,7 +1914,7 @@ type Polygon struct {
 func (x *Polygon) Reset() {
 	*x = Polygon{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[30]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1846,7 +1927,7 @@ func (x *Polygon) String() string {
 func (*Polygon) ProtoMessage() {}
 
 func (x *Polygon) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[30]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1859,7 +1940,7 @@ func (x *Polygon) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
 func (*Polygon) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{30}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Polygon) GetVertices() []*LatLngPoint {
@@ -1891,7 +1972,7 @@ type Position struct {
 func (x *Position) Reset() {
 	*x = Position{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[31]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1904,7 +1985,7 @@ func (x *Position) String() string {
 func (*Position) ProtoMessage() {}
 
 func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[31]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,7 +1998,7 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Position.ProtoReflect.Descriptor instead.
 func (*Position) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{31}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Position) GetAccuracyH() string {
@@ -1982,7 +2063,7 @@ type PutConstraintDetailsParameters struct {
 func (x *PutConstraintDetailsParameters) Reset() {
 	*x = PutConstraintDetailsParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[32]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1995,7 +2076,7 @@ func (x *PutConstraintDetailsParameters) String() string {
 func (*PutConstraintDetailsParameters) ProtoMessage() {}
 
 func (x *PutConstraintDetailsParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[32]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2089,7 @@ func (x *PutConstraintDetailsParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutConstraintDetailsParameters.ProtoReflect.Descriptor instead.
 func (*PutConstraintDetailsParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{32}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *PutConstraintDetailsParameters) GetConstraint() *Constraint {
@@ -2049,7 +2130,7 @@ type PutConstraintReferenceParameters struct {
 func (x *PutConstraintReferenceParameters) Reset() {
 	*x = PutConstraintReferenceParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[33]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2062,7 +2143,7 @@ func (x *PutConstraintReferenceParameters) String() string {
 func (*PutConstraintReferenceParameters) ProtoMessage() {}
 
 func (x *PutConstraintReferenceParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[33]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2075,7 +2156,7 @@ func (x *PutConstraintReferenceParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutConstraintReferenceParameters.ProtoReflect.Descriptor instead.
 func (*PutConstraintReferenceParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{33}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PutConstraintReferenceParameters) GetExtents() []*Volume4D {
@@ -2113,7 +2194,7 @@ type PutConstraintReferenceRequest struct {
 func (x *PutConstraintReferenceRequest) Reset() {
 	*x = PutConstraintReferenceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[34]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2126,7 +2207,7 @@ func (x *PutConstraintReferenceRequest) String() string {
 func (*PutConstraintReferenceRequest) ProtoMessage() {}
 
 func (x *PutConstraintReferenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[34]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,7 +2220,7 @@ func (x *PutConstraintReferenceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutConstraintReferenceRequest.ProtoReflect.Descriptor instead.
 func (*PutConstraintReferenceRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{34}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PutConstraintReferenceRequest) GetEntityuuid() string {
@@ -2178,7 +2259,7 @@ type PutOperationDetailsParameters struct {
 func (x *PutOperationDetailsParameters) Reset() {
 	*x = PutOperationDetailsParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[35]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2191,7 +2272,7 @@ func (x *PutOperationDetailsParameters) String() string {
 func (*PutOperationDetailsParameters) ProtoMessage() {}
 
 func (x *PutOperationDetailsParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[35]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2204,7 +2285,7 @@ func (x *PutOperationDetailsParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutOperationDetailsParameters.ProtoReflect.Descriptor instead.
 func (*PutOperationDetailsParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{35}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *PutOperationDetailsParameters) GetOperation() *Operation {
@@ -2246,10 +2327,11 @@ type PutOperationReferenceParameters struct {
 	// Constraints, which is determined by whether the Subscription associated with this Operation
 	// triggers notifications for Constraints.
 	Key []string `protobuf:"bytes,2,rep,name=key,proto3" json:"key,omitempty"`
-	// If an existing Subscription is not specified in `subscription_id`, then this field must be
+	// If an existing Subscription is not specified in `subscription_id`, then this field may be
 	// populated.  When this field is populated, an implicit Subscription will be created and
 	// associated with this Operation, and will generally be deleted automatically upon the
-	// deletion of this Operation.
+	// deletion of this Operation.  When neither is provided, the Operation has no Subscription
+	// and the USS is responsible for polling for relevant airspace updates itself.
 	NewSubscription *ImplicitSubscriptionParameters `protobuf:"bytes,3,opt,name=new_subscription,json=newSubscription,proto3" json:"new_subscription,omitempty"`
 	// To ensure consistency in read-modify-write operations and distributed systems, the client must
 	// specify the version of this Operation in the DSS that it is attempting to modify.  If a new
@@ -2258,17 +2340,21 @@ type PutOperationReferenceParameters struct {
 	State      string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 	// The ID of an existing Subscription that the USS will use to keep the operator informed about
 	// updates to relevant airspace information.  If this field is not provided, then the
-	// `new_subscription` field must be provided in order to provide notification capability
+	// `new_subscription` field may be provided in order to provide notification capability
 	// for the Operation.  The Subscription specified by this ID must cover at least the area over
 	// which this Operation is conducted, and it must provide notifications for Operations.
 	SubscriptionId string `protobuf:"bytes,6,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
 	UssBaseUrl     string `protobuf:"bytes,7,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+	// Priority of this Operation, which must not be negative.  Operations are created at priority 0
+	// when this field is not provided, and keep their current priority when it is not provided in
+	// an update.
+	Priority *wrappers.Int32Value `protobuf:"bytes,8,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
 func (x *PutOperationReferenceParameters) Reset() {
 	*x = PutOperationReferenceParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[36]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2281,7 +2367,7 @@ func (x *PutOperationReferenceParameters) String() string {
 func (*PutOperationReferenceParameters) ProtoMessage() {}
 
 func (x *PutOperationReferenceParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[36]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,7 +2380,7 @@ func (x *PutOperationReferenceParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutOperationReferenceParameters.ProtoReflect.Descriptor instead.
 func (*PutOperationReferenceParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{36}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PutOperationReferenceParameters) GetExtents() []*Volume4D {
@@ -2346,6 +2432,13 @@ func (x *PutOperationReferenceParameters) GetUssBaseUrl() string {
 	return ""
 }
 
+func (x *PutOperationReferenceParameters) GetPriority() *wrappers.Int32Value {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
 type PutOperationReferenceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2360,7 +2453,7 @@ type PutOperationReferenceRequest struct {
 func (x *PutOperationReferenceRequest) Reset() {
 	*x = PutOperationReferenceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[37]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2373,7 +2466,7 @@ func (x *PutOperationReferenceRequest) String() string {
 func (*PutOperationReferenceRequest) ProtoMessage() {}
 
 func (x *PutOperationReferenceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[37]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2386,7 +2479,7 @@ func (x *PutOperationReferenceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutOperationReferenceRequest.ProtoReflect.Descriptor instead.
 func (*PutOperationReferenceRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{37}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *PutOperationReferenceRequest) GetEntityuuid() string {
@@ -2424,7 +2517,7 @@ type PutSubscriptionParameters struct {
 func (x *PutSubscriptionParameters) Reset() {
 	*x = PutSubscriptionParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[38]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2437,7 +2530,7 @@ func (x *PutSubscriptionParameters) String() string {
 func (*PutSubscriptionParameters) ProtoMessage() {}
 
 func (x *PutSubscriptionParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[38]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2450,7 +2543,7 @@ func (x *PutSubscriptionParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSubscriptionParameters.ProtoReflect.Descriptor instead.
 func (*PutSubscriptionParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{38}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *PutSubscriptionParameters) GetExtents() *Volume4D {
@@ -2502,7 +2595,7 @@ type PutSubscriptionRequest struct {
 func (x *PutSubscriptionRequest) Reset() {
 	*x = PutSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[39]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2515,7 +2608,7 @@ func (x *PutSubscriptionRequest) String() string {
 func (*PutSubscriptionRequest) ProtoMessage() {}
 
 func (x *PutSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[39]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2528,7 +2621,7 @@ func (x *PutSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*PutSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{39}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *PutSubscriptionRequest) GetParams() *PutSubscriptionParameters {
@@ -2564,7 +2657,7 @@ type PutSubscriptionResponse struct {
 func (x *PutSubscriptionResponse) Reset() {
 	*x = PutSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[40]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2577,7 +2670,7 @@ func (x *PutSubscriptionResponse) String() string {
 func (*PutSubscriptionResponse) ProtoMessage() {}
 
 func (x *PutSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[40]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +2683,7 @@ func (x *PutSubscriptionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*PutSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{40}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *PutSubscriptionResponse) GetConstraints() []*ConstraintReference {
@@ -2626,7 +2719,7 @@ type QueryConstraintReferencesRequest struct {
 func (x *QueryConstraintReferencesRequest) Reset() {
 	*x = QueryConstraintReferencesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[41]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2639,7 +2732,7 @@ func (x *QueryConstraintReferencesRequest) String() string {
 func (*QueryConstraintReferencesRequest) ProtoMessage() {}
 
 func (x *QueryConstraintReferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[41]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2652,7 +2745,7 @@ func (x *QueryConstraintReferencesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryConstraintReferencesRequest.ProtoReflect.Descriptor instead.
 func (*QueryConstraintReferencesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{41}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *QueryConstraintReferencesRequest) GetParams() *SearchConstraintReferenceParameters {
@@ -2674,7 +2767,7 @@ type QuerySubscriptionsRequest struct {
 func (x *QuerySubscriptionsRequest) Reset() {
 	*x = QuerySubscriptionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[42]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2687,7 +2780,7 @@ func (x *QuerySubscriptionsRequest) String() string {
 func (*QuerySubscriptionsRequest) ProtoMessage() {}
 
 func (x *QuerySubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[42]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,7 +2793,7 @@ func (x *QuerySubscriptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*QuerySubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{42}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *QuerySubscriptionsRequest) GetParams() *SearchSubscriptionParameters {
@@ -2724,7 +2817,7 @@ type Radius struct {
 func (x *Radius) Reset() {
 	*x = Radius{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[43]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2737,7 +2830,7 @@ func (x *Radius) String() string {
 func (*Radius) ProtoMessage() {}
 
 func (x *Radius) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[43]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2750,7 +2843,7 @@ func (x *Radius) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Radius.ProtoReflect.Descriptor instead.
 func (*Radius) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{43}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *Radius) GetUnits() string {
@@ -2779,7 +2872,7 @@ type SearchConstraintReferenceParameters struct {
 func (x *SearchConstraintReferenceParameters) Reset() {
 	*x = SearchConstraintReferenceParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[44]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2792,7 +2885,7 @@ func (x *SearchConstraintReferenceParameters) String() string {
 func (*SearchConstraintReferenceParameters) ProtoMessage() {}
 
 func (x *SearchConstraintReferenceParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[44]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2805,7 +2898,7 @@ func (x *SearchConstraintReferenceParameters) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SearchConstraintReferenceParameters.ProtoReflect.Descriptor instead.
 func (*SearchConstraintReferenceParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{44}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SearchConstraintReferenceParameters) GetAreaOfInterest() *Volume4D {
@@ -2828,7 +2921,7 @@ type SearchConstraintReferencesResponse struct {
 func (x *SearchConstraintReferencesResponse) Reset() {
 	*x = SearchConstraintReferencesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[45]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2841,7 +2934,7 @@ func (x *SearchConstraintReferencesResponse) String() string {
 func (*SearchConstraintReferencesResponse) ProtoMessage() {}
 
 func (x *SearchConstraintReferencesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[45]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2854,7 +2947,7 @@ func (x *SearchConstraintReferencesResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SearchConstraintReferencesResponse.ProtoReflect.Descriptor instead.
 func (*SearchConstraintReferencesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{45}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *SearchConstraintReferencesResponse) GetConstraintReferences() []*ConstraintReference {
@@ -2871,12 +2964,15 @@ type SearchOperationReferenceParameters struct {
 	unknownFields protoimpl.UnknownFields
 
 	AreaOfInterest *Volume4D `protobuf:"bytes,1,opt,name=area_of_interest,json=areaOfInterest,proto3" json:"area_of_interest,omitempty"`
+	// If provided, only Operations at or above this priority are returned.  Operations of any
+	// priority are returned when this field is 0.
+	MinPriority int32 `protobuf:"varint,2,opt,name=min_priority,json=minPriority,proto3" json:"min_priority,omitempty"`
 }
 
 func (x *SearchOperationReferenceParameters) Reset() {
 	*x = SearchOperationReferenceParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[46]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2889,7 +2985,7 @@ func (x *SearchOperationReferenceParameters) String() string {
 func (*SearchOperationReferenceParameters) ProtoMessage() {}
 
 func (x *SearchOperationReferenceParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[46]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2902,7 +2998,7 @@ func (x *SearchOperationReferenceParameters) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SearchOperationReferenceParameters.ProtoReflect.Descriptor instead.
 func (*SearchOperationReferenceParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{46}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SearchOperationReferenceParameters) GetAreaOfInterest() *Volume4D {
@@ -2912,6 +3008,13 @@ func (x *SearchOperationReferenceParameters) GetAreaOfInterest() *Volume4D {
 	return nil
 }
 
+func (x *SearchOperationReferenceParameters) GetMinPriority() int32 {
+	if x != nil {
+		return x.MinPriority
+	}
+	return 0
+}
+
 // Response to DSS query for OperationReferences in an area of interest.
 type SearchOperationReferenceResponse struct {
 	state         protoimpl.MessageState
@@ -2925,7 +3028,7 @@ type SearchOperationReferenceResponse struct {
 func (x *SearchOperationReferenceResponse) Reset() {
 	*x = SearchOperationReferenceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[47]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2938,7 +3041,7 @@ func (x *SearchOperationReferenceResponse) String() string {
 func (*SearchOperationReferenceResponse) ProtoMessage() {}
 
 func (x *SearchOperationReferenceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[47]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2951,7 +3054,7 @@ func (x *SearchOperationReferenceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchOperationReferenceResponse.ProtoReflect.Descriptor instead.
 func (*SearchOperationReferenceResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{47}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *SearchOperationReferenceResponse) GetOperationReferences() []*OperationReference {
@@ -2973,7 +3076,7 @@ type SearchOperationReferencesRequest struct {
 func (x *SearchOperationReferencesRequest) Reset() {
 	*x = SearchOperationReferencesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[48]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2986,7 +3089,7 @@ func (x *SearchOperationReferencesRequest) String() string {
 func (*SearchOperationReferencesRequest) ProtoMessage() {}
 
 func (x *SearchOperationReferencesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[48]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2999,7 +3102,7 @@ func (x *SearchOperationReferencesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchOperationReferencesRequest.ProtoReflect.Descriptor instead.
 func (*SearchOperationReferencesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{48}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SearchOperationReferencesRequest) GetParams() *SearchOperationReferenceParameters {
@@ -3021,7 +3124,7 @@ type SearchSubscriptionParameters struct {
 func (x *SearchSubscriptionParameters) Reset() {
 	*x = SearchSubscriptionParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[49]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3034,7 +3137,7 @@ func (x *SearchSubscriptionParameters) String() string {
 func (*SearchSubscriptionParameters) ProtoMessage() {}
 
 func (x *SearchSubscriptionParameters) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[49]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3047,7 +3150,7 @@ func (x *SearchSubscriptionParameters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchSubscriptionParameters.ProtoReflect.Descriptor instead.
 func (*SearchSubscriptionParameters) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{49}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *SearchSubscriptionParameters) GetAreaOfInterest() *Volume4D {
@@ -3070,7 +3173,7 @@ type SearchSubscriptionsResponse struct {
 func (x *SearchSubscriptionsResponse) Reset() {
 	*x = SearchSubscriptionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[50]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3083,7 +3186,7 @@ func (x *SearchSubscriptionsResponse) String() string {
 func (*SearchSubscriptionsResponse) ProtoMessage() {}
 
 func (x *SearchSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[50]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3096,7 +3199,7 @@ func (x *SearchSubscriptionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*SearchSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{50}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *SearchSubscriptionsResponse) GetSubscriptions() []*Subscription {
@@ -3106,37 +3209,35 @@ func (x *SearchSubscriptionsResponse) GetSubscriptions() []*Subscription {
 	return nil
 }
 
-// Subscriber to notify of a change in the airspace.  This is provided by the DSS
-// to a client changing the airspace, and it is the responsibility of that client
-// to send a notification to the specified USS according to the change made to the
-// airspace.
-type SubscriberToNotify struct {
+// Request to set the availability of a USS.
+type SetUssAvailabilityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Subscription(s) prompting this notification.
-	Subscriptions []*SubscriptionState `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
-	UssBaseUrl    string               `protobuf:"bytes,2,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+	// Request body.
+	Params *SetUssAvailabilityStatusParameters `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// Client ID (matching the subject of its access tokens) of the USS whose availability is set.
+	UssId string `protobuf:"bytes,2,opt,name=uss_id,json=ussId,proto3" json:"uss_id,omitempty"`
 }
 
-func (x *SubscriberToNotify) Reset() {
-	*x = SubscriberToNotify{}
+func (x *SetUssAvailabilityRequest) Reset() {
+	*x = SetUssAvailabilityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[51]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscriberToNotify) String() string {
+func (x *SetUssAvailabilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscriberToNotify) ProtoMessage() {}
+func (*SetUssAvailabilityRequest) ProtoMessage() {}
 
-func (x *SubscriberToNotify) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[51]
+func (x *SetUssAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3147,35 +3248,155 @@ func (x *SubscriberToNotify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscriberToNotify.ProtoReflect.Descriptor instead.
-func (*SubscriberToNotify) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SetUssAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetUssAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SubscriberToNotify) GetSubscriptions() []*SubscriptionState {
+func (x *SetUssAvailabilityRequest) GetParams() *SetUssAvailabilityStatusParameters {
 	if x != nil {
-		return x.Subscriptions
+		return x.Params
 	}
 	return nil
 }
 
-func (x *SubscriberToNotify) GetUssBaseUrl() string {
+func (x *SetUssAvailabilityRequest) GetUssId() string {
 	if x != nil {
-		return x.UssBaseUrl
+		return x.UssId
 	}
 	return ""
 }
 
-// Specification of a geographic area that a client is interested
-// in on an ongoing basis (e.g., "planning area").
-type Subscription struct {
+// Parameters for a request to set the availability of a USS.
+type SetUssAvailabilityStatusParameters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of Operations (UUIDs) that are dependent on this Subscription.
-	DependentOperations []string `protobuf:"bytes,1,rep,name=dependent_operations,json=dependentOperations,proto3" json:"dependent_operations,omitempty"`
-	Id                  string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Availability of the USS: `Up`, `Down` or `Unknown`.
+	Availability string `protobuf:"bytes,1,opt,name=availability,proto3" json:"availability,omitempty"`
+	// To ensure consistency in read-modify-write operations and distributed systems, the client must
+	// specify the version of the availability in the DSS that it is attempting to modify.  If the
+	// availability of the USS was never set, this version should be set to 0.
+	OldVersion int32 `protobuf:"varint,2,opt,name=old_version,json=oldVersion,proto3" json:"old_version,omitempty"`
+}
+
+func (x *SetUssAvailabilityStatusParameters) Reset() {
+	*x = SetUssAvailabilityStatusParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUssAvailabilityStatusParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUssAvailabilityStatusParameters) ProtoMessage() {}
+
+func (x *SetUssAvailabilityStatusParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUssAvailabilityStatusParameters.ProtoReflect.Descriptor instead.
+func (*SetUssAvailabilityStatusParameters) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetUssAvailabilityStatusParameters) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+func (x *SetUssAvailabilityStatusParameters) GetOldVersion() int32 {
+	if x != nil {
+		return x.OldVersion
+	}
+	return 0
+}
+
+// Subscriber to notify of a change in the airspace.  This is provided by the DSS
+// to a client changing the airspace, and it is the responsibility of that client
+// to send a notification to the specified USS according to the change made to the
+// airspace.
+type SubscriberToNotify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscription(s) prompting this notification.
+	Subscriptions []*SubscriptionState `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	UssBaseUrl    string               `protobuf:"bytes,2,opt,name=uss_base_url,json=ussBaseUrl,proto3" json:"uss_base_url,omitempty"`
+}
+
+func (x *SubscriberToNotify) Reset() {
+	*x = SubscriberToNotify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriberToNotify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriberToNotify) ProtoMessage() {}
+
+func (x *SubscriberToNotify) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriberToNotify.ProtoReflect.Descriptor instead.
+func (*SubscriberToNotify) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SubscriberToNotify) GetSubscriptions() []*SubscriptionState {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *SubscriberToNotify) GetUssBaseUrl() string {
+	if x != nil {
+		return x.UssBaseUrl
+	}
+	return ""
+}
+
+// Specification of a geographic area that a client is interested
+// in on an ongoing basis (e.g., "planning area").
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of Operations (UUIDs) that are dependent on this Subscription.
+	DependentOperations []string `protobuf:"bytes,1,rep,name=dependent_operations,json=dependentOperations,proto3" json:"dependent_operations,omitempty"`
+	Id                  string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	// True if this Subscription was implicitly created by the DSS via the creation of an
 	// Operation, and should therefore be deleted by the DSS when that Operation is deleted.
 	ImplicitSubscription bool  `protobuf:"varint,3,opt,name=implicit_subscription,json=implicitSubscription,proto3" json:"implicit_subscription,omitempty"`
@@ -3195,12 +3416,15 @@ type Subscription struct {
 	// must specify this version when modifying an existing Subscription to ensure consistency
 	// in read-modify-write operations and distributed systems.
 	Version int32 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	// Time remaining until the end of this Subscription, computed by the DSS from its clock at the time of
+	// the response; 0 once it has ended.  Only provided by DSSs configured to compute it.
+	TimeToExpiry *duration.Duration `protobuf:"bytes,11,opt,name=time_to_expiry,json=timeToExpiry,proto3" json:"time_to_expiry,omitempty"`
 }
 
 func (x *Subscription) Reset() {
 	*x = Subscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[52]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3213,7 +3437,7 @@ func (x *Subscription) String() string {
 func (*Subscription) ProtoMessage() {}
 
 func (x *Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[52]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3226,7 +3450,7 @@ func (x *Subscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
 func (*Subscription) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{52}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *Subscription) GetDependentOperations() []string {
@@ -3299,6 +3523,13 @@ func (x *Subscription) GetVersion() int32 {
 	return 0
 }
 
+func (x *Subscription) GetTimeToExpiry() *duration.Duration {
+	if x != nil {
+		return x.TimeToExpiry
+	}
+	return nil
+}
+
 // State of Subscription which is causing a notification to be sent.
 type SubscriptionState struct {
 	state         protoimpl.MessageState
@@ -3312,7 +3543,7 @@ type SubscriptionState struct {
 func (x *SubscriptionState) Reset() {
 	*x = SubscriptionState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[53]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3325,7 +3556,7 @@ func (x *SubscriptionState) String() string {
 func (*SubscriptionState) ProtoMessage() {}
 
 func (x *SubscriptionState) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[53]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3338,7 +3569,7 @@ func (x *SubscriptionState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscriptionState.ProtoReflect.Descriptor instead.
 func (*SubscriptionState) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{53}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *SubscriptionState) GetNotificationIndex() int32 {
@@ -3368,7 +3599,7 @@ type Time struct {
 func (x *Time) Reset() {
 	*x = Time{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[54]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3381,7 +3612,7 @@ func (x *Time) String() string {
 func (*Time) ProtoMessage() {}
 
 func (x *Time) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[54]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3394,7 +3625,7 @@ func (x *Time) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Time.ProtoReflect.Descriptor instead.
 func (*Time) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{54}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *Time) GetFormat() string {
@@ -3411,6 +3642,121 @@ func (x *Time) GetValue() *timestamp.Timestamp {
 	return nil
 }
 
+// Availability of a USS, consulted by its peers before trusting the data it shares.
+type UssAvailabilityStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Availability of the USS: `Up`, `Down` or `Unknown`.
+	Availability string `protobuf:"bytes,1,opt,name=availability,proto3" json:"availability,omitempty"`
+	// Client ID (matching the subject of its access tokens) of the USS.
+	Uss string `protobuf:"bytes,2,opt,name=uss,proto3" json:"uss,omitempty"`
+}
+
+func (x *UssAvailabilityStatus) Reset() {
+	*x = UssAvailabilityStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UssAvailabilityStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UssAvailabilityStatus) ProtoMessage() {}
+
+func (x *UssAvailabilityStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UssAvailabilityStatus.ProtoReflect.Descriptor instead.
+func (*UssAvailabilityStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UssAvailabilityStatus) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+func (x *UssAvailabilityStatus) GetUss() string {
+	if x != nil {
+		return x.Uss
+	}
+	return ""
+}
+
+// Response to a request for, or to set, the availability of a USS.
+type UssAvailabilityStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *UssAvailabilityStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Version of the availability, incremented every time it is set; 0 if it was never set.
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UssAvailabilityStatusResponse) Reset() {
+	*x = UssAvailabilityStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UssAvailabilityStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UssAvailabilityStatusResponse) ProtoMessage() {}
+
+func (x *UssAvailabilityStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UssAvailabilityStatusResponse.ProtoReflect.Descriptor instead.
+func (*UssAvailabilityStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UssAvailabilityStatusResponse) GetStatus() *UssAvailabilityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UssAvailabilityStatusResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 // Vehicle position, altitude, and velocity.
 type VehicleTelemetry struct {
 	state         protoimpl.MessageState
@@ -3426,7 +3772,7 @@ type VehicleTelemetry struct {
 func (x *VehicleTelemetry) Reset() {
 	*x = VehicleTelemetry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[55]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3439,7 +3785,7 @@ func (x *VehicleTelemetry) String() string {
 func (*VehicleTelemetry) ProtoMessage() {}
 
 func (x *VehicleTelemetry) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[55]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3452,7 +3798,7 @@ func (x *VehicleTelemetry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VehicleTelemetry.ProtoReflect.Descriptor instead.
 func (*VehicleTelemetry) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{55}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *VehicleTelemetry) GetId() string {
@@ -3499,7 +3845,7 @@ type Velocity struct {
 func (x *Velocity) Reset() {
 	*x = Velocity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[56]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3512,7 +3858,7 @@ func (x *Velocity) String() string {
 func (*Velocity) ProtoMessage() {}
 
 func (x *Velocity) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[56]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3525,7 +3871,7 @@ func (x *Velocity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Velocity.ProtoReflect.Descriptor instead.
 func (*Velocity) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{56}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *Velocity) GetSpeed() float32 {
@@ -3569,7 +3915,7 @@ type Volume3D struct {
 func (x *Volume3D) Reset() {
 	*x = Volume3D{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[57]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3582,7 +3928,7 @@ func (x *Volume3D) String() string {
 func (*Volume3D) ProtoMessage() {}
 
 func (x *Volume3D) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[57]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3595,7 +3941,7 @@ func (x *Volume3D) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Volume3D.ProtoReflect.Descriptor instead.
 func (*Volume3D) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{57}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *Volume3D) GetAltitudeLower() *Altitude {
@@ -3642,7 +3988,7 @@ type Volume4D struct {
 func (x *Volume4D) Reset() {
 	*x = Volume4D{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[58]
+		mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3655,7 +4001,7 @@ func (x *Volume4D) String() string {
 func (*Volume4D) ProtoMessage() {}
 
 func (x *Volume4D) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[58]
+	mi := &file_pkg_api_v1_scdpb_scd_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3668,7 +4014,7 @@ func (x *Volume4D) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Volume4D.ProtoReflect.Descriptor instead.
 func (*Volume4D) Descriptor() ([]byte, []int) {
-	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{58}
+	return file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *Volume4D) GetTimeEnd() *Time {
@@ -3699,8 +4045,12 @@ var file_pkg_api_v1_scdpb_scd_proto_rawDesc = []byte{
 	0x70, 0x62, 0x2f, 0x73, 0x63, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73, 0x63,
 	0x64, 0x70, 0x62, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x22, 0x77, 0x0a, 0x18, 0x41, 0x69, 0x72, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f,
 	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
 	0x0a, 0x10, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
@@ -3754,8 +4104,8 @@ var file_pkg_api_v1_scdpb_scd_proto_rawDesc = []byte{
 	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
 	0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0xdd,
-	0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66,
+	0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x9e,
+	0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66,
 	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x76, 0x6e, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x76, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
@@ -3768,542 +4118,609 @@ var file_pkg_api_v1_scdpb_scd_proto_rawDesc = []byte{
 	0x72, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75,
 	0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73,
 	0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x42,
-	0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x41, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75,
-	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x75, 0x75, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x1a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
+	0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22,
+	0x42, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x1a, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0xb0, 0x02, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x22, 0x29, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x51, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x6f, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
 	0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
 	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
 	0x13, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x65, 0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75,
+	0x75, 0x69, 0x64, 0x22, 0x6b, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x6f, 0x70,
 	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x22, 0xb0, 0x02, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x2e, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x30, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0x29, 0x0a, 0x0d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x22, 0x56, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74,
+	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x22, 0x40, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63,
+	0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x32,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x75,
+	0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x73,
+	0x49, 0x64, 0x22, 0x78, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73,
+	0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x31, 0x0a, 0x0b,
+	0x4c, 0x61, 0x74, 0x4c, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6e, 0x67, 0x22,
+	0x42, 0x0a, 0x14, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x77, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x10,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6c, 0x6f, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x76, 0x6c, 0x6f, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63,
+	0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0xe2, 0x02, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x6f, 0x76, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x76, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70,
+	0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65,
+	0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x69,
+	0x6d, 0x65, 0x54, 0x6f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x39, 0x0a, 0x07, 0x50, 0x6f,
+	0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x4c, 0x61, 0x74, 0x4c, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x63, 0x65, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79,
+	0x48, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x76, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x56,
+	0x12, 0x2b, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x1e,
+	0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31,
 	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
 	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
-	0x74, 0x22, 0x3f, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x6f, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x22, 0x4d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x6b, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x12, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22,
-	0x56, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x35, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x68, 0x69,
-	0x63, 0x6c, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x65,
-	0x6c, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x79, 0x22, 0x40, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x64,
-	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a,
-	0x1e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x34, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73,
-	0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x31, 0x0a, 0x0b, 0x4c, 0x61, 0x74, 0x4c, 0x6e,
-	0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6e, 0x67, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c, 0x6e, 0x67, 0x22, 0x42, 0x0a, 0x14, 0x4d, 0x61,
-	0x6b, 0x65, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x77,
-	0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
-	0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x37,
-	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x76, 0x6c, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x76, 0x6c, 0x6f, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
-	0x22, 0x85, 0x02, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x76, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x76, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
-	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64,
-	0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64,
-	0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0c,
-	0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x79,
-	0x67, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4c, 0x61,
-	0x74, 0x4c, 0x6e, 0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x65, 0x72, 0x74, 0x69,
-	0x63, 0x65, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x48, 0x12,
-	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x5f, 0x76, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x56, 0x12, 0x2b,
-	0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64,
-	0x65, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x70, 0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
-	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
-	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x1e, 0x50, 0x75,
-	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12,
-	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63,
-	0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64,
-	0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73,
-	0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x80, 0x01, 0x0a, 0x1d, 0x50, 0x75, 0x74, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x63, 0x64, 0x70,
-	0x62, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x1d, 0x50,
-	0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x09,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
-	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x3e, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xb2, 0x02, 0x0a, 0x1f, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x50, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x63, 0x64,
-	0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73,
-	0x65, 0x55, 0x72, 0x6c, 0x22, 0x7e, 0x0a, 0x1c, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x75, 0x75, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x19, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a,
-	0x16, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f,
-	0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6c,
-	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x7a, 0x0a, 0x16, 0x50, 0x75,
-	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26,
-	0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
-	0x12, 0x39, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
-	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x50, 0x75, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
+	0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6c, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75,
+	0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x80, 0x01, 0x0a, 0x1d, 0x50, 0x75,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x63,
+	0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
 	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x58, 0x0a, 0x19,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x64, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x34, 0x0a, 0x06, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x60, 0x0a, 0x23,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x6f, 0x66, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x0e,
-	0x61, 0x72, 0x65, 0x61, 0x4f, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x22, 0x75,
-	0x0a, 0x22, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x14, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x22, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x61,
-	0x72, 0x65, 0x61, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x0e, 0x61, 0x72, 0x65, 0x61, 0x4f, 0x66, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x22, 0x70, 0x0a, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
-	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x20, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73,
-	0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xb2, 0x01, 0x0a,
+	0x1d, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2e,
+	0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xeb, 0x02, 0x0a, 0x1f, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x50, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x63, 0x64, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42,
+	0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22,
+	0x7e, 0x0a, 0x1c, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x12,
+	0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x59, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x39, 0x0a, 0x10, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70,
-	0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x0e, 0x61, 0x72, 0x65, 0x61,
-	0x4f, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1b, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x76, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73,
-	0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0xaf, 0x03, 0x0a,
-	0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a,
-	0x14, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x33, 0x0a, 0x15, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x14, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x46, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
-	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x74,
-	0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64,
-	0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73,
-	0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6b,
-	0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x04, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xae, 0x01,
-	0x0a, 0x10, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x74,
-	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x30, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
-	0x64, 0x12, 0x2b, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x6c, 0x6f,
-	0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x22, 0x57,
-	0x0a, 0x08, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70,
-	0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f,
-	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x6e, 0x69,
-	0x74, 0x73, 0x53, 0x70, 0x65, 0x65, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x08, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x33, 0x44, 0x12, 0x36, 0x0a, 0x0e, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65,
-	0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73,
-	0x63, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x52, 0x0d, 0x61,
-	0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0e,
-	0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74,
-	0x69, 0x74, 0x75, 0x64, 0x65, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x55,
-	0x70, 0x70, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x5f,
-	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
-	0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x0d, 0x6f, 0x75, 0x74,
-	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0f, 0x6f, 0x75,
-	0x74, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x6c, 0x79,
-	0x67, 0x6f, 0x6e, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x79,
-	0x67, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x08, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44,
-	0x12, 0x26, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52,
-	0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73,
-	0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x33, 0x44, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x32, 0xb4, 0x0f,
-	0x0a, 0x1c, 0x55, 0x54, 0x4d, 0x41, 0x50, 0x49, 0x55, 0x53, 0x53, 0x44, 0x53, 0x53, 0x41, 0x6e,
-	0x64, 0x55, 0x53, 0x53, 0x55, 0x53, 0x53, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa2,
-	0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x2e, 0x73,
-	0x63, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x2a, 0x2a, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31,
-	0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75,
-	0x69, 0x64, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x26, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f,
+	0xf3, 0x01, 0x0a, 0x19, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a,
+	0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52,
+	0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61,
+	0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x7a, 0x0a, 0x16, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69,
+	0x64, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73,
+	0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x66, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x58, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x34, 0x0a, 0x06, 0x52, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x60, 0x0a, 0x23, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39,
+	0x0a, 0x10, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
+	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x0e, 0x61, 0x72, 0x65, 0x61, 0x4f,
+	0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x22, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4f, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x22, 0x82, 0x01, 0x0a, 0x22, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x10, 0x61, 0x72, 0x65, 0x61, 0x5f,
+	0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x34, 0x44, 0x52, 0x0e, 0x61, 0x72, 0x65, 0x61, 0x4f, 0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x70, 0x0a, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f,
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x65, 0x0a, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x63,
+	0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x59,
+	0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x39,
+	0x0a, 0x10, 0x61, 0x72, 0x65, 0x61, 0x5f, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
+	0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x34, 0x44, 0x52, 0x0e, 0x61, 0x72, 0x65, 0x61, 0x4f,
+	0x66, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x1b, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x75, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x41, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x73, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x75, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x73, 0x49, 0x64, 0x22, 0x69, 0x0a, 0x22, 0x53, 0x65,
+	0x74, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x72, 0x54, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x3e, 0x0a, 0x0d, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x75,
+	0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x22, 0xf0, 0x03,
+	0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31,
+	0x0a, 0x14, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x33, 0x0a, 0x15, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x46, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x46, 0x6f, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63,
+	0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x73, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x73, 0x42, 0x61,
+	0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x3f, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x22, 0x6b, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x50, 0x0a,
+	0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x30, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x4d, 0x0a, 0x15, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x73, 0x73, 0x22, 0x6f,
+	0x0a, 0x1d, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xae, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x65, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56, 0x65,
+	0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79,
+	0x22, 0x57, 0x0a, 0x08, 0x56, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75,
+	0x6e, 0x69, 0x74, 0x73, 0x53, 0x70, 0x65, 0x65, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x08, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x33, 0x44, 0x12, 0x36, 0x0a, 0x0e, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x52,
+	0x0d, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x0e, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x41,
+	0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x52, 0x0d, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x52, 0x0d, 0x6f,
+	0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0f,
+	0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x6f,
+	0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x6f,
+	0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x08, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x34, 0x44, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x33, 0x44, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x32,
+	0xd0, 0x11, 0x0a, 0x1c, 0x55, 0x54, 0x4d, 0x41, 0x50, 0x49, 0x55, 0x53, 0x53, 0x44, 0x53, 0x53,
+	0x41, 0x6e, 0x64, 0x55, 0x53, 0x53, 0x55, 0x53, 0x53, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0xa2, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27,
+	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
 	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x64, 0x73, 0x73, 0x2f,
-	0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75,
-	0x75, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x63,
-	0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x2a, 0x2a, 0x2f, 0x64, 0x73, 0x73, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x75, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x9e, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x26, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x63, 0x64,
+	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x2a, 0x29, 0x2f, 0x64, 0x73,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x75, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e,
 	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x7d,
-	0x12, 0x99, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x63,
-	0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x2a, 0x26, 0x2f, 0x64, 0x73, 0x73,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69,
+	0x64, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e,
+	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x95,
+	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x64, 0x73,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x75, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x63, 0x64,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x63, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x12, 0x26, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x20, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x73, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x73, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23,
+	0x12, 0x21, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x73, 0x5f, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2f, 0x7b, 0x75, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x73, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6b,
+	0x65, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x0f, 0x2f,
+	0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x3a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x16, 0x50, 0x75, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x24, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x43, 0x6f, 0x6e,
 	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
-	0x12, 0x2a, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x95, 0x01, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x63,
-	0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
 	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x12, 0x29, 0x2f, 0x64, 0x73, 0x73, 0x2f,
-	0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75,
-	0x75, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12,
-	0x26, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44,
-	0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
-	0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x19, 0x22, 0x0f, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x16, 0x50,
-	0x75, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75,
-	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x63,
-	0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x1a, 0x2a, 0x2f,
-	0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
-	0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0xa0, 0x01, 0x0a, 0x15, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x63,
-	0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x33, 0x1a, 0x29, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f,
-	0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x88, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62,
-	0x2e, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
-	0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x1a,
-	0x26, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0xa4, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e,
-	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x23, 0x2f, 0x64, 0x73, 0x73, 0x2f,
+	0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x1a, 0x2a, 0x2f, 0x64, 0x73, 0x73, 0x2f,
 	0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e,
-	0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x1b, 0x2f, 0x64, 0x73,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x75, 0x75, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa0, 0x01,
+	0x0a, 0x15, 0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x50, 0x75, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73,
+	0x63, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x1a, 0x29, 0x2f,
+	0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x75, 0x75, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x88, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x75, 0x74, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x1a, 0x26, 0x2f, 0x64, 0x73,
 	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0xa1, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27,
-	0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x22, 0x22, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76,
-	0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x63, 0x64, 0x70,
+	0x62, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x22, 0x23, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x63, 0x64, 0x70,
+	0x62, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x63,
+	0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x1b, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xa1, 0x01, 0x0a,
+	0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x63, 0x64,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2c, 0x22, 0x22, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x8f, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x20, 0x2e, 0x73, 0x63, 0x64, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x63, 0x64, 0x70,
+	0x62, 0x2e, 0x55, 0x73, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x1a, 0x21, 0x2f, 0x64, 0x73, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x75, 0x73, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x2f, 0x7b, 0x75, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4318,7 +4735,7 @@ func file_pkg_api_v1_scdpb_scd_proto_rawDescGZIP() []byte {
 	return file_pkg_api_v1_scdpb_scd_proto_rawDescData
 }
 
-var file_pkg_api_v1_scdpb_scd_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
+var file_pkg_api_v1_scdpb_scd_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_pkg_api_v1_scdpb_scd_proto_goTypes = []interface{}{
 	(*AirspaceConflictResponse)(nil),            // 0: scdpb.AirspaceConflictResponse
 	(*Altitude)(nil),                            // 1: scdpb.Altitude
@@ -4344,143 +4761,160 @@ var file_pkg_api_v1_scdpb_scd_proto_goTypes = []interface{}{
 	(*GetOperationTelemetryResponse)(nil),       // 21: scdpb.GetOperationTelemetryResponse
 	(*GetSubscriptionRequest)(nil),              // 22: scdpb.GetSubscriptionRequest
 	(*GetSubscriptionResponse)(nil),             // 23: scdpb.GetSubscriptionResponse
-	(*ImplicitSubscriptionParameters)(nil),      // 24: scdpb.ImplicitSubscriptionParameters
-	(*LatLngPoint)(nil),                         // 25: scdpb.LatLngPoint
-	(*MakeDssReportRequest)(nil),                // 26: scdpb.MakeDssReportRequest
-	(*Operation)(nil),                           // 27: scdpb.Operation
-	(*OperationDetails)(nil),                    // 28: scdpb.OperationDetails
-	(*OperationReference)(nil),                  // 29: scdpb.OperationReference
-	(*Polygon)(nil),                             // 30: scdpb.Polygon
-	(*Position)(nil),                            // 31: scdpb.Position
-	(*PutConstraintDetailsParameters)(nil),      // 32: scdpb.PutConstraintDetailsParameters
-	(*PutConstraintReferenceParameters)(nil),    // 33: scdpb.PutConstraintReferenceParameters
-	(*PutConstraintReferenceRequest)(nil),       // 34: scdpb.PutConstraintReferenceRequest
-	(*PutOperationDetailsParameters)(nil),       // 35: scdpb.PutOperationDetailsParameters
-	(*PutOperationReferenceParameters)(nil),     // 36: scdpb.PutOperationReferenceParameters
-	(*PutOperationReferenceRequest)(nil),        // 37: scdpb.PutOperationReferenceRequest
-	(*PutSubscriptionParameters)(nil),           // 38: scdpb.PutSubscriptionParameters
-	(*PutSubscriptionRequest)(nil),              // 39: scdpb.PutSubscriptionRequest
-	(*PutSubscriptionResponse)(nil),             // 40: scdpb.PutSubscriptionResponse
-	(*QueryConstraintReferencesRequest)(nil),    // 41: scdpb.QueryConstraintReferencesRequest
-	(*QuerySubscriptionsRequest)(nil),           // 42: scdpb.QuerySubscriptionsRequest
-	(*Radius)(nil),                              // 43: scdpb.Radius
-	(*SearchConstraintReferenceParameters)(nil), // 44: scdpb.SearchConstraintReferenceParameters
-	(*SearchConstraintReferencesResponse)(nil),  // 45: scdpb.SearchConstraintReferencesResponse
-	(*SearchOperationReferenceParameters)(nil),  // 46: scdpb.SearchOperationReferenceParameters
-	(*SearchOperationReferenceResponse)(nil),    // 47: scdpb.SearchOperationReferenceResponse
-	(*SearchOperationReferencesRequest)(nil),    // 48: scdpb.SearchOperationReferencesRequest
-	(*SearchSubscriptionParameters)(nil),        // 49: scdpb.SearchSubscriptionParameters
-	(*SearchSubscriptionsResponse)(nil),         // 50: scdpb.SearchSubscriptionsResponse
-	(*SubscriberToNotify)(nil),                  // 51: scdpb.SubscriberToNotify
-	(*Subscription)(nil),                        // 52: scdpb.Subscription
-	(*SubscriptionState)(nil),                   // 53: scdpb.SubscriptionState
-	(*Time)(nil),                                // 54: scdpb.Time
-	(*VehicleTelemetry)(nil),                    // 55: scdpb.VehicleTelemetry
-	(*Velocity)(nil),                            // 56: scdpb.Velocity
-	(*Volume3D)(nil),                            // 57: scdpb.Volume3D
-	(*Volume4D)(nil),                            // 58: scdpb.Volume4D
-	(*timestamp.Timestamp)(nil),                 // 59: google.protobuf.Timestamp
+	(*GetUssAvailabilityRequest)(nil),           // 24: scdpb.GetUssAvailabilityRequest
+	(*ImplicitSubscriptionParameters)(nil),      // 25: scdpb.ImplicitSubscriptionParameters
+	(*LatLngPoint)(nil),                         // 26: scdpb.LatLngPoint
+	(*MakeDssReportRequest)(nil),                // 27: scdpb.MakeDssReportRequest
+	(*Operation)(nil),                           // 28: scdpb.Operation
+	(*OperationDetails)(nil),                    // 29: scdpb.OperationDetails
+	(*OperationReference)(nil),                  // 30: scdpb.OperationReference
+	(*Polygon)(nil),                             // 31: scdpb.Polygon
+	(*Position)(nil),                            // 32: scdpb.Position
+	(*PutConstraintDetailsParameters)(nil),      // 33: scdpb.PutConstraintDetailsParameters
+	(*PutConstraintReferenceParameters)(nil),    // 34: scdpb.PutConstraintReferenceParameters
+	(*PutConstraintReferenceRequest)(nil),       // 35: scdpb.PutConstraintReferenceRequest
+	(*PutOperationDetailsParameters)(nil),       // 36: scdpb.PutOperationDetailsParameters
+	(*PutOperationReferenceParameters)(nil),     // 37: scdpb.PutOperationReferenceParameters
+	(*PutOperationReferenceRequest)(nil),        // 38: scdpb.PutOperationReferenceRequest
+	(*PutSubscriptionParameters)(nil),           // 39: scdpb.PutSubscriptionParameters
+	(*PutSubscriptionRequest)(nil),              // 40: scdpb.PutSubscriptionRequest
+	(*PutSubscriptionResponse)(nil),             // 41: scdpb.PutSubscriptionResponse
+	(*QueryConstraintReferencesRequest)(nil),    // 42: scdpb.QueryConstraintReferencesRequest
+	(*QuerySubscriptionsRequest)(nil),           // 43: scdpb.QuerySubscriptionsRequest
+	(*Radius)(nil),                              // 44: scdpb.Radius
+	(*SearchConstraintReferenceParameters)(nil), // 45: scdpb.SearchConstraintReferenceParameters
+	(*SearchConstraintReferencesResponse)(nil),  // 46: scdpb.SearchConstraintReferencesResponse
+	(*SearchOperationReferenceParameters)(nil),  // 47: scdpb.SearchOperationReferenceParameters
+	(*SearchOperationReferenceResponse)(nil),    // 48: scdpb.SearchOperationReferenceResponse
+	(*SearchOperationReferencesRequest)(nil),    // 49: scdpb.SearchOperationReferencesRequest
+	(*SearchSubscriptionParameters)(nil),        // 50: scdpb.SearchSubscriptionParameters
+	(*SearchSubscriptionsResponse)(nil),         // 51: scdpb.SearchSubscriptionsResponse
+	(*SetUssAvailabilityRequest)(nil),           // 52: scdpb.SetUssAvailabilityRequest
+	(*SetUssAvailabilityStatusParameters)(nil),  // 53: scdpb.SetUssAvailabilityStatusParameters
+	(*SubscriberToNotify)(nil),                  // 54: scdpb.SubscriberToNotify
+	(*Subscription)(nil),                        // 55: scdpb.Subscription
+	(*SubscriptionState)(nil),                   // 56: scdpb.SubscriptionState
+	(*Time)(nil),                                // 57: scdpb.Time
+	(*UssAvailabilityStatus)(nil),               // 58: scdpb.UssAvailabilityStatus
+	(*UssAvailabilityStatusResponse)(nil),       // 59: scdpb.UssAvailabilityStatusResponse
+	(*VehicleTelemetry)(nil),                    // 60: scdpb.VehicleTelemetry
+	(*Velocity)(nil),                            // 61: scdpb.Velocity
+	(*Volume3D)(nil),                            // 62: scdpb.Volume3D
+	(*Volume4D)(nil),                            // 63: scdpb.Volume4D
+	(*duration.Duration)(nil),                   // 64: google.protobuf.Duration
+	(*wrappers.Int32Value)(nil),                 // 65: google.protobuf.Int32Value
+	(*timestamp.Timestamp)(nil),                 // 66: google.protobuf.Timestamp
 }
 var file_pkg_api_v1_scdpb_scd_proto_depIdxs = []int32{
 	12, // 0: scdpb.AirspaceConflictResponse.entity_conflicts:type_name -> scdpb.EntityReference
 	7,  // 1: scdpb.ChangeConstraintReferenceResponse.constraint_reference:type_name -> scdpb.ConstraintReference
-	51, // 2: scdpb.ChangeConstraintReferenceResponse.subscribers:type_name -> scdpb.SubscriberToNotify
-	29, // 3: scdpb.ChangeOperationReferenceResponse.operation_reference:type_name -> scdpb.OperationReference
-	51, // 4: scdpb.ChangeOperationReferenceResponse.subscribers:type_name -> scdpb.SubscriberToNotify
-	25, // 5: scdpb.Circle.center:type_name -> scdpb.LatLngPoint
-	43, // 6: scdpb.Circle.radius:type_name -> scdpb.Radius
+	54, // 2: scdpb.ChangeConstraintReferenceResponse.subscribers:type_name -> scdpb.SubscriberToNotify
+	30, // 3: scdpb.ChangeOperationReferenceResponse.operation_reference:type_name -> scdpb.OperationReference
+	54, // 4: scdpb.ChangeOperationReferenceResponse.subscribers:type_name -> scdpb.SubscriberToNotify
+	26, // 5: scdpb.Circle.center:type_name -> scdpb.LatLngPoint
+	44, // 6: scdpb.Circle.radius:type_name -> scdpb.Radius
 	6,  // 7: scdpb.Constraint.details:type_name -> scdpb.ConstraintDetails
 	7,  // 8: scdpb.Constraint.reference:type_name -> scdpb.ConstraintReference
-	58, // 9: scdpb.ConstraintDetails.volumes:type_name -> scdpb.Volume4D
-	54, // 10: scdpb.ConstraintReference.time_end:type_name -> scdpb.Time
-	54, // 11: scdpb.ConstraintReference.time_start:type_name -> scdpb.Time
-	52, // 12: scdpb.DeleteSubscriptionResponse.subscription:type_name -> scdpb.Subscription
-	7,  // 13: scdpb.EntityReference.constraint_reference:type_name -> scdpb.ConstraintReference
-	29, // 14: scdpb.EntityReference.operation_reference:type_name -> scdpb.OperationReference
-	54, // 15: scdpb.ErrorReport.time_request:type_name -> scdpb.Time
-	54, // 16: scdpb.ErrorReport.time_response:type_name -> scdpb.Time
-	5,  // 17: scdpb.GetConstraintDetailsResponse.constraint:type_name -> scdpb.Constraint
-	7,  // 18: scdpb.GetConstraintReferenceResponse.constraint_reference:type_name -> scdpb.ConstraintReference
-	27, // 19: scdpb.GetOperationDetailsResponse.operation:type_name -> scdpb.Operation
-	29, // 20: scdpb.GetOperationReferenceResponse.operation_reference:type_name -> scdpb.OperationReference
-	55, // 21: scdpb.GetOperationTelemetryResponse.telemetry:type_name -> scdpb.VehicleTelemetry
-	52, // 22: scdpb.GetSubscriptionResponse.subscription:type_name -> scdpb.Subscription
-	13, // 23: scdpb.MakeDssReportRequest.params:type_name -> scdpb.ErrorReport
-	28, // 24: scdpb.Operation.details:type_name -> scdpb.OperationDetails
-	29, // 25: scdpb.Operation.reference:type_name -> scdpb.OperationReference
-	58, // 26: scdpb.OperationDetails.volumes:type_name -> scdpb.Volume4D
-	54, // 27: scdpb.OperationReference.time_end:type_name -> scdpb.Time
-	54, // 28: scdpb.OperationReference.time_start:type_name -> scdpb.Time
-	25, // 29: scdpb.Polygon.vertices:type_name -> scdpb.LatLngPoint
-	1,  // 30: scdpb.Position.altitude:type_name -> scdpb.Altitude
-	5,  // 31: scdpb.PutConstraintDetailsParameters.constraint:type_name -> scdpb.Constraint
-	53, // 32: scdpb.PutConstraintDetailsParameters.subscriptions:type_name -> scdpb.SubscriptionState
-	58, // 33: scdpb.PutConstraintReferenceParameters.extents:type_name -> scdpb.Volume4D
-	33, // 34: scdpb.PutConstraintReferenceRequest.params:type_name -> scdpb.PutConstraintReferenceParameters
-	27, // 35: scdpb.PutOperationDetailsParameters.operation:type_name -> scdpb.Operation
-	53, // 36: scdpb.PutOperationDetailsParameters.subscriptions:type_name -> scdpb.SubscriptionState
-	58, // 37: scdpb.PutOperationReferenceParameters.extents:type_name -> scdpb.Volume4D
-	24, // 38: scdpb.PutOperationReferenceParameters.new_subscription:type_name -> scdpb.ImplicitSubscriptionParameters
-	36, // 39: scdpb.PutOperationReferenceRequest.params:type_name -> scdpb.PutOperationReferenceParameters
-	58, // 40: scdpb.PutSubscriptionParameters.extents:type_name -> scdpb.Volume4D
-	38, // 41: scdpb.PutSubscriptionRequest.params:type_name -> scdpb.PutSubscriptionParameters
-	7,  // 42: scdpb.PutSubscriptionResponse.constraints:type_name -> scdpb.ConstraintReference
-	29, // 43: scdpb.PutSubscriptionResponse.operations:type_name -> scdpb.OperationReference
-	52, // 44: scdpb.PutSubscriptionResponse.subscription:type_name -> scdpb.Subscription
-	44, // 45: scdpb.QueryConstraintReferencesRequest.params:type_name -> scdpb.SearchConstraintReferenceParameters
-	49, // 46: scdpb.QuerySubscriptionsRequest.params:type_name -> scdpb.SearchSubscriptionParameters
-	58, // 47: scdpb.SearchConstraintReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
-	7,  // 48: scdpb.SearchConstraintReferencesResponse.constraint_references:type_name -> scdpb.ConstraintReference
-	58, // 49: scdpb.SearchOperationReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
-	29, // 50: scdpb.SearchOperationReferenceResponse.operation_references:type_name -> scdpb.OperationReference
-	46, // 51: scdpb.SearchOperationReferencesRequest.params:type_name -> scdpb.SearchOperationReferenceParameters
-	58, // 52: scdpb.SearchSubscriptionParameters.area_of_interest:type_name -> scdpb.Volume4D
-	52, // 53: scdpb.SearchSubscriptionsResponse.subscriptions:type_name -> scdpb.Subscription
-	53, // 54: scdpb.SubscriberToNotify.subscriptions:type_name -> scdpb.SubscriptionState
-	54, // 55: scdpb.Subscription.time_end:type_name -> scdpb.Time
-	54, // 56: scdpb.Subscription.time_start:type_name -> scdpb.Time
-	59, // 57: scdpb.Time.value:type_name -> google.protobuf.Timestamp
-	31, // 58: scdpb.VehicleTelemetry.position:type_name -> scdpb.Position
-	54, // 59: scdpb.VehicleTelemetry.time_measured:type_name -> scdpb.Time
-	56, // 60: scdpb.VehicleTelemetry.velocity:type_name -> scdpb.Velocity
-	1,  // 61: scdpb.Volume3D.altitude_lower:type_name -> scdpb.Altitude
-	1,  // 62: scdpb.Volume3D.altitude_upper:type_name -> scdpb.Altitude
-	4,  // 63: scdpb.Volume3D.outline_circle:type_name -> scdpb.Circle
-	30, // 64: scdpb.Volume3D.outline_polygon:type_name -> scdpb.Polygon
-	54, // 65: scdpb.Volume4D.time_end:type_name -> scdpb.Time
-	54, // 66: scdpb.Volume4D.time_start:type_name -> scdpb.Time
-	57, // 67: scdpb.Volume4D.volume:type_name -> scdpb.Volume3D
-	8,  // 68: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:input_type -> scdpb.DeleteConstraintReferenceRequest
-	9,  // 69: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationReference:input_type -> scdpb.DeleteOperationReferenceRequest
-	10, // 70: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:input_type -> scdpb.DeleteSubscriptionRequest
-	16, // 71: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:input_type -> scdpb.GetConstraintReferenceRequest
-	19, // 72: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationReference:input_type -> scdpb.GetOperationReferenceRequest
-	22, // 73: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:input_type -> scdpb.GetSubscriptionRequest
-	26, // 74: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:input_type -> scdpb.MakeDssReportRequest
-	34, // 75: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutConstraintReference:input_type -> scdpb.PutConstraintReferenceRequest
-	37, // 76: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutOperationReference:input_type -> scdpb.PutOperationReferenceRequest
-	39, // 77: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutSubscription:input_type -> scdpb.PutSubscriptionRequest
-	41, // 78: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:input_type -> scdpb.QueryConstraintReferencesRequest
-	42, // 79: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:input_type -> scdpb.QuerySubscriptionsRequest
-	48, // 80: scdpb.UTMAPIUSSDSSAndUSSUSSService.SearchOperationReferences:input_type -> scdpb.SearchOperationReferencesRequest
-	2,  // 81: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
-	3,  // 82: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationReference:output_type -> scdpb.ChangeOperationReferenceResponse
-	11, // 83: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:output_type -> scdpb.DeleteSubscriptionResponse
-	17, // 84: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:output_type -> scdpb.GetConstraintReferenceResponse
-	20, // 85: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationReference:output_type -> scdpb.GetOperationReferenceResponse
-	23, // 86: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:output_type -> scdpb.GetSubscriptionResponse
-	13, // 87: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:output_type -> scdpb.ErrorReport
-	2,  // 88: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
-	3,  // 89: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutOperationReference:output_type -> scdpb.ChangeOperationReferenceResponse
-	40, // 90: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutSubscription:output_type -> scdpb.PutSubscriptionResponse
-	45, // 91: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:output_type -> scdpb.SearchConstraintReferencesResponse
-	50, // 92: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:output_type -> scdpb.SearchSubscriptionsResponse
-	47, // 93: scdpb.UTMAPIUSSDSSAndUSSUSSService.SearchOperationReferences:output_type -> scdpb.SearchOperationReferenceResponse
-	81, // [81:94] is the sub-list for method output_type
-	68, // [68:81] is the sub-list for method input_type
-	68, // [68:68] is the sub-list for extension type_name
-	68, // [68:68] is the sub-list for extension extendee
-	0,  // [0:68] is the sub-list for field type_name
+	63, // 9: scdpb.ConstraintDetails.volumes:type_name -> scdpb.Volume4D
+	57, // 10: scdpb.ConstraintReference.time_end:type_name -> scdpb.Time
+	57, // 11: scdpb.ConstraintReference.time_start:type_name -> scdpb.Time
+	64, // 12: scdpb.ConstraintReference.time_to_expiry:type_name -> google.protobuf.Duration
+	55, // 13: scdpb.DeleteSubscriptionResponse.subscription:type_name -> scdpb.Subscription
+	7,  // 14: scdpb.EntityReference.constraint_reference:type_name -> scdpb.ConstraintReference
+	30, // 15: scdpb.EntityReference.operation_reference:type_name -> scdpb.OperationReference
+	57, // 16: scdpb.ErrorReport.time_request:type_name -> scdpb.Time
+	57, // 17: scdpb.ErrorReport.time_response:type_name -> scdpb.Time
+	5,  // 18: scdpb.GetConstraintDetailsResponse.constraint:type_name -> scdpb.Constraint
+	7,  // 19: scdpb.GetConstraintReferenceResponse.constraint_reference:type_name -> scdpb.ConstraintReference
+	28, // 20: scdpb.GetOperationDetailsResponse.operation:type_name -> scdpb.Operation
+	30, // 21: scdpb.GetOperationReferenceResponse.operation_reference:type_name -> scdpb.OperationReference
+	60, // 22: scdpb.GetOperationTelemetryResponse.telemetry:type_name -> scdpb.VehicleTelemetry
+	55, // 23: scdpb.GetSubscriptionResponse.subscription:type_name -> scdpb.Subscription
+	13, // 24: scdpb.MakeDssReportRequest.params:type_name -> scdpb.ErrorReport
+	29, // 25: scdpb.Operation.details:type_name -> scdpb.OperationDetails
+	30, // 26: scdpb.Operation.reference:type_name -> scdpb.OperationReference
+	63, // 27: scdpb.OperationDetails.volumes:type_name -> scdpb.Volume4D
+	57, // 28: scdpb.OperationReference.time_end:type_name -> scdpb.Time
+	57, // 29: scdpb.OperationReference.time_start:type_name -> scdpb.Time
+	64, // 30: scdpb.OperationReference.time_to_expiry:type_name -> google.protobuf.Duration
+	26, // 31: scdpb.Polygon.vertices:type_name -> scdpb.LatLngPoint
+	1,  // 32: scdpb.Position.altitude:type_name -> scdpb.Altitude
+	5,  // 33: scdpb.PutConstraintDetailsParameters.constraint:type_name -> scdpb.Constraint
+	56, // 34: scdpb.PutConstraintDetailsParameters.subscriptions:type_name -> scdpb.SubscriptionState
+	63, // 35: scdpb.PutConstraintReferenceParameters.extents:type_name -> scdpb.Volume4D
+	34, // 36: scdpb.PutConstraintReferenceRequest.params:type_name -> scdpb.PutConstraintReferenceParameters
+	28, // 37: scdpb.PutOperationDetailsParameters.operation:type_name -> scdpb.Operation
+	56, // 38: scdpb.PutOperationDetailsParameters.subscriptions:type_name -> scdpb.SubscriptionState
+	63, // 39: scdpb.PutOperationReferenceParameters.extents:type_name -> scdpb.Volume4D
+	25, // 40: scdpb.PutOperationReferenceParameters.new_subscription:type_name -> scdpb.ImplicitSubscriptionParameters
+	65, // 41: scdpb.PutOperationReferenceParameters.priority:type_name -> google.protobuf.Int32Value
+	37, // 42: scdpb.PutOperationReferenceRequest.params:type_name -> scdpb.PutOperationReferenceParameters
+	63, // 43: scdpb.PutSubscriptionParameters.extents:type_name -> scdpb.Volume4D
+	39, // 44: scdpb.PutSubscriptionRequest.params:type_name -> scdpb.PutSubscriptionParameters
+	7,  // 45: scdpb.PutSubscriptionResponse.constraints:type_name -> scdpb.ConstraintReference
+	30, // 46: scdpb.PutSubscriptionResponse.operations:type_name -> scdpb.OperationReference
+	55, // 47: scdpb.PutSubscriptionResponse.subscription:type_name -> scdpb.Subscription
+	45, // 48: scdpb.QueryConstraintReferencesRequest.params:type_name -> scdpb.SearchConstraintReferenceParameters
+	50, // 49: scdpb.QuerySubscriptionsRequest.params:type_name -> scdpb.SearchSubscriptionParameters
+	63, // 50: scdpb.SearchConstraintReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
+	7,  // 51: scdpb.SearchConstraintReferencesResponse.constraint_references:type_name -> scdpb.ConstraintReference
+	63, // 52: scdpb.SearchOperationReferenceParameters.area_of_interest:type_name -> scdpb.Volume4D
+	30, // 53: scdpb.SearchOperationReferenceResponse.operation_references:type_name -> scdpb.OperationReference
+	47, // 54: scdpb.SearchOperationReferencesRequest.params:type_name -> scdpb.SearchOperationReferenceParameters
+	63, // 55: scdpb.SearchSubscriptionParameters.area_of_interest:type_name -> scdpb.Volume4D
+	55, // 56: scdpb.SearchSubscriptionsResponse.subscriptions:type_name -> scdpb.Subscription
+	53, // 57: scdpb.SetUssAvailabilityRequest.params:type_name -> scdpb.SetUssAvailabilityStatusParameters
+	56, // 58: scdpb.SubscriberToNotify.subscriptions:type_name -> scdpb.SubscriptionState
+	57, // 59: scdpb.Subscription.time_end:type_name -> scdpb.Time
+	57, // 60: scdpb.Subscription.time_start:type_name -> scdpb.Time
+	64, // 61: scdpb.Subscription.time_to_expiry:type_name -> google.protobuf.Duration
+	66, // 62: scdpb.Time.value:type_name -> google.protobuf.Timestamp
+	58, // 63: scdpb.UssAvailabilityStatusResponse.status:type_name -> scdpb.UssAvailabilityStatus
+	32, // 64: scdpb.VehicleTelemetry.position:type_name -> scdpb.Position
+	57, // 65: scdpb.VehicleTelemetry.time_measured:type_name -> scdpb.Time
+	61, // 66: scdpb.VehicleTelemetry.velocity:type_name -> scdpb.Velocity
+	1,  // 67: scdpb.Volume3D.altitude_lower:type_name -> scdpb.Altitude
+	1,  // 68: scdpb.Volume3D.altitude_upper:type_name -> scdpb.Altitude
+	4,  // 69: scdpb.Volume3D.outline_circle:type_name -> scdpb.Circle
+	31, // 70: scdpb.Volume3D.outline_polygon:type_name -> scdpb.Polygon
+	57, // 71: scdpb.Volume4D.time_end:type_name -> scdpb.Time
+	57, // 72: scdpb.Volume4D.time_start:type_name -> scdpb.Time
+	62, // 73: scdpb.Volume4D.volume:type_name -> scdpb.Volume3D
+	8,  // 74: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:input_type -> scdpb.DeleteConstraintReferenceRequest
+	9,  // 75: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationReference:input_type -> scdpb.DeleteOperationReferenceRequest
+	10, // 76: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:input_type -> scdpb.DeleteSubscriptionRequest
+	16, // 77: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:input_type -> scdpb.GetConstraintReferenceRequest
+	19, // 78: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationReference:input_type -> scdpb.GetOperationReferenceRequest
+	22, // 79: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:input_type -> scdpb.GetSubscriptionRequest
+	24, // 80: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetUssAvailability:input_type -> scdpb.GetUssAvailabilityRequest
+	27, // 81: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:input_type -> scdpb.MakeDssReportRequest
+	35, // 82: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutConstraintReference:input_type -> scdpb.PutConstraintReferenceRequest
+	38, // 83: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutOperationReference:input_type -> scdpb.PutOperationReferenceRequest
+	40, // 84: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutSubscription:input_type -> scdpb.PutSubscriptionRequest
+	42, // 85: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:input_type -> scdpb.QueryConstraintReferencesRequest
+	43, // 86: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:input_type -> scdpb.QuerySubscriptionsRequest
+	49, // 87: scdpb.UTMAPIUSSDSSAndUSSUSSService.SearchOperationReferences:input_type -> scdpb.SearchOperationReferencesRequest
+	52, // 88: scdpb.UTMAPIUSSDSSAndUSSUSSService.SetUssAvailability:input_type -> scdpb.SetUssAvailabilityRequest
+	2,  // 89: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
+	3,  // 90: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteOperationReference:output_type -> scdpb.ChangeOperationReferenceResponse
+	11, // 91: scdpb.UTMAPIUSSDSSAndUSSUSSService.DeleteSubscription:output_type -> scdpb.DeleteSubscriptionResponse
+	17, // 92: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetConstraintReference:output_type -> scdpb.GetConstraintReferenceResponse
+	20, // 93: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetOperationReference:output_type -> scdpb.GetOperationReferenceResponse
+	23, // 94: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetSubscription:output_type -> scdpb.GetSubscriptionResponse
+	59, // 95: scdpb.UTMAPIUSSDSSAndUSSUSSService.GetUssAvailability:output_type -> scdpb.UssAvailabilityStatusResponse
+	13, // 96: scdpb.UTMAPIUSSDSSAndUSSUSSService.MakeDssReport:output_type -> scdpb.ErrorReport
+	2,  // 97: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutConstraintReference:output_type -> scdpb.ChangeConstraintReferenceResponse
+	3,  // 98: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutOperationReference:output_type -> scdpb.ChangeOperationReferenceResponse
+	41, // 99: scdpb.UTMAPIUSSDSSAndUSSUSSService.PutSubscription:output_type -> scdpb.PutSubscriptionResponse
+	46, // 100: scdpb.UTMAPIUSSDSSAndUSSUSSService.QueryConstraintReferences:output_type -> scdpb.SearchConstraintReferencesResponse
+	51, // 101: scdpb.UTMAPIUSSDSSAndUSSUSSService.QuerySubscriptions:output_type -> scdpb.SearchSubscriptionsResponse
+	48, // 102: scdpb.UTMAPIUSSDSSAndUSSUSSService.SearchOperationReferences:output_type -> scdpb.SearchOperationReferenceResponse
+	59, // 103: scdpb.UTMAPIUSSDSSAndUSSUSSService.SetUssAvailability:output_type -> scdpb.UssAvailabilityStatusResponse
+	89, // [89:104] is the sub-list for method output_type
+	74, // [74:89] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_pkg_api_v1_scdpb_scd_proto_init() }
@@ -4778,7 +5212,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImplicitSubscriptionParameters); i {
+			switch v := v.(*GetUssAvailabilityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4790,7 +5224,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LatLngPoint); i {
+			switch v := v.(*ImplicitSubscriptionParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4802,7 +5236,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeDssReportRequest); i {
+			switch v := v.(*LatLngPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4814,7 +5248,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operation); i {
+			switch v := v.(*MakeDssReportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4826,7 +5260,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationDetails); i {
+			switch v := v.(*Operation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4838,7 +5272,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationReference); i {
+			switch v := v.(*OperationDetails); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4850,7 +5284,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Polygon); i {
+			switch v := v.(*OperationReference); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4862,7 +5296,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Position); i {
+			switch v := v.(*Polygon); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4874,7 +5308,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutConstraintDetailsParameters); i {
+			switch v := v.(*Position); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4886,7 +5320,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutConstraintReferenceParameters); i {
+			switch v := v.(*PutConstraintDetailsParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4898,7 +5332,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutConstraintReferenceRequest); i {
+			switch v := v.(*PutConstraintReferenceParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4910,7 +5344,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutOperationDetailsParameters); i {
+			switch v := v.(*PutConstraintReferenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4922,7 +5356,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutOperationReferenceParameters); i {
+			switch v := v.(*PutOperationDetailsParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4934,7 +5368,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutOperationReferenceRequest); i {
+			switch v := v.(*PutOperationReferenceParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4946,7 +5380,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutSubscriptionParameters); i {
+			switch v := v.(*PutOperationReferenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4958,7 +5392,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutSubscriptionRequest); i {
+			switch v := v.(*PutSubscriptionParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4970,7 +5404,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutSubscriptionResponse); i {
+			switch v := v.(*PutSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4982,7 +5416,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryConstraintReferencesRequest); i {
+			switch v := v.(*PutSubscriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4994,7 +5428,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySubscriptionsRequest); i {
+			switch v := v.(*QueryConstraintReferencesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5006,7 +5440,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Radius); i {
+			switch v := v.(*QuerySubscriptionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5018,7 +5452,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchConstraintReferenceParameters); i {
+			switch v := v.(*Radius); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5030,7 +5464,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchConstraintReferencesResponse); i {
+			switch v := v.(*SearchConstraintReferenceParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5042,7 +5476,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchOperationReferenceParameters); i {
+			switch v := v.(*SearchConstraintReferencesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5054,7 +5488,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchOperationReferenceResponse); i {
+			switch v := v.(*SearchOperationReferenceParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5066,7 +5500,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchOperationReferencesRequest); i {
+			switch v := v.(*SearchOperationReferenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5078,7 +5512,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchSubscriptionParameters); i {
+			switch v := v.(*SearchOperationReferencesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5090,7 +5524,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchSubscriptionsResponse); i {
+			switch v := v.(*SearchSubscriptionParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5102,7 +5536,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscriberToNotify); i {
+			switch v := v.(*SearchSubscriptionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5114,7 +5548,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Subscription); i {
+			switch v := v.(*SetUssAvailabilityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5126,7 +5560,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscriptionState); i {
+			switch v := v.(*SetUssAvailabilityStatusParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5138,7 +5572,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Time); i {
+			switch v := v.(*SubscriberToNotify); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5150,7 +5584,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VehicleTelemetry); i {
+			switch v := v.(*Subscription); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5162,7 +5596,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Velocity); i {
+			switch v := v.(*SubscriptionState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5174,7 +5608,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Volume3D); i {
+			switch v := v.(*Time); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5186,6 +5620,66 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			}
 		}
 		file_pkg_api_v1_scdpb_scd_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UssAvailabilityStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_scdpb_scd_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UssAvailabilityStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_scdpb_scd_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleTelemetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_scdpb_scd_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Velocity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_scdpb_scd_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Volume3D); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_api_v1_scdpb_scd_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Volume4D); i {
 			case 0:
 				return &v.state
@@ -5204,7 +5698,7 @@ func file_pkg_api_v1_scdpb_scd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_api_v1_scdpb_scd_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   59,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -5244,6 +5738,10 @@ type UTMAPIUSSDSSAndUSSUSSServiceClient interface {
 	//
 	// Retrieve a specific subscription.
 	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*GetSubscriptionResponse, error)
+	// Retrieve the availability of the specified USS.
+	//
+	// USSs whose availability was never set are reported as `Unknown`.
+	GetUssAvailability(ctx context.Context, in *GetUssAvailabilityRequest, opts ...grpc.CallOption) (*UssAvailabilityStatusResponse, error)
 	// Report information about communication issues to a DSS.
 	//
 	// Report issues to a DSS. Data sent to this endpoint is archived.
@@ -5277,6 +5775,10 @@ type UTMAPIUSSDSSAndUSSUSSServiceClient interface {
 	QuerySubscriptions(ctx context.Context, in *QuerySubscriptionsRequest, opts ...grpc.CallOption) (*SearchSubscriptionsResponse, error)
 	// Retrieve all Operation references in the specified area/volume/time from the DSS.
 	SearchOperationReferences(ctx context.Context, in *SearchOperationReferencesRequest, opts ...grpc.CallOption) (*SearchOperationReferenceResponse, error)
+	// Set the availability of the calling USS.
+	//
+	// A USS may only set its own availability.
+	SetUssAvailability(ctx context.Context, in *SetUssAvailabilityRequest, opts ...grpc.CallOption) (*UssAvailabilityStatusResponse, error)
 }
 
 type uTMAPIUSSDSSAndUSSUSSServiceClient struct {
@@ -5341,6 +5843,15 @@ func (c *uTMAPIUSSDSSAndUSSUSSServiceClient) GetSubscription(ctx context.Context
 	return out, nil
 }
 
+func (c *uTMAPIUSSDSSAndUSSUSSServiceClient) GetUssAvailability(ctx context.Context, in *GetUssAvailabilityRequest, opts ...grpc.CallOption) (*UssAvailabilityStatusResponse, error) {
+	out := new(UssAvailabilityStatusResponse)
+	err := c.cc.Invoke(ctx, "/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetUssAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *uTMAPIUSSDSSAndUSSUSSServiceClient) MakeDssReport(ctx context.Context, in *MakeDssReportRequest, opts ...grpc.CallOption) (*ErrorReport, error) {
 	out := new(ErrorReport)
 	err := c.cc.Invoke(ctx, "/scdpb.UTMAPIUSSDSSAndUSSUSSService/MakeDssReport", in, out, opts...)
@@ -5404,6 +5915,15 @@ func (c *uTMAPIUSSDSSAndUSSUSSServiceClient) SearchOperationReferences(ctx conte
 	return out, nil
 }
 
+func (c *uTMAPIUSSDSSAndUSSUSSServiceClient) SetUssAvailability(ctx context.Context, in *SetUssAvailabilityRequest, opts ...grpc.CallOption) (*UssAvailabilityStatusResponse, error) {
+	out := new(UssAvailabilityStatusResponse)
+	err := c.cc.Invoke(ctx, "/scdpb.UTMAPIUSSDSSAndUSSUSSService/SetUssAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UTMAPIUSSDSSAndUSSUSSServiceServer is the server API for UTMAPIUSSDSSAndUSSUSSService service.
 type UTMAPIUSSDSSAndUSSUSSServiceServer interface {
 	// Delete the specified Constraint reference from the DSS.
@@ -5420,6 +5940,10 @@ type UTMAPIUSSDSSAndUSSUSSServiceServer interface {
 	//
 	// Retrieve a specific subscription.
 	GetSubscription(context.Context, *GetSubscriptionRequest) (*GetSubscriptionResponse, error)
+	// Retrieve the availability of the specified USS.
+	//
+	// USSs whose availability was never set are reported as `Unknown`.
+	GetUssAvailability(context.Context, *GetUssAvailabilityRequest) (*UssAvailabilityStatusResponse, error)
 	// Report information about communication issues to a DSS.
 	//
 	// Report issues to a DSS. Data sent to this endpoint is archived.
@@ -5453,6 +5977,10 @@ type UTMAPIUSSDSSAndUSSUSSServiceServer interface {
 	QuerySubscriptions(context.Context, *QuerySubscriptionsRequest) (*SearchSubscriptionsResponse, error)
 	// Retrieve all Operation references in the specified area/volume/time from the DSS.
 	SearchOperationReferences(context.Context, *SearchOperationReferencesRequest) (*SearchOperationReferenceResponse, error)
+	// Set the availability of the calling USS.
+	//
+	// A USS may only set its own availability.
+	SetUssAvailability(context.Context, *SetUssAvailabilityRequest) (*UssAvailabilityStatusResponse, error)
 }
 
 // UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer can be embedded to have forward compatible implementations.
@@ -5477,6 +6005,9 @@ func (*UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer) GetOperationReference(co
 func (*UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*GetSubscriptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
 }
+func (*UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer) GetUssAvailability(context.Context, *GetUssAvailabilityRequest) (*UssAvailabilityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUssAvailability not implemented")
+}
 func (*UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer) MakeDssReport(context.Context, *MakeDssReportRequest) (*ErrorReport, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MakeDssReport not implemented")
 }
@@ -5498,6 +6029,9 @@ func (*UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer) QuerySubscriptions(conte
 func (*UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer) SearchOperationReferences(context.Context, *SearchOperationReferencesRequest) (*SearchOperationReferenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchOperationReferences not implemented")
 }
+func (*UnimplementedUTMAPIUSSDSSAndUSSUSSServiceServer) SetUssAvailability(context.Context, *SetUssAvailabilityRequest) (*UssAvailabilityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUssAvailability not implemented")
+}
 
 func RegisterUTMAPIUSSDSSAndUSSUSSServiceServer(s *grpc.Server, srv UTMAPIUSSDSSAndUSSUSSServiceServer) {
 	s.RegisterService(&_UTMAPIUSSDSSAndUSSUSSService_serviceDesc, srv)
@@ -5611,6 +6145,24 @@ func _UTMAPIUSSDSSAndUSSUSSService_GetSubscription_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUssAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UTMAPIUSSDSSAndUSSUSSServiceServer).GetUssAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetUssAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UTMAPIUSSDSSAndUSSUSSServiceServer).GetUssAvailability(ctx, req.(*GetUssAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UTMAPIUSSDSSAndUSSUSSService_MakeDssReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MakeDssReportRequest)
 	if err := dec(in); err != nil {
@@ -5737,6 +6289,24 @@ func _UTMAPIUSSDSSAndUSSUSSService_SearchOperationReferences_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUssAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UTMAPIUSSDSSAndUSSUSSServiceServer).SetUssAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/scdpb.UTMAPIUSSDSSAndUSSUSSService/SetUssAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UTMAPIUSSDSSAndUSSUSSServiceServer).SetUssAvailability(ctx, req.(*SetUssAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UTMAPIUSSDSSAndUSSUSSService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "scdpb.UTMAPIUSSDSSAndUSSUSSService",
 	HandlerType: (*UTMAPIUSSDSSAndUSSUSSServiceServer)(nil),
@@ -5765,6 +6335,10 @@ var _UTMAPIUSSDSSAndUSSUSSService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubscription",
 			Handler:    _UTMAPIUSSDSSAndUSSUSSService_GetSubscription_Handler,
 		},
+		{
+			MethodName: "GetUssAvailability",
+			Handler:    _UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_Handler,
+		},
 		{
 			MethodName: "MakeDssReport",
 			Handler:    _UTMAPIUSSDSSAndUSSUSSService_MakeDssReport_Handler,
@@ -5793,6 +6367,10 @@ var _UTMAPIUSSDSSAndUSSUSSService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchOperationReferences",
 			Handler:    _UTMAPIUSSDSSAndUSSUSSService_SearchOperationReferences_Handler,
 		},
+		{
+			MethodName: "SetUssAvailability",
+			Handler:    _UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/api/v1/scdpb/scd.proto",
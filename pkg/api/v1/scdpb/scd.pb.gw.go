@@ -355,6 +355,60 @@ func local_request_UTMAPIUSSDSSAndUSSUSSService_GetSubscription_0(ctx context.Co
 
 }
 
+func request_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0(ctx context.Context, marshaler runtime.Marshaler, client UTMAPIUSSDSSAndUSSUSSServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetUssAvailabilityRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["uss_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "uss_id")
+	}
+
+	protoReq.UssId, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "uss_id", err)
+	}
+
+	msg, err := client.GetUssAvailability(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0(ctx context.Context, marshaler runtime.Marshaler, server UTMAPIUSSDSSAndUSSUSSServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GetUssAvailabilityRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["uss_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "uss_id")
+	}
+
+	protoReq.UssId, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "uss_id", err)
+	}
+
+	msg, err := server.GetUssAvailability(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 func request_UTMAPIUSSDSSAndUSSUSSService_MakeDssReport_0(ctx context.Context, marshaler runtime.Marshaler, client UTMAPIUSSDSSAndUSSUSSServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
 	var protoReq MakeDssReportRequest
 	var metadata runtime.ServerMetadata
@@ -701,6 +755,76 @@ func local_request_UTMAPIUSSDSSAndUSSUSSService_SearchOperationReferences_0(ctx
 
 }
 
+func request_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0(ctx context.Context, marshaler runtime.Marshaler, client UTMAPIUSSDSSAndUSSUSSServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SetUssAvailabilityRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq.Params); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["uss_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "uss_id")
+	}
+
+	protoReq.UssId, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "uss_id", err)
+	}
+
+	msg, err := client.SetUssAvailability(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+
+}
+
+func local_request_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0(ctx context.Context, marshaler runtime.Marshaler, server UTMAPIUSSDSSAndUSSUSSServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq SetUssAvailabilityRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq.Params); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["uss_id"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "uss_id")
+	}
+
+	protoReq.UssId, err = runtime.String(val)
+
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "uss_id", err)
+	}
+
+	msg, err := server.SetUssAvailability(ctx, &protoReq)
+	return msg, metadata, err
+
+}
+
 // RegisterUTMAPIUSSDSSAndUSSUSSServiceHandlerServer registers the http handlers for service UTMAPIUSSDSSAndUSSUSSService to "mux".
 // UnaryRPC     :call UTMAPIUSSDSSAndUSSUSSServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -826,6 +950,26 @@ func RegisterUTMAPIUSSDSSAndUSSUSSServiceHandlerServer(ctx context.Context, mux
 
 	})
 
+	mux.Handle("GET", pattern_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0(rctx, inboundMarshaler, server, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_UTMAPIUSSDSSAndUSSUSSService_MakeDssReport_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -966,6 +1110,26 @@ func RegisterUTMAPIUSSDSSAndUSSUSSServiceHandlerServer(ctx context.Context, mux
 
 	})
 
+	mux.Handle("PUT", pattern_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0(rctx, inboundMarshaler, server, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -1127,6 +1291,26 @@ func RegisterUTMAPIUSSDSSAndUSSUSSServiceHandlerClient(ctx context.Context, mux
 
 	})
 
+	mux.Handle("GET", pattern_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	mux.Handle("POST", pattern_UTMAPIUSSDSSAndUSSUSSService_MakeDssReport_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
 		ctx, cancel := context.WithCancel(req.Context())
 		defer cancel()
@@ -1267,6 +1451,26 @@ func RegisterUTMAPIUSSDSSAndUSSUSSServiceHandlerClient(ctx context.Context, mux
 
 	})
 
+	mux.Handle("PUT", pattern_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -1283,6 +1487,8 @@ var (
 
 	pattern_UTMAPIUSSDSSAndUSSUSSService_GetSubscription_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"dss", "v1", "subscriptions", "subscriptionid"}, "", runtime.AssumeColonVerbOpt(true)))
 
+	pattern_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"dss", "v1", "uss_availability", "uss_id"}, "", runtime.AssumeColonVerbOpt(true)))
+
 	pattern_UTMAPIUSSDSSAndUSSUSSService_MakeDssReport_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"dss", "v1", "reports"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_UTMAPIUSSDSSAndUSSUSSService_PutConstraintReference_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"dss", "v1", "constraint_references", "entityuuid"}, "", runtime.AssumeColonVerbOpt(true)))
@@ -1296,6 +1502,8 @@ var (
 	pattern_UTMAPIUSSDSSAndUSSUSSService_QuerySubscriptions_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"dss", "v1", "subscriptions", "query"}, "", runtime.AssumeColonVerbOpt(true)))
 
 	pattern_UTMAPIUSSDSSAndUSSUSSService_SearchOperationReferences_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3}, []string{"dss", "v1", "operation_references", "query"}, "", runtime.AssumeColonVerbOpt(true)))
+
+	pattern_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3}, []string{"dss", "v1", "uss_availability", "uss_id"}, "", runtime.AssumeColonVerbOpt(true)))
 )
 
 var (
@@ -1311,6 +1519,8 @@ var (
 
 	forward_UTMAPIUSSDSSAndUSSUSSService_GetSubscription_0 = runtime.ForwardResponseMessage
 
+	forward_UTMAPIUSSDSSAndUSSUSSService_GetUssAvailability_0 = runtime.ForwardResponseMessage
+
 	forward_UTMAPIUSSDSSAndUSSUSSService_MakeDssReport_0 = runtime.ForwardResponseMessage
 
 	forward_UTMAPIUSSDSSAndUSSUSSService_PutConstraintReference_0 = runtime.ForwardResponseMessage
@@ -1324,4 +1534,6 @@ var (
 	forward_UTMAPIUSSDSSAndUSSUSSService_QuerySubscriptions_0 = runtime.ForwardResponseMessage
 
 	forward_UTMAPIUSSDSSAndUSSUSSService_SearchOperationReferences_0 = runtime.ForwardResponseMessage
+
+	forward_UTMAPIUSSDSSAndUSSUSSService_SetUssAvailability_0 = runtime.ForwardResponseMessage
 )
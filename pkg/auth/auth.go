@@ -2,14 +2,18 @@ package auth
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -19,7 +23,9 @@ import (
 	"github.com/interuss/dss/pkg/models"
 
 	"github.com/dgrijalva/jwt-go"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -29,6 +35,11 @@ import (
 var (
 	// ContextKeyOwner is the key to an owner value.
 	ContextKeyOwner ContextKey = "owner"
+	// ContextKeyScopes is the key to the scopes claimed by an access token.
+	ContextKeyScopes ContextKey = "scopes"
+	// contextKeyRefetch marks resolutions of keys that fetch every JWKS
+	// endpoint regardless of its refresh timer.
+	contextKeyRefetch ContextKey = "refetch"
 )
 
 // ContextKey models auth-specific keys in a context.
@@ -36,9 +47,14 @@ type ContextKey string
 
 type missingScopesError struct {
 	s []string
+	// anyOf is true if claiming any one of s would have sufficed.
+	anyOf bool
 }
 
 func (m *missingScopesError) Error() string {
+	if m.anyOf && len(m.s) > 1 {
+		return "one of " + strings.Join(m.s, ", ")
+	}
 	return strings.Join(m.s, ", ")
 }
 
@@ -54,6 +70,18 @@ func OwnerFromContext(ctx context.Context) (models.Owner, bool) {
 	return owner, ok
 }
 
+// ContextWithScopes adds "scopes" to "ctx".
+func ContextWithScopes(ctx context.Context, scopes ScopeSet) context.Context {
+	return context.WithValue(ctx, ContextKeyScopes, scopes)
+}
+
+// ScopesFromContext returns the scopes claimed by the access token of the
+// request in "ctx" and a boolean indicating whether they were present or not.
+func ScopesFromContext(ctx context.Context) (ScopeSet, bool) {
+	scopes, ok := ctx.Value(ContextKeyScopes).(ScopeSet)
+	return scopes, ok
+}
+
 // KeyResolver abstracts resolving keys.
 type KeyResolver interface {
 	// ResolveKey returns a public or private key, most commonly an rsa.PublicKey.
@@ -69,85 +97,374 @@ func (r *fromMemoryKeyResolver) ResolveKeys(context.Context) ([]interface{}, err
 	return r.Keys, nil
 }
 
-// FromFileKeyResolver resolves keys from 'KeyFile'.
+// FromFileKeyResolver resolves keys from 'KeyFiles'. Each of them is either a
+// PEM file holding one public key, or a directory whose PEM files (other than
+// hidden ones) each hold one. Directories are listed again on every resolution,
+// so keys added to, changed in, or removed from them are picked up the next
+// time the Authorizer refreshes its keys.
 type FromFileKeyResolver struct {
 	KeyFiles []string
-	keys     []interface{}
+
+	// mu guards keys and loaded, as the Authorizer's periodic refreshes and
+	// those for tokens of unknown key ID may resolve keys concurrently.
+	mu   sync.Mutex
+	keys []interface{}
+	// loaded describes the files keys were read from, to skip reading them
+	// again while unchanged.
+	loaded string
 }
 
-// ResolveKeys resolves an RSA public key from file for verifying JWTs.
-func (r *FromFileKeyResolver) ResolveKeys(context.Context) ([]interface{}, error) {
-	if r.keys != nil {
+// ResolveKeys resolves RSA or ECDSA public keys from file for verifying JWTs.
+// All of the keys are read before any of them is returned, so either every key
+// file currently present is in use or, if one of them cannot be read, none of
+// the changes since the last resolution are.
+func (r *FromFileKeyResolver) ResolveKeys(ctx context.Context) ([]interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	files, loaded, err := r.keyFiles()
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	if r.keys != nil && loaded == r.loaded {
 		return r.keys, nil
 	}
 
-	for _, f := range r.KeyFiles {
+	var keys []interface{}
+	for _, f := range files {
 		bytes, err := ioutil.ReadFile(f)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Error reading key file")
 		}
 		pub, _ := pem.Decode(bytes)
 		if pub == nil {
-			return nil, stacktrace.NewError("Failed to decode key file")
+			return nil, stacktrace.NewError("Failed to decode key file %s", f)
 		}
 		parsedKey, err := x509.ParsePKIXPublicKey(pub.Bytes)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Error parsing key as x509 public key")
 		}
-		key, ok := parsedKey.(*rsa.PublicKey)
-		if !ok {
-			return nil, stacktrace.NewError("Could not create RSA public key from %s", f)
+		switch parsedKey.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+			keys = append(keys, parsedKey)
+		default:
+			return nil, stacktrace.NewError("Could not create RSA or ECDSA public key from %s", f)
 		}
-		r.keys = append(r.keys, key)
 	}
+	if r.keys != nil {
+		logging.WithValuesFromContext(ctx, logging.Logger).Info(
+			"reloaded public keys", zap.Int("keys", len(keys)), zap.Strings("files", files))
+	}
+	r.keys, r.loaded = keys, loaded
 	return r.keys, nil
 }
 
-// JWKSResolver resolves the key(s) with ID 'KeyID' from 'Endpoint' serving
-// JWK sets.
+// keyFiles returns the key files r currently resolves keys from, with a
+// description of their sizes and modification times that changes whenever
+// any of them is added, changed, or removed.
+func (r *FromFileKeyResolver) keyFiles() ([]string, string, error) {
+	var (
+		files  []string
+		loaded strings.Builder
+	)
+	add := func(f string, info os.FileInfo) {
+		files = append(files, f)
+		fmt.Fprintf(&loaded, "%s:%d:%d\n", f, info.Size(), info.ModTime().UnixNano())
+	}
+	for _, f := range r.KeyFiles {
+		info, err := os.Stat(f)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "Error reading key file")
+		}
+		if !info.IsDir() {
+			add(f, info)
+			continue
+		}
+		entries, err := ioutil.ReadDir(f)
+		if err != nil {
+			return nil, "", stacktrace.Propagate(err, "Error listing key directory %s", f)
+		}
+		// ReadDir sorts entries by name, keeping keys in a stable order.
+		for _, entry := range entries {
+			if strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			// Stat again to follow symbolic links, as found in mounted Kubernetes
+			// secrets.
+			path := filepath.Join(f, entry.Name())
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, "", stacktrace.Propagate(err, "Error reading key file")
+			}
+			if info.Mode().IsRegular() {
+				add(path, info)
+			}
+		}
+	}
+	return files, loaded.String(), nil
+}
+
+// DefaultMaxJWKSBytes is the largest JWKS response JWKSResolvers accept by
+// default, well above the size of sets of a few dozen keys.
+const DefaultMaxJWKSBytes = 1 << 20
+
+// JWKSResolver resolves the key(s) with ID 'KeyID' from 'Endpoint' and
+// 'Endpoints' serving JWK sets.
 type JWKSResolver struct {
 	Endpoint *url.URL
+	// Endpoints are further endpoints whose JWK sets are merged with that of
+	// Endpoint, for federating several identity providers. Where several
+	// endpoints publish a key with the same ID, the key of the endpoint
+	// configured first is used.
+	Endpoints []*url.URL
 	// If empty, will use all the keys provided by the jwks Endpoint.
 	KeyIDs []string
+	// MaxResponseBytes bounds the size of the JWKS response body; responses
+	// beyond it are rejected. If 0, DefaultMaxJWKSBytes is used.
+	MaxResponseBytes int64
+	// AllowEmpty accepts JWK sets without any key, which then contribute no
+	// keys. Otherwise an empty set fails the fetch of its endpoint, so that
+	// the keys last fetched from it remain in use like those of any endpoint
+	// that cannot be fetched, or, if no endpoint can be, the keys last
+	// resolved remain in use while the Authorizer retries, as long as they
+	// are not older than its MaxKeyStaleness.
+	AllowEmpty bool
+	// RefreshInterval, if positive, is how long the keys fetched from an
+	// endpoint are used before that endpoint is fetched again. Each endpoint
+	// is timed from its own last successful fetch, so that an endpoint being
+	// retried does not have the others fetched again with it. Refreshes of
+	// keys for tokens of unknown key ID fetch every endpoint regardless.
+	RefreshInterval time.Duration
+	// MaxStaleness bounds how long the keys last fetched from an endpoint
+	// stand in for that endpoint while it cannot be fetched; if 0, they do so
+	// indefinitely. They only do so while another endpoint can be fetched,
+	// since the Authorizer keeps the keys last resolved otherwise.
+	MaxStaleness time.Duration
+
+	mu sync.Mutex
+	// validated holds the keys last fetched from each endpoint, by URL, so
+	// that they are only fetched again once modified where the endpoint
+	// serves caching headers, and remain in use while it fails.
+	validated map[string]validatedKeys
+}
+
+// validatedKeys are the keys fetched from an endpoint along with the caching
+// headers it served them with.
+type validatedKeys struct {
+	keys         []jose.JSONWebKey
+	etag         string
+	lastModified string
+	// fetched is when the keys were last fetched or found unmodified.
+	fetched time.Time
 }
 
-// ResolveKeys resolves an RSA public key from file for verifying JWTs.
+// endpointKeys are the keys fetched from one of the endpoints of a
+// JWKSResolver.
+type endpointKeys struct {
+	endpoint *url.URL
+	keys     []jose.JSONWebKey
+	err      error
+}
+
+// ResolveKeys resolves the RSA or ECDSA public keys, according to their kty,
+// served at the endpoints of r for verifying JWTs. Endpoints that cannot be
+// fetched contribute the keys last fetched from them, as long as they are no
+// older than MaxStaleness, unless no endpoint can be fetched.
 func (r *JWKSResolver) ResolveKeys(ctx context.Context) ([]interface{}, error) {
-	req := http.Request{
-		Method: http.MethodGet,
-		URL:    r.Endpoint,
+	logger := logging.WithValuesFromContext(ctx, logging.Logger)
+
+	var endpoints []*url.URL
+	if r.Endpoint != nil {
+		endpoints = append(endpoints, r.Endpoint)
 	}
+	endpoints = append(endpoints, r.Endpoints...)
 
-	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
-	if err != nil {
-		return nil, stacktrace.Propagate(err, fmt.Sprintf("Error retrieving JWKS at %s", req.URL))
+	// Endpoints are fetched concurrently so that a slow one does not hold up
+	// the others.
+	var (
+		fetched = make([]endpointKeys, len(endpoints))
+		wg      sync.WaitGroup
+	)
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *url.URL) {
+			defer wg.Done()
+			keys, err := r.fetch(ctx, endpoint)
+			fetched[i] = endpointKeys{endpoint: endpoint, keys: keys, err: err}
+		}(i, endpoint)
 	}
-	defer resp.Body.Close()
+	wg.Wait()
 
-	jwks := jose.JSONWebKeySet{}
-	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
-		return nil, stacktrace.Propagate(err, "Error decoding JWKS")
+	var (
+		available []endpointKeys
+		succeeded bool
+		// complete is false if an endpoint contributes no keys.
+		complete = true
+		lastErr  error
+	)
+	for _, f := range fetched {
+		if f.err == nil {
+			succeeded = true
+			available = append(available, f)
+			continue
+		}
+		logger.Warn("failed to retrieve JWKS", zap.Stringer("endpoint", f.endpoint), zap.Error(f.err))
+		lastErr = f.err
+		if keys, ok := r.lastKeys(f.endpoint); ok {
+			logger.Warn("using JWKS last retrieved", zap.Stringer("endpoint", f.endpoint))
+			available = append(available, endpointKeys{endpoint: f.endpoint, keys: keys})
+		} else {
+			complete = false
+		}
+	}
+	if !succeeded {
+		if lastErr == nil {
+			return nil, stacktrace.NewError("No JWKS endpoint configured")
+		}
+		return nil, stacktrace.Propagate(lastErr, "Error retrieving JWKS from every endpoint")
 	}
 
-	var keys []interface{}
 	var webKeys []jose.JSONWebKey
 	if len(r.KeyIDs) == 0 {
-		webKeys = jwks.Keys
+		// Keys are identified by kid across endpoints; keys without one are
+		// always used.
+		from := map[string]*url.URL{}
+		for _, a := range available {
+			for _, w := range a.keys {
+				if first, ok := from[w.KeyID]; ok && w.KeyID != "" {
+					logger.Warn("ignoring JWKS key with colliding ID", zap.String("kid", w.KeyID),
+						zap.Stringer("endpoint", a.endpoint), zap.Stringer("preferred_endpoint", first))
+					continue
+				}
+				from[w.KeyID] = a.endpoint
+				webKeys = append(webKeys, w)
+			}
+		}
 	}
 	for _, kid := range r.KeyIDs {
-		// jwks.Key returns a slice of keys.
-		jkeys := jwks.Key(kid)
-		if len(jkeys) == 0 {
-			return nil, stacktrace.NewError("Failed to resolve key(s) for ID: %s", kid)
+		var from *url.URL
+		for _, a := range available {
+			// JSONWebKeySet.Key returns a slice of keys.
+			jkeys := (&jose.JSONWebKeySet{Keys: a.keys}).Key(kid)
+			switch {
+			case len(jkeys) == 0:
+				continue
+			case from != nil:
+				logger.Warn("ignoring JWKS key with colliding ID", zap.String("kid", kid),
+					zap.Stringer("endpoint", a.endpoint), zap.Stringer("preferred_endpoint", from))
+				continue
+			}
+			from = a.endpoint
+			webKeys = append(webKeys, jkeys...)
+		}
+		if from == nil {
+			if complete {
+				return nil, stacktrace.NewError("Failed to resolve key(s) for ID: %s", kid)
+			}
+			// The key may be served by an endpoint that could not be fetched.
+			logger.Warn("failed to resolve JWKS key", zap.String("kid", kid))
 		}
-		webKeys = append(webKeys, jkeys...)
 	}
+
+	var keys []interface{}
 	for _, w := range webKeys {
 		keys = append(keys, w.Key)
 	}
 	return keys, nil
 }
 
+// lastKeys returns the keys last fetched from endpoint, unless they are older
+// than r.MaxStaleness.
+func (r *JWKSResolver) lastKeys(endpoint *url.URL) ([]jose.JSONWebKey, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	previous, ok := r.validated[endpoint.String()]
+	if !ok || (r.MaxStaleness > 0 && Now().Sub(previous.fetched) > r.MaxStaleness) {
+		return nil, false
+	}
+	return previous.keys, true
+}
+
+// fetch returns the JWK set served at endpoint, or the set last fetched from
+// it if fetched less than r.RefreshInterval ago. The set is only downloaded
+// again if modified since the last fetch, where endpoint serves ETag or
+// Last-Modified headers.
+func (r *JWKSResolver) fetch(ctx context.Context, endpoint *url.URL) ([]jose.JSONWebKey, error) {
+	req := http.Request{
+		Method: http.MethodGet,
+		URL:    endpoint,
+		Header: http.Header{},
+	}
+
+	r.mu.Lock()
+	previous, cached := r.validated[endpoint.String()]
+	r.mu.Unlock()
+	if cached && r.RefreshInterval > 0 && ctx.Value(contextKeyRefetch) == nil &&
+		Now().Sub(previous.fetched) < r.RefreshInterval {
+		return previous.keys, nil
+	}
+	if cached {
+		if previous.etag != "" {
+			req.Header.Set("If-None-Match", previous.etag)
+		}
+		if previous.lastModified != "" {
+			req.Header.Set("If-Modified-Since", previous.lastModified)
+		}
+	}
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, fmt.Sprintf("Error retrieving JWKS at %s", req.URL))
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		if !cached || (previous.etag == "" && previous.lastModified == "") {
+			return nil, stacktrace.NewError("JWKS at %s reported unmodified although never fetched", req.URL)
+		}
+		previous.fetched = Now()
+		r.mu.Lock()
+		r.validated[endpoint.String()] = previous
+		r.mu.Unlock()
+		return previous.keys, nil
+	}
+
+	limit := r.MaxResponseBytes
+	if limit <= 0 {
+		limit = DefaultMaxJWKSBytes
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error reading JWKS at %s", req.URL)
+	}
+	if int64(len(body)) > limit {
+		return nil, stacktrace.NewError("JWKS at %s exceeds the limit of %d bytes", req.URL, limit)
+	}
+
+	jwks := jose.JSONWebKeySet{}
+	if err := json.Unmarshal(body, &jwks); err != nil {
+		return nil, stacktrace.Propagate(err, "Error decoding JWKS")
+	}
+	if len(jwks.Keys) == 0 && !r.AllowEmpty {
+		return nil, stacktrace.NewError("JWKS at %s contains no keys", req.URL)
+	}
+
+	// Without caching headers, the set is downloaded again at every fetch.
+	latest := validatedKeys{
+		keys:         jwks.Keys,
+		etag:         resp.Header.Get("ETag"),
+		lastModified: resp.Header.Get("Last-Modified"),
+		fetched:      Now(),
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.validated == nil {
+		r.validated = map[string]validatedKeys{}
+	}
+	r.validated[endpoint.String()] = latest
+	return jwks.Keys, nil
+}
+
 // KeyClaimedScopesValidator validates a set of scopes claimed by an incoming
 // JWT.
 type KeyClaimedScopesValidator interface {
@@ -156,6 +473,18 @@ type KeyClaimedScopesValidator interface {
 	ValidateKeyClaimedScopes(ctx context.Context, scopes ScopeSet) error
 }
 
+// RequiredScopes describes the scopes a KeyClaimedScopesValidator requires.
+type RequiredScopes struct {
+	AllOf []Scope // Every one of AllOf must be claimed.
+	AnyOf []Scope // At least one of AnyOf must be claimed.
+}
+
+// ScopesDescriber is implemented by KeyClaimedScopesValidators that can
+// report the scopes they require.
+type ScopesDescriber interface {
+	RequiredScopes() RequiredScopes
+}
+
 type allScopesRequiredValidator struct {
 	scopes []Scope
 }
@@ -180,6 +509,10 @@ func (v *allScopesRequiredValidator) ValidateKeyClaimedScopes(ctx context.Contex
 	return nil
 }
 
+func (v *allScopesRequiredValidator) RequiredScopes() RequiredScopes {
+	return RequiredScopes{AllOf: v.scopes}
+}
+
 // RequireAllScopes returns a KeyClaimedScopesValidator instance ensuring that
 // every element in scopes is claimed by an incoming set of scopes.
 func RequireAllScopes(scopes ...Scope) KeyClaimedScopesValidator {
@@ -205,10 +538,15 @@ func (v *anyScopesRequiredValidator) ValidateKeyClaimedScopes(ctx context.Contex
 	}
 
 	return &missingScopesError{
-		s: missing,
+		s:     missing,
+		anyOf: true,
 	}
 }
 
+func (v *anyScopesRequiredValidator) RequiredScopes() RequiredScopes {
+	return RequiredScopes{AnyOf: v.scopes}
+}
+
 // RequireAnyScope returns a KeyClaimedScopesValidator instance ensuring that
 // at least one element in scopes is claimed by an incoming set of scopes.
 func RequireAnyScope(scopes ...Scope) KeyClaimedScopesValidator {
@@ -221,24 +559,89 @@ func RequireAnyScope(scopes ...Scope) KeyClaimedScopesValidator {
 type Authorizer struct {
 	logger            *zap.Logger
 	keys              []interface{}
+	keysResolved      time.Time
+	maxKeyStaleness   time.Duration
 	keyGuard          sync.RWMutex
 	scopesValidators  map[Operation]KeyClaimedScopesValidator
 	acceptedAudiences map[string]bool
+	allowedAlgorithms map[string]bool
+	clockSkew         time.Duration
+	// tokens caches the claims of verified access tokens; nil if disabled.
+	tokens *tokenCache
+	// refreshes refreshes keys for tokens signed with keys of unknown ID; nil
+	// if keys are only refreshed periodically.
+	refreshes *keyRefreshes
+	// metrics counts accepted and rejected requests; nil if not exported.
+	metrics *authMetrics
+	// introspector validates tokens in place of keys, if configured as the
+	// KeyResolver.
+	introspector *IntrospectionResolver
+	// scopeSpellings maps the lower case form of each required scope to the
+	// scope itself; nil if scopes are matched case-sensitively.
+	scopeSpellings map[string]Scope
+	exempt         func(fullMethod string) bool
 }
 
 // Configuration bundles up creation-time parameters for an Authorizer instance.
 type Configuration struct {
-	KeyResolver       KeyResolver                             // Used to initialize and periodically refresh keys.
-	KeyRefreshTimeout time.Duration                           // Keys are refreshed on this cadence.
-	ScopesValidators  map[Operation]KeyClaimedScopesValidator // ScopesValidators are used to enforce authorization for operations.
-	AcceptedAudiences []string                                // AcceptedAudiences enforces the aud keyClaim on the jwt. An empty string allows no aud keyClaim.
+	KeyResolver        KeyResolver                             // Used to initialize and periodically refresh keys, or an IntrospectionResolver to introspect tokens instead.
+	KeyRefreshTimeout  time.Duration                           // Each resolution of keys is bounded by this timeout.
+	KeyRefreshInterval time.Duration                           // Keys are refreshed on this cadence; KeyRefreshTimeout is used if zero.
+	KeyRetryInterval   time.Duration                           // Failed refreshes are retried after this interval, doubled after each failure up to the refresh interval; DefaultKeyRetryInterval is used if zero.
+	MaxKeyStaleness    time.Duration                           // Once keys were last resolved this long ago, all tokens are rejected until keys are refreshed; keys never expire if zero.
+	MaxKeyRefreshes    int                                     // Bounds the concurrent refreshes of keys for tokens with a kid that none of the keys verifies, coalesced by kid; keys are only refreshed periodically if zero.
+	MinKeyRefreshGap   time.Duration                           // Refreshes of keys for tokens with a kid that none of the keys verifies start at least this long apart; DefaultMinKeyRefreshGap is used if zero.
+	ScopesValidators   map[Operation]KeyClaimedScopesValidator // ScopesValidators are used to enforce authorization for operations.
+	AcceptedAudiences  []string                                // AcceptedAudiences enforces the aud keyClaim on the jwt. An empty string allows no aud keyClaim.
+	AllowedAlgorithms  []string                                // AllowedAlgorithms restricts the alg of accepted jwts, such as "ES256"; any alg verifiable with the keys is accepted if empty.
+	TokenCacheSize     int                                     // TokenCacheSize bounds the number of verified jwts whose verification is skipped when presented again; disabled if 0.
+	TokenCacheTTL      time.Duration                           // TokenCacheTTL bounds how long a verified jwt is cached, in addition to its exp claim.
+	ClockSkew          time.Duration                           // ClockSkew is how far past its exp, or before its nbf or iat, a jwt is still accepted; jwts are validated strictly if zero.
+	Registerer         prometheus.Registerer                   // Registerer exports the counts of accepted and rejected requests; they are not exported if nil.
+	// ScopesIgnoreCase, if true, treats a claimed scope differing from a
+	// scope required by ScopesValidators only by case as claiming the required
+	// scope, for identity providers inconsistent in their casing of scopes.
+	// This only relaxes how claimed scopes are spelled: each required scope
+	// must still be claimed, and no scope grants more than it would if spelled
+	// as required. Scopes are only matched this way against validators that
+	// are ScopesDescribers.
+	ScopesIgnoreCase bool
+	// Exempt, if set, returns true for the operations served without
+	// requiring an access token, such as health checks.
+	Exempt func(fullMethod string) bool
+}
+
+// DefaultKeyRetryInterval is the interval after which a failed refresh of
+// keys is first retried if Configuration.KeyRetryInterval is not set.
+const DefaultKeyRetryInterval = 5 * time.Second
+
+// DefaultMinKeyRefreshGap is the least time between the starts of refreshes
+// of keys for tokens with unknown kids if Configuration.MinKeyRefreshGap is
+// not set.
+const DefaultMinKeyRefreshGap = 10 * time.Second
+
+// resolveKeys resolves keys with configuration.KeyResolver, giving up after
+// configuration.KeyRefreshTimeout.
+func (configuration Configuration) resolveKeys(ctx context.Context) ([]interface{}, error) {
+	if configuration.KeyRefreshTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, configuration.KeyRefreshTimeout)
+		defer cancel()
+	}
+	return configuration.KeyResolver.ResolveKeys(ctx)
 }
 
 // NewRSAAuthorizer returns an Authorizer instance using values from configuration.
+// Despite its name, the Authorizer verifies tokens signed with either RSA or
+// ECDSA keys, depending on the type of each key resolved.
 func NewRSAAuthorizer(ctx context.Context, configuration Configuration) (*Authorizer, error) {
 	logger := logging.WithValuesFromContext(ctx, logging.Logger)
 
-	keys, err := configuration.KeyResolver.ResolveKeys(ctx)
+	if configuration.ClockSkew < 0 {
+		return nil, stacktrace.NewError("Clock skew %s must not be negative", configuration.ClockSkew)
+	}
+
+	keys, err := configuration.resolveKeys(ctx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Unable to resolve keys")
 	}
@@ -248,26 +651,96 @@ func NewRSAAuthorizer(ctx context.Context, configuration Configuration) (*Author
 		auds[s] = true
 	}
 
+	algs := make(map[string]bool)
+	for _, s := range configuration.AllowedAlgorithms {
+		algs[s] = true
+	}
+
 	authorizer := &Authorizer{
 		scopesValidators:  configuration.ScopesValidators,
 		acceptedAudiences: auds,
+		allowedAlgorithms: algs,
+		clockSkew:         configuration.ClockSkew,
+		exempt:            configuration.Exempt,
 		logger:            logger,
 		keys:              keys,
+		keysResolved:      Now(),
+		maxKeyStaleness:   configuration.MaxKeyStaleness,
+	}
+	if configuration.ScopesIgnoreCase {
+		authorizer.scopeSpellings = scopeSpellings(configuration.ScopesValidators)
+	}
+	if configuration.TokenCacheSize > 0 {
+		authorizer.tokens = newTokenCache(configuration.TokenCacheSize, configuration.TokenCacheTTL)
+	}
+	if configuration.Registerer != nil {
+		authorizer.metrics, err = newAuthMetrics(configuration.Registerer)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Unable to export auth metrics")
+		}
 	}
 
-	go func() {
-		ticker := time.NewTicker(configuration.KeyRefreshTimeout)
-		defer ticker.Stop()
+	if introspector, ok := configuration.KeyResolver.(*IntrospectionResolver); ok {
+		// There are no keys to refresh.
+		authorizer.introspector = introspector
+		return authorizer, nil
+	}
+
+	if configuration.MaxKeyRefreshes > 0 {
+		minGap := configuration.MinKeyRefreshGap
+		if minGap == 0 {
+			minGap = DefaultMinKeyRefreshGap
+		}
+		authorizer.refreshes = newKeyRefreshes(configuration.MaxKeyRefreshes, minGap, func() ([]interface{}, error) {
+			// The refresh is shared by several requests, so it is not bound
+			// to the context of any of them.
+			keys, err := configuration.resolveKeys(context.WithValue(ctx, contextKeyRefetch, true))
+			if err != nil {
+				logger.Warn("failed to refresh keys for unknown key ID", zap.Error(err))
+				return nil, stacktrace.Propagate(err, "Unable to refresh keys")
+			}
+			authorizer.setKeys(keys)
+			return keys, nil
+		})
+	}
+
+	refreshInterval := configuration.KeyRefreshInterval
+	if refreshInterval == 0 {
+		refreshInterval = configuration.KeyRefreshTimeout
+	}
 
+	retryInterval := configuration.KeyRetryInterval
+	if retryInterval == 0 {
+		retryInterval = DefaultKeyRetryInterval
+	}
+
+	go func() {
+		var (
+			wait    = refreshInterval
+			backoff time.Duration
+		)
 		for {
 			select {
-			case <-ticker.C:
-				keys, err := configuration.KeyResolver.ResolveKeys(ctx)
+			case <-time.After(wait):
+				keys, err := configuration.resolveKeys(ctx)
 				if err != nil {
-					logger.Panic("failed to refresh key", zap.Error(err))
+					// The keys last resolved remain in use, until they are
+					// older than MaxKeyStaleness, while refreshes are retried.
+					if backoff == 0 {
+						backoff = retryInterval
+					} else {
+						backoff *= 2
+					}
+					if backoff > refreshInterval {
+						backoff = refreshInterval
+					}
+					logger.Warn("failed to refresh keys", zap.Duration("retry_in", backoff), zap.Error(err))
+					wait = backoff
+					continue
 				}
 
 				authorizer.setKeys(keys)
+				wait, backoff = refreshInterval, 0
 			case <-ctx.Done():
 				logger.Warn("finalizing key refresh worker", zap.Error(ctx.Err()))
 				return
@@ -281,50 +754,230 @@ func NewRSAAuthorizer(ctx context.Context, configuration Configuration) (*Author
 func (a *Authorizer) setKeys(keys []interface{}) {
 	a.keyGuard.Lock()
 	a.keys = keys
+	a.keysResolved = Now()
 	a.keyGuard.Unlock()
+	// Tokens verified with keys that were since rotated out must be verified
+	// again.
+	if a.tokens != nil {
+		a.tokens.purge()
+	}
 }
 
 // AuthInterceptor intercepts incoming gRPC requests and extracts and verifies
 // accompanying bearer tokens.
 func (a *Authorizer) AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authorize(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamAuthInterceptor is the equivalent of AuthInterceptor for streaming
+// gRPC requests.
+func (a *Authorizer) StreamAuthInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authorize(stream.Context(), &grpc.UnaryServerInfo{FullMethod: info.FullMethod})
+	if err != nil {
+		return err
+	}
+	wrapped := grpc_middleware.WrapServerStream(stream)
+	wrapped.WrappedContext = ctx
+	return handler(srv, wrapped)
+}
+
+// authorize verifies the bearer token accompanying the request in ctx, and
+// returns ctx along with the owner identified by the token.
+func (a *Authorizer) authorize(ctx context.Context, info *grpc.UnaryServerInfo) (context.Context, error) {
+	if a.exempt != nil && a.exempt(info.FullMethod) {
+		return ctx, nil
+	}
+	ctx, reason, err := a.authenticate(ctx, info)
+	a.metrics.observe(info.FullMethod, reason, err)
+	return ctx, err
+}
 
+// authenticate implements authorize, additionally returning the reason for
+// which the request is rejected, if it is.
+func (a *Authorizer) authenticate(ctx context.Context, info *grpc.UnaryServerInfo) (context.Context, string, error) {
 	tknStr, ok := getToken(ctx)
 	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.Unauthenticated, "Missing access token")
+		return nil, reasonMissingToken, stacktrace.NewErrorWithCode(dsserr.Unauthenticated, "Missing access token")
+	}
+
+	var (
+		keyClaims claims
+		reason    string
+		err       error
+	)
+	if a.introspector != nil {
+		keyClaims, reason, err = a.introspector.introspectedClaims(ctx, tknStr, a.clockSkew)
+	} else {
+		keyClaims, reason, err = a.verifiedClaims(ctx, tknStr)
+	}
+	if err != nil {
+		return nil, reason, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	if !keyClaims.hasAudienceIn(a.acceptedAudiences) {
+		if keyClaims.audiences != nil {
+			return nil, reasonWrongAudience, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
+				"Invalid access token audiences: %s", strings.Join(keyClaims.audiences, ", "))
+		}
+		return nil, reasonWrongAudience, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
+			"Invalid access token audience: %v", keyClaims.Audience)
 	}
 
+	// A token without a scope claim is treated as claiming no scopes.
+	scopes := a.requiredSpellings(keyClaims.Scopes)
+	if err := a.validateKeyClaimedScopes(ctx, info, scopes); err != nil {
+		if len(scopes) == 0 {
+			return nil, reasonMissingScope, stacktrace.NewErrorWithCode(dsserr.PermissionDenied,
+				"Access token claims no scopes, but %s requires %s", info.FullMethod, err)
+		}
+		return nil, reasonMissingScope, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Access token missing scopes: %s", err)
+	}
+
+	ctx = ContextWithScopes(ctx, scopes)
+	return ContextWithOwner(ctx, models.Owner(keyClaims.Subject)), "", nil
+}
+
+// verifiedClaims returns the claims of tknStr once its signature and claims
+// are verified, or those cached from an earlier verification, unless the keys
+// verifying them are stale. If tknStr is rejected, the reason is returned
+// along with the error.
+func (a *Authorizer) verifiedClaims(ctx context.Context, tknStr string) (claims, string, error) {
 	a.keyGuard.RLock()
-	keys := a.keys
+	keys, resolved := a.keys, a.keysResolved
 	a.keyGuard.RUnlock()
-	validated := false
-	var err error
-	var keyClaims claims
+	if a.maxKeyStaleness > 0 && Now().Sub(resolved) > a.maxKeyStaleness {
+		return claims{}, reasonStaleKeys, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
+			"Keys to verify access tokens are stale, last refreshed at %s", resolved.Format(time.RFC3339))
+	}
+
+	if a.tokens != nil {
+		if keyClaims, ok := a.tokens.get(tknStr); ok {
+			return keyClaims, "", nil
+		}
+	}
+
+	if len(a.allowedAlgorithms) > 0 {
+		token, _, err := new(jwt.Parser).ParseUnverified(tknStr, &claims{})
+		if err != nil {
+			return claims{}, reasonMalformedToken, stacktrace.PropagateWithCode(err, dsserr.Unauthenticated, "Access token validation failed")
+		}
+		if alg := token.Method.Alg(); !a.allowedAlgorithms[alg] {
+			return claims{}, reasonDisallowedAlgorithm, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
+				"Access token signed with disallowed algorithm: %s", alg)
+		}
+	}
+
+	keyClaims, err := a.verify(tknStr, keys)
+	if err != nil && a.refreshes != nil && !signatureVerified(err) {
+		// The token may be signed with a key added since keys were last
+		// resolved, possibly to none.
+		if kid := tokenKeyID(tknStr); kid != "" {
+			if refreshed, refreshErr := a.refreshes.refresh(ctx, kid); refreshErr == nil {
+				keys = refreshed
+				keyClaims, err = a.verify(tknStr, refreshed)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return claims{}, reasonNoKeys, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
+			"No keys to verify access tokens, last resolved at %s", resolved.Format(time.RFC3339))
+	}
+	if err != nil {
+		return claims{}, tokenFailureReason(err), stacktrace.PropagateWithCode(err, dsserr.Unauthenticated, "Access token validation failed")
+	}
+
+	if a.tokens != nil {
+		a.tokens.put(tknStr, keyClaims)
+	}
+	return keyClaims, "", nil
+}
 
+// verify returns the claims of tknStr if one of keys verifies its signature
+// and claims, or the reason it is invalid otherwise.
+func (a *Authorizer) verify(tknStr string, keys []interface{}) (claims, error) {
+	var err error
 	for _, key := range keys {
-		keyClaims = claims{}
+		keyClaims := claims{skew: a.clockSkew}
 		key := key
-		_, err = jwt.ParseWithClaims(tknStr, &keyClaims, func(token *jwt.Token) (interface{}, error) {
+		_, keyErr := jwt.ParseWithClaims(tknStr, &keyClaims, func(token *jwt.Token) (interface{}, error) {
+			if !verifiesMethod(key, token.Method) {
+				return nil, stacktrace.NewError("Key of type %T cannot verify %s access tokens", key, token.Method.Alg())
+			}
 			return key, nil
 		})
-		if err == nil {
-			validated = true
-			break
+		if keyErr == nil {
+			return keyClaims, nil
+		}
+		// Report why a token signed with one of the keys is invalid rather
+		// than the other keys not matching its signature.
+		if err == nil || signatureVerified(keyErr) {
+			err = keyErr
 		}
 	}
-	if !validated {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.Unauthenticated, "Access token validation failed")
+	if err == nil {
+		err = stacktrace.NewError("No keys to verify access tokens")
 	}
+	return claims{}, err
+}
 
-	if !a.acceptedAudiences[keyClaims.Audience] {
-		return nil, stacktrace.NewErrorWithCode(dsserr.Unauthenticated,
-			"Invalid access token audience: %v", keyClaims.Audience)
+// tokenKeyID returns the kid header of tknStr, if it has one.
+func tokenKeyID(tknStr string) string {
+	token, _, err := new(jwt.Parser).ParseUnverified(tknStr, &claims{})
+	if err != nil {
+		return ""
+	}
+	kid, _ := token.Header["kid"].(string)
+	return kid
+}
+
+// scopeSpellings maps the lower case form of each scope required by
+// validators to the scope itself.
+func scopeSpellings(validators map[Operation]KeyClaimedScopesValidator) map[string]Scope {
+	spellings := map[string]Scope{}
+	for _, validator := range validators {
+		describer, ok := validator.(ScopesDescriber)
+		if !ok {
+			continue
+		}
+		required := describer.RequiredScopes()
+		for _, scope := range append(required.AllOf, required.AnyOf...) {
+			spellings[strings.ToLower(scope.String())] = scope
+		}
 	}
+	return spellings
+}
 
-	if err := a.validateKeyClaimedScopes(ctx, info, keyClaims.Scopes); err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Access token missing scopes")
+// requiredSpellings returns claimed, along with the required scopes claimed
+// in a different case if scopes are matched case-insensitively.
+func (a *Authorizer) requiredSpellings(claimed ScopeSet) ScopeSet {
+	if a.scopeSpellings == nil {
+		return claimed
+	}
+	scopes := ScopeSet{}
+	for scope := range claimed {
+		scopes[scope] = struct{}{}
+		if required, ok := a.scopeSpellings[strings.ToLower(scope.String())]; ok {
+			scopes[required] = struct{}{}
+		}
 	}
+	return scopes
+}
 
-	return handler(ContextWithOwner(ctx, models.Owner(keyClaims.Subject)), req)
+// verifiesMethod returns true if key may verify signatures made using method.
+func verifiesMethod(key interface{}, method jwt.SigningMethod) bool {
+	switch method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		_, ok := key.(*rsa.PublicKey)
+		return ok
+	case *jwt.SigningMethodECDSA:
+		_, ok := key.(*ecdsa.PublicKey)
+		return ok
+	}
+	return false
 }
 
 // Matches keyClaimedScopes against the required scopes and returns true if
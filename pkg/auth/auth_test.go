@@ -1,12 +1,24 @@
 package auth
 
 import (
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -19,6 +31,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"gopkg.in/square/go-jose.v2"
 )
 
 func rsaTokenCtx(ctx context.Context, key *rsa.PrivateKey, exp, nbf int64) context.Context {
@@ -125,6 +138,73 @@ func TestRSAAuthInterceptor(t *testing.T) {
 	}
 }
 
+func TestTokenWithoutScopesClaim(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver: &fromMemoryKeyResolver{
+			Keys: []interface{}{&key.PublicKey},
+		},
+		KeyRefreshTimeout: 1 * time.Millisecond,
+		AcceptedAudiences: []string{""},
+		ScopesValidators: map[Operation]KeyClaimedScopesValidator{
+			"/dss.SyncService/PutFoo": RequireAnyScope("required1", "required2"),
+		},
+	})
+	require.NoError(t, err)
+
+	// The token is valid, but has no scope claim at all.
+	tokenCtx := rsaTokenCtx(ctx, key, time.Now().Add(time.Minute).Unix(), 0)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	_, err = a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/PutFoo"}, handler)
+	require.Error(t, err)
+	require.Equal(t, dsserr.PermissionDenied, stacktrace.GetCode(err))
+	require.Contains(t, stacktrace.RootCause(err).Error(), "claims no scopes")
+	require.Contains(t, stacktrace.RootCause(err).Error(), "one of required1, required2")
+
+	// Operations requiring no scopes are still served.
+	resp, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/GetFoo"}, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+}
+
+func TestTokenWithoutSubjectRejected(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 512)
+	require.NoError(t, err)
+
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver: &fromMemoryKeyResolver{
+			Keys: []interface{}{&key.PublicKey},
+		},
+		KeyRefreshTimeout: 1 * time.Millisecond,
+		AcceptedAudiences: []string{""},
+	})
+	require.NoError(t, err)
+
+	tokenString, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"exp": time.Now().Add(time.Minute).Unix(),
+		"iss": "baz",
+	}).SignedString(key)
+	require.NoError(t, err)
+	tokenCtx := metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+		"Authorization": "Bearer " + tokenString,
+	}))
+
+	_, err = a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/GetFoo"},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil })
+	require.Error(t, err)
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+	require.Contains(t, err.Error(), errMissingOrEmptySubject.Error())
+}
+
 func TestMissingScopes(t *testing.T) {
 	ac := &Authorizer{scopesValidators: map[Operation]KeyClaimedScopesValidator{
 		"/dss.SyncService/PutFoo": RequireAnyScope(("required1"), Scope("required2")),
@@ -168,6 +248,58 @@ func TestMissingScopes(t *testing.T) {
 	}
 }
 
+func TestScopesMatchedCaseInsensitivelyIfConfigured(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"sub":   "real_owner",
+		"iss":   "baz",
+		"scope": "DSS.Write.Identification_Service_Areas",
+	}).SignedString(key)
+	require.NoError(t, err)
+	tokenCtx := metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+		"Authorization": "Bearer " + token,
+	}))
+
+	const (
+		write = "dss.write.identification_service_areas"
+		read  = "dss.read.identification_service_areas"
+	)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return ctx, nil }
+	for _, ignoreCase := range []bool{false, true} {
+		a, err := NewRSAAuthorizer(ctx, Configuration{
+			KeyResolver:       &fromMemoryKeyResolver{Keys: []interface{}{&key.PublicKey}},
+			KeyRefreshTimeout: time.Hour,
+			AcceptedAudiences: []string{""},
+			ScopesValidators: map[Operation]KeyClaimedScopesValidator{
+				"/dss.SyncService/PutFoo": RequireAllScopes(write),
+				"/dss.SyncService/GetFoo": RequireAnyScope(read),
+			},
+			ScopesIgnoreCase: ignoreCase,
+		})
+		require.NoError(t, err)
+
+		authorized, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/PutFoo"}, handler)
+		if !ignoreCase {
+			require.Equal(t, dsserr.PermissionDenied, stacktrace.GetCode(err))
+			continue
+		}
+		require.NoError(t, err)
+		// Handlers see the scope as spelled where required.
+		scopes, ok := ScopesFromContext(authorized.(context.Context))
+		require.True(t, ok)
+		require.Contains(t, scopes, Scope(write))
+
+		// Other scopes are still required, whatever their case.
+		_, err = a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/GetFoo"}, handler)
+		require.Equal(t, dsserr.PermissionDenied, stacktrace.GetCode(err))
+	}
+}
+
 func TestClaimsValidation(t *testing.T) {
 	Now = func() time.Time {
 		return time.Unix(42, 0)
@@ -199,6 +331,62 @@ func TestClaimsValidation(t *testing.T) {
 	require.Error(t, claims.Valid())
 }
 
+func TestClockSkewWidensTimeClaimsSymmetrically(t *testing.T) {
+	Now = func() time.Time {
+		return time.Unix(1000, 0)
+	}
+	jwt.TimeFunc = Now
+
+	defer func() {
+		jwt.TimeFunc = time.Now
+		Now = time.Now
+	}()
+
+	newClaims := func(skew time.Duration) *claims {
+		c := &claims{skew: skew}
+		c.Subject = "real_owner"
+		c.Issuer = "real_issuer"
+		c.ExpiresAt = 1100
+		return c
+	}
+
+	// Without skew, tokens just expired or not yet valid are rejected.
+	c := newClaims(0)
+	c.ExpiresAt = 998
+	require.Error(t, c.Valid())
+	c = newClaims(0)
+	c.NotBefore = 1002
+	require.Error(t, c.Valid())
+
+	c = newClaims(5 * time.Second)
+	c.ExpiresAt = 998
+	require.NoError(t, c.Valid())
+	c.ExpiresAt = 995
+	require.NoError(t, c.Valid())
+	c.ExpiresAt = 994
+	err := c.Valid()
+	require.Error(t, err)
+	require.Equal(t, "exp claim is 6s in the past, beyond the clock skew tolerance of 5s", err.Error())
+	require.Equal(t, reasonExpiredToken, tokenFailureReason(err))
+
+	c = newClaims(5 * time.Second)
+	c.NotBefore = 1005
+	c.IssuedAt = 1005
+	require.NoError(t, c.Valid())
+	c.NotBefore = 1006
+	err = c.Valid()
+	require.Error(t, err)
+	require.Equal(t, "nbf claim is 6s in the future, beyond the clock skew tolerance of 5s", err.Error())
+
+	// Tokens issued by a clock ahead of ours for the longest duration accepted
+	// are accepted too.
+	c = newClaims(5 * time.Second)
+	c.ExpiresAt = 1000 + 3600 + 5
+	require.NoError(t, c.Valid())
+	c.ExpiresAt++
+	require.Error(t, c.Valid())
+}
+
 func TestContextWithOwner(t *testing.T) {
 	ctx := context.Background()
 	_, ok := OwnerFromContext(ctx)
@@ -209,3 +397,943 @@ func TestContextWithOwner(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, models.Owner("real_owner"), owner)
 }
+
+// recordingKeyResolver records when it was asked to resolve keys, and the
+// deadline of the context it was asked with.
+type recordingKeyResolver struct {
+	mu        sync.Mutex
+	calls     []time.Time
+	deadlines []time.Time
+}
+
+func (r *recordingKeyResolver) ResolveKeys(ctx context.Context) ([]interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	deadline, _ := ctx.Deadline()
+	r.calls = append(r.calls, time.Now())
+	r.deadlines = append(r.deadlines, deadline)
+	return nil, nil
+}
+
+func (r *recordingKeyResolver) snapshot() ([]time.Time, []time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]time.Time(nil), r.calls...), append([]time.Time(nil), r.deadlines...)
+}
+
+func TestKeysRefreshedAtConfiguredInterval(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const (
+		interval     = 20 * time.Millisecond
+		fetchTimeout = 5 * time.Second
+		refreshes    = 3
+	)
+	resolver := &recordingKeyResolver{}
+	_, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:        resolver,
+		KeyRefreshTimeout:  fetchTimeout,
+		KeyRefreshInterval: interval,
+	})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		calls, _ := resolver.snapshot()
+		return len(calls) > refreshes
+	}, 5*time.Second, interval/4)
+	cancel()
+
+	calls, deadlines := resolver.snapshot()
+	// The initial resolution is followed by one refresh per interval, rather
+	// than one per fetch timeout.
+	require.GreaterOrEqual(t, int64(calls[refreshes].Sub(calls[0])), int64(refreshes*interval))
+	require.Less(t, int64(calls[refreshes].Sub(calls[0])), int64(fetchTimeout))
+	for i, deadline := range deadlines {
+		require.False(t, deadline.IsZero())
+		require.False(t, deadline.After(calls[i].Add(fetchTimeout)))
+	}
+}
+
+func TestJWKSResolverRejectsOversizedResponses(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: "1", Algorithm: "RS256", Use: "sig"},
+	}})
+	require.NoError(t, err)
+	// Padding the set past the default limit keeps it valid JSON.
+	oversized := append(bytes.Repeat([]byte(" "), DefaultMaxJWKSBytes), jwks...)
+
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	body = jwks
+	keys, err := (&JWKSResolver{Endpoint: endpoint}).ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	_, err = (&JWKSResolver{Endpoint: endpoint, MaxResponseBytes: int64(len(jwks) - 1)}).ResolveKeys(ctx)
+	require.Error(t, err)
+	_, err = (&JWKSResolver{Endpoint: endpoint, MaxResponseBytes: int64(len(jwks))}).ResolveKeys(ctx)
+	require.NoError(t, err)
+
+	body = oversized
+	_, err = (&JWKSResolver{Endpoint: endpoint}).ResolveKeys(ctx)
+	require.Error(t, err)
+}
+
+func signedTokenCtx(ctx context.Context, t *testing.T, method jwt.SigningMethod, key interface{}) context.Context {
+	token, err := jwt.NewWithClaims(method, jwt.MapClaims{
+		"exp": time.Now().Add(time.Minute).Unix(),
+		"sub": "real_owner",
+		"iss": "baz",
+	}).SignedString(key)
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+		"Authorization": "Bearer " + token,
+	}))
+}
+
+func TestECDSAKeysVerifyTokens(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	// EC public keys are read from PEM files the same way RSA ones are.
+	der, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+	tmpfile, err := ioutil.TempFile("", "ec.pem")
+	require.NoError(t, err)
+	defer os.Remove(tmpfile.Name())
+	require.NoError(t, pem.Encode(tmpfile, &pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(t, tmpfile.Close())
+	keys, err := (&FromFileKeyResolver{KeyFiles: []string{tmpfile.Name()}}).ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{&ecKey.PublicKey}, keys)
+
+	var (
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+		es256   = signedTokenCtx(ctx, t, jwt.SigningMethodES256, ecKey)
+		rs256   = signedTokenCtx(ctx, t, jwt.SigningMethodRS256, rsaKey)
+	)
+	for _, r := range []struct {
+		name       string
+		algorithms []string
+		accepted   []context.Context
+		rejected   []context.Context
+	}{
+		{name: "any-algorithm", accepted: []context.Context{es256, rs256}},
+		{name: "es256-only", algorithms: []string{"ES256"}, accepted: []context.Context{es256}, rejected: []context.Context{rs256}},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			a, err := NewRSAAuthorizer(ctx, Configuration{
+				KeyResolver: &fromMemoryKeyResolver{
+					Keys: append([]interface{}{&rsaKey.PublicKey}, keys...),
+				},
+				KeyRefreshTimeout: 1 * time.Millisecond,
+				AcceptedAudiences: []string{""},
+				AllowedAlgorithms: r.algorithms,
+			})
+			require.NoError(t, err)
+
+			for _, tokenCtx := range r.accepted {
+				_, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{}, handler)
+				require.NoError(t, err)
+			}
+			for _, tokenCtx := range r.rejected {
+				_, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{}, handler)
+				require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+				require.Contains(t, err.Error(), "RS256")
+			}
+		})
+	}
+}
+
+func TestFromFileKeyResolverReloadsDirectory(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	var (
+		writeKey = func(name string, key interface{}) {
+			der, err := x509.MarshalPKIXPublicKey(key)
+			require.NoError(t, err)
+			require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name),
+				pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644))
+		}
+		resolver = &FromFileKeyResolver{KeyFiles: []string{dir}}
+	)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	writeKey("a.pem", &rsaKey.PublicKey)
+	// Hidden files, such as those of mounted Kubernetes secrets, are skipped.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".hidden"), []byte("not a key"), 0644))
+	keys, err := resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{&rsaKey.PublicKey}, keys)
+
+	writeKey("b.pem", &ecKey.PublicKey)
+	keys, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{&rsaKey.PublicKey, &ecKey.PublicKey}, keys)
+
+	// A key file being written fails the resolution as a whole rather than
+	// dropping that key.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.pem"), []byte("-----BEGIN PUBLIC"), 0644))
+	_, err = resolver.ResolveKeys(ctx)
+	require.Error(t, err)
+
+	writeKey("a.pem", &ecKey.PublicKey)
+	require.NoError(t, os.Remove(filepath.Join(dir, "b.pem")))
+	keys, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{&ecKey.PublicKey}, keys)
+}
+
+func TestFromFileKeyResolverResolvesConcurrently(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "keys")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.pem"),
+		pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644))
+
+	var (
+		resolver = &FromFileKeyResolver{KeyFiles: []string{dir}}
+		wg       sync.WaitGroup
+		errs     = make(chan error, 8)
+	)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := resolver.ResolveKeys(ctx)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+}
+
+func TestJWKSResolverMergesEndpoints(t *testing.T) {
+	var keys []*rsa.PublicKey
+	for i := 0; i < 4; i++ {
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+		keys = append(keys, &key.PublicKey)
+	}
+	serve := func(webKeys ...jose.JSONWebKey) *url.URL {
+		body, err := json.Marshal(jose.JSONWebKeySet{Keys: webKeys})
+		require.NoError(t, err)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write(body)
+		}))
+		t.Cleanup(server.Close)
+		u, err := url.Parse(server.URL)
+		require.NoError(t, err)
+		return u
+	}
+	var (
+		ctx   = context.Background()
+		first = serve(
+			jose.JSONWebKey{Key: keys[0], KeyID: "1", Algorithm: "RS256", Use: "sig"},
+			jose.JSONWebKey{Key: keys[1], KeyID: "2", Algorithm: "RS256", Use: "sig"},
+		)
+		// The second endpoint publishes a key colliding with one of the first.
+		second = serve(
+			jose.JSONWebKey{Key: keys[2], KeyID: "2", Algorithm: "RS256", Use: "sig"},
+			jose.JSONWebKey{Key: keys[3], KeyID: "3", Algorithm: "RS256", Use: "sig"},
+		)
+		unreachable = &url.URL{Scheme: "http", Host: "127.0.0.1:1"}
+		expected    = []interface{}{keys[0], keys[1], keys[3]}
+	)
+
+	resolved, err := (&JWKSResolver{Endpoints: []*url.URL{first, second}}).ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, resolved)
+
+	resolved, err = (&JWKSResolver{Endpoint: first, Endpoints: []*url.URL{second}, KeyIDs: []string{"1", "2", "3"}}).ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, resolved)
+
+	_, err = (&JWKSResolver{Endpoints: []*url.URL{first, second}, KeyIDs: []string{"4"}}).ResolveKeys(ctx)
+	require.Error(t, err)
+
+	// Endpoints that cannot be fetched do not prevent using the others.
+	resolved, err = (&JWKSResolver{Endpoints: []*url.URL{unreachable, second}, KeyIDs: []string{"1", "3"}}).ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{keys[3]}, resolved)
+
+	_, err = (&JWKSResolver{Endpoints: []*url.URL{unreachable}}).ResolveKeys(ctx)
+	require.Error(t, err)
+}
+
+// switchableJWKS serves the JWK set of keys, or fails with a server error
+// while failing is set, counting the requests it receives.
+type switchableJWKS struct {
+	endpoint *url.URL
+	failing  int32
+	fetches  int32
+}
+
+func serveSwitchableJWKS(t *testing.T, keys ...jose.JSONWebKey) *switchableJWKS {
+	body, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+	require.NoError(t, err)
+	s := &switchableJWKS{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.fetches, 1)
+		if atomic.LoadInt32(&s.failing) != 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	s.endpoint, err = url.Parse(server.URL)
+	require.NoError(t, err)
+	return s
+}
+
+func (s *switchableJWKS) setFailing(failing bool) {
+	var v int32
+	if failing {
+		v = 1
+	}
+	atomic.StoreInt32(&s.failing, v)
+}
+
+func TestJWKSResolverKeepsKeysOfFailedEndpoint(t *testing.T) {
+	now := time.Unix(1000, 0)
+	Now = func() time.Time { return now }
+	defer func() { Now = time.Now }()
+
+	var keys []*rsa.PublicKey
+	for i := 0; i < 2; i++ {
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+		keys = append(keys, &key.PublicKey)
+	}
+	var (
+		ctx      = context.Background()
+		first    = serveSwitchableJWKS(t, jose.JSONWebKey{Key: keys[0], KeyID: "1", Algorithm: "RS256", Use: "sig"})
+		second   = serveSwitchableJWKS(t, jose.JSONWebKey{Key: keys[1], KeyID: "2", Algorithm: "RS256", Use: "sig"})
+		resolver = &JWKSResolver{
+			Endpoints:    []*url.URL{first.endpoint, second.endpoint},
+			KeyIDs:       []string{"1", "2"},
+			MaxStaleness: time.Minute,
+		}
+	)
+
+	resolved, err := resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{keys[0], keys[1]}, resolved)
+
+	// The keys last fetched from the failing endpoint remain in use, in the
+	// precedence of their endpoint.
+	first.setFailing(true)
+	now = now.Add(30 * time.Second)
+	resolved, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{keys[0], keys[1]}, resolved)
+
+	// Until they are stale.
+	now = now.Add(time.Minute)
+	resolved, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{keys[1]}, resolved)
+
+	// If no endpoint can be fetched, resolution fails so that the Authorizer
+	// applies its own staleness to the keys it last resolved.
+	first.setFailing(false)
+	_, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	first.setFailing(true)
+	second.setFailing(true)
+	_, err = resolver.ResolveKeys(ctx)
+	require.Error(t, err)
+}
+
+func TestJWKSResolverTimesEndpointsSeparately(t *testing.T) {
+	now := time.Unix(1000, 0)
+	Now = func() time.Time { return now }
+	defer func() { Now = time.Now }()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	var (
+		ctx      = context.Background()
+		first    = serveSwitchableJWKS(t, jose.JSONWebKey{Key: &key.PublicKey, KeyID: "1", Algorithm: "RS256", Use: "sig"})
+		second   = serveSwitchableJWKS(t)
+		resolver = &JWKSResolver{
+			Endpoints:       []*url.URL{first.endpoint, second.endpoint},
+			AllowEmpty:      true,
+			RefreshInterval: time.Minute,
+		}
+		fetches = func() []int32 {
+			return []int32{atomic.LoadInt32(&first.fetches), atomic.LoadInt32(&second.fetches)}
+		}
+	)
+
+	second.setFailing(true)
+	_, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 1}, fetches())
+
+	// The failed endpoint is fetched again at the next resolution, while the
+	// other is not until its own interval elapses.
+	second.setFailing(false)
+	now = now.Add(30 * time.Second)
+	_, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int32{1, 2}, fetches())
+
+	now = now.Add(40 * time.Second)
+	_, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []int32{2, 2}, fetches())
+
+	// Refreshes for unknown key IDs fetch every endpoint.
+	_, err = resolver.ResolveKeys(context.WithValue(ctx, contextKeyRefetch, true))
+	require.NoError(t, err)
+	require.Equal(t, []int32{3, 3}, fetches())
+}
+
+func TestJWKSResolverRefetchesOnlyModifiedSets(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: "1", Algorithm: "RS256", Use: "sig"},
+	}})
+	require.NoError(t, err)
+
+	var (
+		mu         sync.Mutex
+		headers    http.Header
+		conditions []http.Header
+		downloads  int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		conditions = append(conditions, http.Header{
+			"If-None-Match":     r.Header.Values("If-None-Match"),
+			"If-Modified-Since": r.Header.Values("If-Modified-Since"),
+		})
+		if etag := headers.Get("ETag"); etag != "" && r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		if lm := headers.Get("Last-Modified"); lm != "" && r.Header.Get("If-Modified-Since") == lm {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		for name, values := range headers {
+			w.Header()[name] = values
+		}
+		downloads++
+		w.Write(jwks)
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	for _, r := range []struct {
+		name      string
+		headers   http.Header
+		condition string
+		value     string
+	}{
+		{name: "etag", headers: http.Header{"Etag": {`"v1"`}}, condition: "If-None-Match", value: `"v1"`},
+		{name: "last-modified", headers: http.Header{"Last-Modified": {"Wed, 14 Oct 2026 00:00:00 GMT"}}, condition: "If-Modified-Since", value: "Wed, 14 Oct 2026 00:00:00 GMT"},
+		{name: "no-caching-headers"},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			mu.Lock()
+			headers, conditions, downloads = r.headers, nil, 0
+			mu.Unlock()
+
+			resolver := &JWKSResolver{Endpoint: endpoint}
+			for i := 0; i < 3; i++ {
+				keys, err := resolver.ResolveKeys(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, []interface{}{&key.PublicKey}, keys)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Len(t, conditions, 3)
+			require.Empty(t, conditions[0].Get("If-None-Match"))
+			require.Empty(t, conditions[0].Get("If-Modified-Since"))
+			if r.condition == "" {
+				require.Equal(t, 3, downloads)
+				require.Empty(t, conditions[2].Get("If-None-Match"))
+				require.Empty(t, conditions[2].Get("If-Modified-Since"))
+				return
+			}
+			require.Equal(t, 1, downloads)
+			require.Equal(t, r.value, conditions[2].Get(r.condition))
+		})
+	}
+}
+
+// flakyKeyResolver resolves a fixed set of keys, or fails while failing is
+// set, recording the time of every resolution.
+type flakyKeyResolver struct {
+	keys []interface{}
+
+	mu      sync.Mutex
+	failing bool
+	calls   []time.Time
+}
+
+func (r *flakyKeyResolver) ResolveKeys(context.Context) ([]interface{}, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, time.Now())
+	if r.failing {
+		return nil, stacktrace.NewError("JWKS endpoint unavailable")
+	}
+	return r.keys, nil
+}
+
+func (r *flakyKeyResolver) setFailing(failing bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failing = failing
+}
+
+func (r *flakyKeyResolver) snapshot() []time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]time.Time(nil), r.calls...)
+}
+
+func TestKeysKeptWhileRefreshesFailUntilStale(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const interval = 100 * time.Millisecond
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	resolver := &flakyKeyResolver{keys: []interface{}{&key.PublicKey}}
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:        resolver,
+		KeyRefreshTimeout:  time.Second,
+		KeyRefreshInterval: interval,
+		KeyRetryInterval:   time.Millisecond,
+		MaxKeyStaleness:    4 * interval,
+		AcceptedAudiences:  []string{""},
+	})
+	require.NoError(t, err)
+	resolver.setFailing(true)
+
+	var (
+		tokenCtx  = signedTokenCtx(ctx, t, jwt.SigningMethodRS256, key)
+		authorize = func() error {
+			_, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{},
+				func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+			return err
+		}
+	)
+
+	// Failed refreshes are retried sooner than the refresh interval, while the
+	// last keys resolved remain in use.
+	require.Eventually(t, func() bool {
+		return len(resolver.snapshot()) > 5
+	}, 5*time.Second, time.Millisecond)
+	calls := resolver.snapshot()
+	require.Less(t, int64(calls[5].Sub(calls[1])), int64(interval))
+	require.NoError(t, authorize())
+
+	// Requests fail closed once the keys are stale.
+	require.Eventually(t, func() bool {
+		return stacktrace.GetCode(authorize()) == dsserr.Unauthenticated
+	}, 5*time.Second, interval/10)
+
+	resolver.setFailing(false)
+	require.Eventually(t, func() bool {
+		return authorize() == nil
+	}, 5*time.Second, interval/10)
+}
+
+func TestEmptyJWKSKeepsKeysUntilStale(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: "1", Algorithm: "RS256", Use: "sig"},
+	}})
+	require.NoError(t, err)
+
+	var (
+		mu   sync.Mutex
+		body = jwks
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write(body)
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	const interval = 100 * time.Millisecond
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:        &JWKSResolver{Endpoint: endpoint},
+		KeyRefreshTimeout:  time.Second,
+		KeyRefreshInterval: interval,
+		KeyRetryInterval:   time.Millisecond,
+		MaxKeyStaleness:    4 * interval,
+		AcceptedAudiences:  []string{""},
+	})
+	require.NoError(t, err)
+
+	mu.Lock()
+	body = []byte(`{"keys": []}`)
+	mu.Unlock()
+
+	_, err = (&JWKSResolver{Endpoint: endpoint}).ResolveKeys(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "contains no keys")
+
+	var (
+		tokenCtx  = signedTokenCtx(ctx, t, jwt.SigningMethodRS256, key)
+		authorize = func() error {
+			_, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{},
+				func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+			return err
+		}
+	)
+
+	// The last good set remains in use while the refreshes fail, until it is
+	// stale.
+	time.Sleep(2 * interval)
+	require.NoError(t, authorize())
+	require.Eventually(t, func() bool {
+		return stacktrace.GetCode(authorize()) == dsserr.Unauthenticated
+	}, 5*time.Second, interval/10)
+}
+
+func TestEmptyJWKSKeepsKeysOfItsEndpoint(t *testing.T) {
+	now := time.Unix(1000, 0)
+	Now = func() time.Time { return now }
+	defer func() { Now = time.Now }()
+
+	var keys []*rsa.PublicKey
+	for i := 0; i < 2; i++ {
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+		keys = append(keys, &key.PublicKey)
+	}
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: keys[0], KeyID: "1", Algorithm: "RS256", Use: "sig"},
+	}})
+	require.NoError(t, err)
+
+	var (
+		mu   sync.Mutex
+		body = jwks
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write(body)
+	}))
+	defer server.Close()
+	emptied, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	other := serveSwitchableJWKS(t, jose.JSONWebKey{Key: keys[1], KeyID: "2", Algorithm: "RS256", Use: "sig"})
+
+	var (
+		ctx      = context.Background()
+		resolver = &JWKSResolver{
+			Endpoints:    []*url.URL{emptied, other.endpoint},
+			MaxStaleness: time.Minute,
+		}
+	)
+	resolved, err := resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{keys[0], keys[1]}, resolved)
+
+	// The keys last fetched from the endpoint serving an empty set remain in
+	// use along with those of the other endpoint.
+	mu.Lock()
+	body = []byte(`{"keys": []}`)
+	mu.Unlock()
+	now = now.Add(30 * time.Second)
+	resolved, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{keys[0], keys[1]}, resolved)
+
+	// Until they are stale.
+	now = now.Add(time.Minute)
+	resolved, err = resolver.ResolveKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{keys[1]}, resolved)
+}
+
+func TestEmptyJWKSAllowed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"keys": []}`))
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	// Once allowed, an empty set resolves to no keys, with which every token
+	// is rejected.
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:        &JWKSResolver{Endpoint: endpoint, AllowEmpty: true},
+		KeyRefreshInterval: time.Hour,
+		AcceptedAudiences:  []string{""},
+	})
+	require.NoError(t, err)
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = a.AuthInterceptor(signedTokenCtx(ctx, t, jwt.SigningMethodRS256, key), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+}
+
+// kidTokenCtx returns ctx along with a token signed with key, whose header
+// names it kid.
+func kidTokenCtx(ctx context.Context, t *testing.T, key *rsa.PrivateKey, kid string) context.Context {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"exp": time.Now().Add(time.Minute).Unix(),
+		"sub": "real_owner",
+		"iss": "baz",
+	})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+		"Authorization": "Bearer " + signed,
+	}))
+}
+
+func TestEmptyJWKSRecoveredByRefreshForUnknownKeyID(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	var fetches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&fetches, 1) == 1 {
+			w.Write([]byte(`{"keys": []}`))
+			return
+		}
+		jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "1", Algorithm: "RS256", Use: "sig"},
+		}})
+		require.NoError(t, err)
+		w.Write(jwks)
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:        &JWKSResolver{Endpoint: endpoint, AllowEmpty: true},
+		KeyRefreshTimeout:  5 * time.Second,
+		KeyRefreshInterval: time.Hour,
+		MaxKeyRefreshes:    1,
+		AcceptedAudiences:  []string{""},
+	})
+	require.NoError(t, err)
+
+	// The token's kid is unknown while no key is resolved, so keys are
+	// refreshed for it.
+	_, err = a.AuthInterceptor(kidTokenCtx(ctx, t, key, "1"), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+	require.NoError(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
+
+func TestDistinctUnknownKeyIDsRefreshKeysOncePerGap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	known, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	unknown, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &known.PublicKey, KeyID: "1", Algorithm: "RS256", Use: "sig"},
+	}})
+	require.NoError(t, err)
+	var fetches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		w.Write(jwks)
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:        &JWKSResolver{Endpoint: endpoint},
+		KeyRefreshTimeout:  5 * time.Second,
+		KeyRefreshInterval: time.Hour,
+		MaxKeyRefreshes:    4,
+		MinKeyRefreshGap:   time.Hour,
+		AcceptedAudiences:  []string{""},
+	})
+	require.NoError(t, err)
+
+	// One after the other, tokens with distinct unknown kids trigger a single
+	// refresh between them.
+	for i := 0; i < 50; i++ {
+		_, err := a.AuthInterceptor(kidTokenCtx(ctx, t, unknown, strconv.Itoa(100+i)), nil, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+		require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+	}
+	require.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
+
+func TestKeyRefreshWaitersStopWithTheirContext(t *testing.T) {
+	release := make(chan struct{})
+	refreshes := newKeyRefreshes(1, 0, func() ([]interface{}, error) {
+		<-release
+		return []interface{}{"key"}, nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := refreshes.refresh(ctx, "1")
+	require.Error(t, err)
+
+	// The refresh carries on for other requests waiting on it.
+	done := make(chan []interface{})
+	go func() {
+		keys, err := refreshes.refresh(context.Background(), "1")
+		require.NoError(t, err)
+		done <- keys
+	}()
+	close(release)
+	require.Equal(t, []interface{}{"key"}, <-done)
+}
+
+func TestConcurrentUnknownKeyIDsRefreshKeysOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	known, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	added, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	marshal := func(keys ...jose.JSONWebKey) []byte {
+		jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+		require.NoError(t, err)
+		return jwks
+	}
+	knownKey := jose.JSONWebKey{Key: &known.PublicKey, KeyID: "1", Algorithm: "RS256", Use: "sig"}
+	addedKey := jose.JSONWebKey{Key: &added.PublicKey, KeyID: "2", Algorithm: "RS256", Use: "sig"}
+
+	var (
+		mu      sync.Mutex
+		fetches int
+		release = make(chan struct{})
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fetches++
+		initial := fetches == 1
+		mu.Unlock()
+		if initial {
+			w.Write(marshal(knownKey))
+			return
+		}
+		// Hold refreshes until every request is waiting on one.
+		<-release
+		w.Write(marshal(knownKey, addedKey))
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	fetched := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return fetches
+	}
+
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:        &JWKSResolver{Endpoint: endpoint},
+		KeyRefreshTimeout:  5 * time.Second,
+		KeyRefreshInterval: time.Hour,
+		MaxKeyRefreshes:    1,
+		AcceptedAudiences:  []string{""},
+	})
+	require.NoError(t, err)
+
+	authorize := func(key *rsa.PrivateKey, kid string) error {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"exp": time.Now().Add(time.Minute).Unix(),
+			"sub": "real_owner",
+			"iss": "baz",
+		})
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		_, err = a.AuthInterceptor(metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+			"Authorization": "Bearer " + signed,
+		})), nil, &grpc.UnaryServerInfo{},
+			func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil })
+		return err
+	}
+
+	const requests = 20
+	errs := make(chan error, requests)
+	for i := 0; i < requests; i++ {
+		go func() {
+			errs <- authorize(added, "2")
+		}()
+	}
+	require.Eventually(t, func() bool { return fetched() == 2 }, 5*time.Second, time.Millisecond)
+
+	// While the refresh runs, a token with another unknown kid is refused
+	// rather than triggering a further refresh.
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(authorize(added, "3")))
+
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	for i := 0; i < requests; i++ {
+		require.NoError(t, <-errs)
+	}
+	require.Equal(t, 2, fetched())
+
+	// Tokens signed with known keys never trigger a refresh.
+	require.NoError(t, authorize(known, "1"))
+	require.Equal(t, 2, fetched())
+}
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+type tokenCacheEntry struct {
+	hash    [sha256.Size]byte
+	claims  claims
+	expires time.Time
+}
+
+// tokenCache is a size-bounded cache of the claims of verified access tokens,
+// keyed by a hash of each token, evicting the least recently added token once
+// full.
+type tokenCache struct {
+	size int
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[[sha256.Size]byte]*list.Element
+	order   *list.List
+}
+
+func newTokenCache(size int, ttl time.Duration) *tokenCache {
+	return &tokenCache{
+		size:    size,
+		ttl:     ttl,
+		entries: make(map[[sha256.Size]byte]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns the claims cached for token, if it has not expired.
+func (c *tokenCache) get(token string) (claims, bool) {
+	hash := sha256.Sum256([]byte(token))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[hash]
+	if !ok {
+		return claims{}, false
+	}
+	entry := elem.Value.(*tokenCacheEntry)
+	if !Now().Before(entry.expires) {
+		c.remove(elem)
+		return claims{}, false
+	}
+	return entry.claims, true
+}
+
+// put caches the claims of token, which was just verified, until the earlier
+// of the cache TTL and the expiry of token, including its clock skew tolerance.
+func (c *tokenCache) put(token string, keyClaims claims) {
+	expires := Now().Add(c.ttl)
+	if keyClaims.ExpiresAt != 0 {
+		if exp := time.Unix(keyClaims.ExpiresAt, 0).Add(keyClaims.skew); exp.Before(expires) {
+			expires = exp
+		}
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[hash]; ok {
+		c.remove(elem)
+	}
+	if c.order.Len() >= c.size {
+		c.removeExpired()
+	}
+	for c.order.Len() >= c.size {
+		c.remove(c.order.Back())
+	}
+	c.entries[hash] = c.order.PushFront(&tokenCacheEntry{
+		hash:    hash,
+		claims:  keyClaims,
+		expires: expires,
+	})
+}
+
+// purge drops every cached token.
+func (c *tokenCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[[sha256.Size]byte]*list.Element)
+	c.order.Init()
+}
+
+// removeExpired drops every expired token, so that a full cache evicts them
+// before tokens still valid.
+func (c *tokenCache) removeExpired() {
+	now := Now()
+	for elem := c.order.Front(); elem != nil; {
+		next := elem.Next()
+		if !now.Before(elem.Value.(*tokenCacheEntry).expires) {
+			c.remove(elem)
+		}
+		elem = next
+	}
+}
+
+func (c *tokenCache) remove(elem *list.Element) {
+	delete(c.entries, elem.Value.(*tokenCacheEntry).hash)
+	c.order.Remove(elem)
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestVerifiedTokensCachedUntilExpiry(t *testing.T) {
+	now := time.Unix(1000, 0)
+	Now = func() time.Time { return now }
+	jwt.TimeFunc = Now
+	defer func() {
+		Now = time.Now
+		jwt.TimeFunc = time.Now
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:       &fromMemoryKeyResolver{Keys: []interface{}{&key.PublicKey}},
+		KeyRefreshTimeout: time.Hour,
+		AcceptedAudiences: []string{""},
+		TokenCacheSize:    10,
+		TokenCacheTTL:     time.Minute,
+	})
+	require.NoError(t, err)
+
+	var (
+		handler   = func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+		authorize = func(tokenCtx context.Context) error {
+			_, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{}, handler)
+			return err
+		}
+		tokenCtx = func(exp time.Time) context.Context {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+				"exp": exp.Unix(),
+				"sub": "real_owner",
+				"iss": "baz",
+			}).SignedString(key)
+			require.NoError(t, err)
+			return metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+				"Authorization": "Bearer " + token,
+			}))
+		}
+		expiring = tokenCtx(now.Add(30 * time.Second))
+	)
+
+	require.NoError(t, authorize(expiring))
+
+	// Once its key is replaced, a token can only be accepted without
+	// verifying its signature.
+	a.keyGuard.Lock()
+	a.keys = []interface{}{&otherKey.PublicKey}
+	a.keyGuard.Unlock()
+	require.NoError(t, authorize(expiring))
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(authorize(tokenCtx(now.Add(time.Minute)))))
+
+	// Tokens are never served from the cache past their expiry, even within
+	// the cache TTL.
+	now = now.Add(30 * time.Second)
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(authorize(expiring)))
+
+	// Refreshing the keys drops the cached tokens.
+	a.setKeys([]interface{}{&key.PublicKey})
+	refreshed := tokenCtx(now.Add(time.Minute))
+	require.NoError(t, authorize(refreshed))
+	a.setKeys([]interface{}{&otherKey.PublicKey})
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(authorize(refreshed)))
+}
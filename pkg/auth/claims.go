@@ -3,6 +3,7 @@ package auth
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -21,16 +22,25 @@ var (
 // ScopeSet models a set of scopes.
 type ScopeSet map[Scope]struct{}
 
+// UnmarshalJSON parses a scope claim either as a space-delimited string or as
+// an array of such strings, ignoring repeated scopes and surrounding
+// whitespace.
 func (s *ScopeSet) UnmarshalJSON(data []byte) error {
+	var strs []string
 	var str string
-	if err := json.Unmarshal(data, &str); err != nil {
+	if err := json.Unmarshal(data, &str); err == nil {
+		strs = []string{str}
+	} else if err := json.Unmarshal(data, &strs); err != nil {
 		return stacktrace.Propagate(err, "Unable to unmarshal JSON")
 	}
 
 	*s = map[Scope]struct{}{}
 
-	for _, scope := range strings.Split(str, " ") {
-		(*s)[Scope(scope)] = struct{}{}
+	// An empty or null claim yields no scopes rather than an empty scope.
+	for _, str := range strs {
+		for _, scope := range strings.Fields(str) {
+			(*s)[Scope(scope)] = struct{}{}
+		}
 	}
 
 	return nil
@@ -39,9 +49,31 @@ func (s *ScopeSet) UnmarshalJSON(data []byte) error {
 type claims struct {
 	jwt.StandardClaims
 	Scopes ScopeSet `json:"scope"`
+	// skew is how far the exp, nbf and iat claims may be off, in either
+	// direction, to tolerate issuers whose clocks deviate from ours.
+	skew time.Duration
+	// audiences are the audiences of an introspected token claiming more
+	// than one, in which case Audience is empty.
+	audiences []string
+}
+
+// hasAudienceIn returns true if c has any audience in accepted, where the
+// empty audience designates tokens without one.
+func (c *claims) hasAudienceIn(accepted map[string]bool) bool {
+	if c.audiences == nil {
+		return accepted[c.Audience]
+	}
+	for _, aud := range c.audiences {
+		if accepted[aud] {
+			return true
+		}
+	}
+	return false
 }
 
 func (c *claims) Valid() error {
+	// The subject becomes the owner of the entities written with the token,
+	// so tokens without one are never accepted.
 	if c.Subject == "" {
 		return errMissingOrEmptySubject
 	}
@@ -49,7 +81,7 @@ func (c *claims) Valid() error {
 
 	c.VerifyExpiresAt(now.Unix(), true)
 
-	if c.ExpiresAt > now.Add(time.Hour).Unix() {
+	if c.ExpiresAt > now.Add(time.Hour+c.skew).Unix() {
 		return errTokenExpireTooFar
 	}
 
@@ -57,5 +89,35 @@ func (c *claims) Valid() error {
 		return errMissingIssuer
 	}
 
-	return c.StandardClaims.Valid()
+	return c.validTimes(jwt.TimeFunc())
+}
+
+// validTimes verifies the exp, nbf and iat claims, when present, at now,
+// tolerating c.skew as jwt.StandardClaims.Valid tolerates none.
+func (c *claims) validTimes(now time.Time) error {
+	// Claims are in seconds, and compared with now as such.
+	now = time.Unix(now.Unix(), 0)
+
+	if c.ExpiresAt != 0 {
+		if late := now.Sub(time.Unix(c.ExpiresAt, 0)); late > c.skew {
+			return jwt.NewValidationError(fmt.Sprintf(
+				"exp claim is %s in the past, beyond the clock skew tolerance of %s", late, c.skew),
+				jwt.ValidationErrorExpired)
+		}
+	}
+	if c.NotBefore != 0 {
+		if early := time.Unix(c.NotBefore, 0).Sub(now); early > c.skew {
+			return jwt.NewValidationError(fmt.Sprintf(
+				"nbf claim is %s in the future, beyond the clock skew tolerance of %s", early, c.skew),
+				jwt.ValidationErrorNotValidYet)
+		}
+	}
+	if c.IssuedAt != 0 {
+		if early := time.Unix(c.IssuedAt, 0).Sub(now); early > c.skew {
+			return jwt.NewValidationError(fmt.Sprintf(
+				"iat claim is %s in the future, beyond the clock skew tolerance of %s", early, c.skew),
+				jwt.ValidationErrorIssuedAt)
+		}
+	}
+	return nil
 }
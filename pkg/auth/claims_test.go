@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -25,3 +26,38 @@ func TestScopesJSONUnmarshaling(t *testing.T) {
 	require.Error(t, json.Unmarshal([]byte(`{"scope": false}`), claims))
 	require.Error(t, json.Unmarshal([]byte(`{"scope": {}}`), claims))
 }
+
+func TestEmptyScopesJSONUnmarshaling(t *testing.T) {
+	for _, empty := range []string{`{"scope": ""}`, `{"scope": " "}`, `{"scope": null}`} {
+		claims := &claims{}
+		require.NoError(t, json.Unmarshal([]byte(empty), claims))
+		require.Empty(t, claims.Scopes, empty)
+	}
+}
+
+func TestScopeRepresentationsValidate(t *testing.T) {
+	validator := RequireAllScopes("one", "two")
+	for _, representation := range []string{
+		`{"scope": "one two"}`,
+		`{"scope": "  one   two "}`,
+		`{"scope": ["one", "two"]}`,
+		`{"scope": [" one", "two "]}`,
+		`{"scope": ["one two"]}`,
+		`{"scope": "one two one two"}`,
+		`{"scope": ["one", "two", "one", "two"]}`,
+		`{"scope": ["one two", "two"]}`,
+	} {
+		claims := &claims{}
+		require.NoError(t, json.Unmarshal([]byte(representation), claims), representation)
+		require.Equal(t, ScopeSet{"one": {}, "two": {}}, claims.Scopes, representation)
+		require.NoError(t, validator.ValidateKeyClaimedScopes(context.Background(), claims.Scopes), representation)
+	}
+
+	for _, empty := range []string{`{"scope": []}`, `{"scope": ["", " "]}`} {
+		claims := &claims{}
+		require.NoError(t, json.Unmarshal([]byte(empty), claims))
+		require.Empty(t, claims.Scopes, empty)
+	}
+
+	require.Error(t, json.Unmarshal([]byte(`{"scope": [42]}`), &claims{}))
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/interuss/stacktrace"
+)
+
+// maxIntrospectionBytes is the largest introspection response accepted, well
+// above the size of responses describing a token.
+const maxIntrospectionBytes = 1 << 16
+
+// IntrospectionResolver validates opaque access tokens by introspecting them
+// at 'Endpoint', as specified by RFC 7662, rather than verifying them with
+// keys. An Authorizer configured with an IntrospectionResolver as its
+// KeyResolver validates every token this way.
+type IntrospectionResolver struct {
+	Endpoint *url.URL
+	// ClientID and ClientSecret authenticate requests to Endpoint with HTTP
+	// Basic authentication.
+	ClientID     string
+	ClientSecret string
+	// CacheSize bounds the number of active tokens whose introspection is
+	// reused, until their exp, when presented again; disabled if 0. Inactive
+	// tokens are not cached, as responses about them carry no exp.
+	CacheSize int
+
+	cacheOnce sync.Once
+	cache     *tokenCache
+}
+
+// introspectionResponse holds the fields of an introspection response an
+// Authorizer validates.
+type introspectionResponse struct {
+	Active    bool      `json:"active"`
+	Scopes    ScopeSet  `json:"scope"`
+	Audiences audiences `json:"aud"`
+	ExpiresAt int64     `json:"exp"`
+	Subject   string    `json:"sub"`
+}
+
+// audiences is an aud field, which may be a single string or an array.
+type audiences []string
+
+func (a *audiences) UnmarshalJSON(data []byte) error {
+	var aud string
+	if err := json.Unmarshal(data, &aud); err == nil {
+		*a = audiences{aud}
+		return nil
+	}
+	var auds []string
+	if err := json.Unmarshal(data, &auds); err != nil {
+		return stacktrace.Propagate(err, "Unable to unmarshal aud as a string or an array of strings")
+	}
+	*a = auds
+	return nil
+}
+
+// ResolveKeys returns no keys, as tokens introspected by r are not verified
+// with keys.
+func (r *IntrospectionResolver) ResolveKeys(context.Context) ([]interface{}, error) {
+	return nil, nil
+}
+
+// introspectedClaims returns the claims of token as introspected at
+// r.Endpoint, or cached from an earlier introspection, once validated
+// tolerating skew. If token is rejected, the reason is returned along with the
+// error.
+func (r *IntrospectionResolver) introspectedClaims(ctx context.Context, token string, skew time.Duration) (claims, string, error) {
+	r.cacheOnce.Do(func() {
+		if r.CacheSize > 0 {
+			// Entries expire with their token only.
+			r.cache = newTokenCache(r.CacheSize, math.MaxInt64)
+		}
+	})
+	if r.cache != nil {
+		if keyClaims, ok := r.cache.get(token); ok {
+			return keyClaims, "", nil
+		}
+	}
+
+	resp, err := r.introspect(ctx, token)
+	if err != nil {
+		return claims{}, reasonIntrospectionFailed, stacktrace.PropagateWithCode(err, dsserr.Unauthenticated, "Unable to introspect access token")
+	}
+	if !resp.Active {
+		return claims{}, reasonInactiveToken, stacktrace.NewErrorWithCode(dsserr.Unauthenticated, "Access token is not active")
+	}
+
+	keyClaims := claims{
+		StandardClaims: jwt.StandardClaims{
+			Subject:   resp.Subject,
+			ExpiresAt: resp.ExpiresAt,
+		},
+		Scopes: resp.Scopes,
+		skew:   skew,
+	}
+	if len(resp.Audiences) == 1 {
+		keyClaims.Audience = resp.Audiences[0]
+	} else if len(resp.Audiences) > 1 {
+		keyClaims.audiences = resp.Audiences
+	}
+	// The subject becomes the owner of the entities written with the token,
+	// as for JWTs.
+	if keyClaims.Subject == "" {
+		return claims{}, reasonInvalidClaims, stacktrace.PropagateWithCode(errMissingOrEmptySubject, dsserr.Unauthenticated, "Access token validation failed")
+	}
+	if err := keyClaims.validTimes(jwt.TimeFunc()); err != nil {
+		return claims{}, reasonExpiredToken, stacktrace.PropagateWithCode(err, dsserr.Unauthenticated, "Access token validation failed")
+	}
+
+	if r.cache != nil && keyClaims.ExpiresAt != 0 {
+		r.cache.put(token, keyClaims)
+	}
+	return keyClaims, "", nil
+}
+
+// introspect POSTs token to r.Endpoint and returns the response.
+func (r *IntrospectionResolver) introspect(ctx context.Context, token string) (*introspectionResponse, error) {
+	form := url.Values{
+		"token":           {token},
+		"token_type_hint": {"access_token"},
+	}
+	req, err := http.NewRequest(http.MethodPost, r.Endpoint.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error creating introspection request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// Client credentials are form-encoded before being used with Basic
+	// authentication, as required by RFC 6749.
+	req.SetBasicAuth(url.QueryEscape(r.ClientID), url.QueryEscape(r.ClientSecret))
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error introspecting access token at %s", r.Endpoint)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, stacktrace.NewError("Introspection endpoint %s responded with %s", r.Endpoint, resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxIntrospectionBytes+1))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error reading introspection response")
+	}
+	if len(body) > maxIntrospectionBytes {
+		return nil, stacktrace.NewError("Introspection response exceeds the limit of %d bytes", maxIntrospectionBytes)
+	}
+	result := &introspectionResponse{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, stacktrace.Propagate(err, "Error decoding introspection response")
+	}
+	return result, nil
+}
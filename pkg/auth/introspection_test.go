@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/models"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIntrospectedTokensCachedUntilExpiry(t *testing.T) {
+	now := time.Unix(1000, 0)
+	Now = func() time.Time { return now }
+	jwt.TimeFunc = Now
+	defer func() {
+		Now = time.Now
+		jwt.TimeFunc = time.Now
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	responses := map[string]map[string]interface{}{
+		"opaque": {
+			"active": true,
+			"sub":    "real_owner",
+			"scope":  "read write",
+			"aud":    []string{"elsewhere", "dss"},
+			"exp":    now.Add(time.Minute).Unix(),
+		},
+		"elsewhere": {
+			"active": true,
+			"sub":    "real_owner",
+			"aud":    "elsewhere",
+			"exp":    now.Add(time.Minute).Unix(),
+		},
+		"revoked": {"active": false},
+	}
+	introspections := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		if r.Method != http.MethodPost || !ok || id != "dss" || secret != "s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		token := r.PostFormValue("token")
+		introspections[token]++
+		require.NoError(t, json.NewEncoder(w).Encode(responses[token]))
+	}))
+	defer server.Close()
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver: &IntrospectionResolver{
+			Endpoint:     endpoint,
+			ClientID:     "dss",
+			ClientSecret: "s3cr3t",
+			CacheSize:    10,
+		},
+		AcceptedAudiences: []string{"dss"},
+		ScopesValidators: map[Operation]KeyClaimedScopesValidator{
+			"/dss.SyncService/PutFoo": RequireAnyScope("write"),
+		},
+		Registerer: prometheus.NewRegistry(),
+	})
+	require.NoError(t, err)
+
+	var (
+		handler   = func(ctx context.Context, req interface{}) (interface{}, error) { return ctx, nil }
+		authorize = func(token string) (context.Context, error) {
+			tokenCtx := metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+				"Authorization": "Bearer " + token,
+			}))
+			res, err := a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{FullMethod: "/dss.SyncService/PutFoo"}, handler)
+			if err != nil {
+				return nil, err
+			}
+			return res.(context.Context), nil
+		}
+	)
+
+	// Any of the audiences of a token may be accepted.
+	authorized, err := authorize("opaque")
+	require.NoError(t, err)
+	owner, ok := OwnerFromContext(authorized)
+	require.True(t, ok)
+	require.Equal(t, models.Owner("real_owner"), owner)
+	_, err = authorize("opaque")
+	require.NoError(t, err)
+	require.Equal(t, 1, introspections["opaque"])
+
+	_, err = authorize("elsewhere")
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+
+	// Inactive tokens are introspected again every time.
+	for i := 0; i < 2; i++ {
+		_, err = authorize("revoked")
+		require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+	}
+	require.Equal(t, 2, introspections["revoked"])
+	require.Equal(t, float64(2), testutil.ToFloat64(a.metrics.failures.WithLabelValues(reasonInactiveToken, "/dss.SyncService/PutFoo")))
+
+	// Once expired, a token is evicted and introspected again.
+	now = now.Add(time.Minute)
+	responses["opaque"]["active"] = false
+	_, err = authorize("opaque")
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+	require.Equal(t, 2, introspections["opaque"])
+
+	_, err = authorize("unknown")
+	require.Equal(t, dsserr.Unauthenticated, stacktrace.GetCode(err))
+}
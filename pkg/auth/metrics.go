@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"github.com/dgrijalva/jwt-go"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reasons for which an Authorizer rejects a request, used as the reason label
+// of its failure counter. They are meant to be relied on by dashboards and
+// alerts, so existing reasons must not be renamed.
+const (
+	reasonMissingToken        = "missing_token"
+	reasonMalformedToken      = "malformed_token"
+	reasonExpiredToken        = "expired_token"
+	reasonBadSignature        = "bad_signature"
+	reasonDisallowedAlgorithm = "disallowed_algorithm"
+	reasonInvalidClaims       = "invalid_claims"
+	reasonWrongAudience       = "wrong_audience"
+	reasonMissingScope        = "missing_scope"
+	reasonStaleKeys           = "stale_keys"
+	reasonNoKeys              = "no_keys"
+	reasonInactiveToken       = "inactive_token"
+	reasonIntrospectionFailed = "introspection_failed"
+)
+
+// authMetrics counts the requests an Authorizer accepts and rejects, by RPC
+// method. A nil *authMetrics counts nothing.
+type authMetrics struct {
+	successes *prometheus.CounterVec
+	failures  *prometheus.CounterVec
+}
+
+// newAuthMetrics returns authMetrics whose counters are registered with
+// registerer. Authorizers sharing a registerer share their counters.
+func newAuthMetrics(registerer prometheus.Registerer) (*authMetrics, error) {
+	successes, err := registerCounterVec(registerer, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dss",
+		Subsystem: "auth",
+		Name:      "successes_total",
+		Help:      "Number of requests whose access token was accepted.",
+	}, []string{"method"}))
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	failures, err := registerCounterVec(registerer, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dss",
+		Subsystem: "auth",
+		Name:      "failures_total",
+		Help:      "Number of requests rejected for lack of a valid access token, by reason.",
+	}, []string{"reason", "method"}))
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return &authMetrics{successes: successes, failures: failures}, nil
+}
+
+// registerCounterVec registers c with registerer, and returns either c or the
+// identical counter registered before it.
+func registerCounterVec(registerer prometheus.Registerer, c *prometheus.CounterVec) (*prometheus.CounterVec, error) {
+	err := registerer.Register(c)
+	if registered, ok := err.(prometheus.AlreadyRegisteredError); ok {
+		if existing, ok := registered.ExistingCollector.(*prometheus.CounterVec); ok {
+			return existing, nil
+		}
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error registering auth counter")
+	}
+	return c, nil
+}
+
+// observe counts a request to method, rejected for reason unless err is nil.
+func (m *authMetrics) observe(method, reason string, err error) {
+	if m == nil {
+		return
+	}
+	if err == nil {
+		m.successes.WithLabelValues(method).Inc()
+		return
+	}
+	m.failures.WithLabelValues(reason, method).Inc()
+}
+
+// tokenFailureReason returns the reason for which jwt rejected a token with
+// err.
+func tokenFailureReason(err error) string {
+	vErr, ok := err.(*jwt.ValidationError)
+	switch {
+	case !ok || vErr.Errors&jwt.ValidationErrorMalformed != 0:
+		return reasonMalformedToken
+	case vErr.Errors&(jwt.ValidationErrorUnverifiable|jwt.ValidationErrorSignatureInvalid) != 0:
+		return reasonBadSignature
+	case vErr.Errors&jwt.ValidationErrorExpired != 0:
+		return reasonExpiredToken
+	}
+	return reasonInvalidClaims
+}
+
+// signatureVerified returns true if jwt rejected a token with err despite
+// verifying its signature.
+func signatureVerified(err error) bool {
+	vErr, ok := err.(*jwt.ValidationError)
+	return ok && vErr.Errors&(jwt.ValidationErrorMalformed|jwt.ValidationErrorUnverifiable|jwt.ValidationErrorSignatureInvalid) == 0
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthOutcomesCountedByReasonAndMethod(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	unknownKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	const (
+		read  = "/dss.SyncService/GetFoo"
+		write = "/dss.SyncService/PutFoo"
+	)
+	a, err := NewRSAAuthorizer(ctx, Configuration{
+		KeyResolver:       &fromMemoryKeyResolver{Keys: []interface{}{&otherKey.PublicKey, &key.PublicKey}},
+		KeyRefreshTimeout: time.Hour,
+		AcceptedAudiences: []string{""},
+		ScopesValidators: map[Operation]KeyClaimedScopesValidator{
+			write: RequireAnyScope("write"),
+		},
+		Registerer: prometheus.NewRegistry(),
+	})
+	require.NoError(t, err)
+
+	var (
+		handler   = func(ctx context.Context, req interface{}) (interface{}, error) { return nil, nil }
+		authorize = func(tokenCtx context.Context, method string) {
+			a.AuthInterceptor(tokenCtx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		}
+		tokenCtx = func(key *rsa.PrivateKey, claims jwt.MapClaims) context.Context {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+			require.NoError(t, err)
+			return metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+				"Authorization": "Bearer " + token,
+			}))
+		}
+		claims = func() jwt.MapClaims {
+			return jwt.MapClaims{
+				"exp":   time.Now().Add(time.Minute).Unix(),
+				"sub":   "real_owner",
+				"iss":   "baz",
+				"scope": "read",
+			}
+		}
+		failures = func(reason, method string) float64 {
+			return testutil.ToFloat64(a.metrics.failures.WithLabelValues(reason, method))
+		}
+	)
+
+	authorize(tokenCtx(key, claims()), read)
+	authorize(tokenCtx(key, claims()), read)
+	require.Equal(t, float64(2), testutil.ToFloat64(a.metrics.successes.WithLabelValues(read)))
+
+	authorize(metadata.NewIncomingContext(ctx, metadata.MD{}), read)
+	require.Equal(t, float64(1), failures(reasonMissingToken, read))
+
+	authorize(metadata.NewIncomingContext(ctx, metadata.New(map[string]string{"Authorization": "Bearer garbage"})), read)
+	require.Equal(t, float64(1), failures(reasonMalformedToken, read))
+
+	// An expired token signed with one of the keys is reported as expired,
+	// although the other key does not match its signature.
+	expired := claims()
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+	authorize(tokenCtx(key, expired), read)
+	require.Equal(t, float64(1), failures(reasonExpiredToken, read))
+	require.Zero(t, failures(reasonBadSignature, read))
+
+	authorize(tokenCtx(unknownKey, claims()), read)
+	require.Equal(t, float64(1), failures(reasonBadSignature, read))
+
+	withoutIssuer := claims()
+	delete(withoutIssuer, "iss")
+	authorize(tokenCtx(key, withoutIssuer), read)
+	require.Equal(t, float64(1), failures(reasonInvalidClaims, read))
+
+	wrongAudience := claims()
+	wrongAudience["aud"] = "elsewhere"
+	authorize(tokenCtx(key, wrongAudience), read)
+	require.Equal(t, float64(1), failures(reasonWrongAudience, read))
+
+	// Failures are broken down by method as well.
+	authorize(tokenCtx(key, claims()), write)
+	require.Equal(t, float64(1), failures(reasonMissingScope, write))
+	require.Zero(t, failures(reasonMissingScope, read))
+	require.Zero(t, testutil.ToFloat64(a.metrics.successes.WithLabelValues(write)))
+}
@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/interuss/stacktrace"
+)
+
+// keyRefresh is a refresh of keys, shared by the requests waiting on it.
+type keyRefresh struct {
+	done chan struct{}
+	keys []interface{}
+	err  error
+}
+
+// keyRefreshes refreshes keys on demand for access tokens signed with keys of
+// unknown ID. Refreshes for the same ID are coalesced into a single one, at
+// most cap(slots) refreshes run at once, and refreshes start at least minGap
+// apart; further refreshes are refused rather than queued, so that a burst or
+// a steady stream of tokens with distinct unknown IDs does not turn into a
+// stream of fetches.
+type keyRefreshes struct {
+	resolve func() ([]interface{}, error)
+	slots   chan struct{}
+	minGap  time.Duration
+
+	mu      sync.Mutex
+	pending map[string]*keyRefresh
+	// started is when the last refresh started.
+	started time.Time
+}
+
+func newKeyRefreshes(limit int, minGap time.Duration, resolve func() ([]interface{}, error)) *keyRefreshes {
+	return &keyRefreshes{
+		resolve: resolve,
+		slots:   make(chan struct{}, limit),
+		minGap:  minGap,
+		pending: map[string]*keyRefresh{},
+	}
+}
+
+// refresh returns the keys resolved for a token signed with the key with ID
+// kid, waiting for the refresh already running for kid if there is one. It
+// stops waiting once ctx is done, leaving the refresh to complete for other
+// requests.
+func (r *keyRefreshes) refresh(ctx context.Context, kid string) ([]interface{}, error) {
+	r.mu.Lock()
+	p, ok := r.pending[kid]
+	if !ok {
+		now := Now()
+		if since := now.Sub(r.started); since < r.minGap {
+			r.mu.Unlock()
+			return nil, stacktrace.NewError("Keys were refreshed %s ago, too recently to refresh them for key ID %s", since, kid)
+		}
+		select {
+		case r.slots <- struct{}{}:
+		default:
+			r.mu.Unlock()
+			return nil, stacktrace.NewError("Too many concurrent refreshes of keys to refresh them for key ID %s", kid)
+		}
+		p = &keyRefresh{done: make(chan struct{})}
+		r.pending[kid] = p
+		r.started = now
+		go r.run(kid, p)
+	}
+	r.mu.Unlock()
+
+	select {
+	case <-p.done:
+		return p.keys, p.err
+	case <-ctx.Done():
+		return nil, stacktrace.Propagate(ctx.Err(), "Stopped waiting for keys to be refreshed for key ID %s", kid)
+	}
+}
+
+// run resolves the keys of refresh p, for kid.
+func (r *keyRefreshes) run(kid string, p *keyRefresh) {
+	p.keys, p.err = r.resolve()
+	<-r.slots
+
+	r.mu.Lock()
+	delete(r.pending, kid)
+	r.mu.Unlock()
+	close(p.done)
+}
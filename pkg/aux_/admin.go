@@ -0,0 +1,171 @@
+package aux
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+)
+
+// GetSubscriptionWithOwner returns the strategic conflict detection
+// Subscription identified in req, whichever USS owns it, along with its
+// owner. Access is restricted to AdminScope by AuthScopes.
+func (a *Server) GetSubscriptionWithOwner(ctx context.Context, req *auxpb.GetSubscriptionWithOwnerRequest) (*auxpb.GetSubscriptionWithOwnerResponse, error) {
+	if a.SCDStore == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+
+	id, err := dssmodels.IDFromString(req.GetId())
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format: `%s`", req.GetId())
+	}
+
+	repo, err := a.SCDStore.Interact(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to interact with store")
+	}
+	sub, err := repo.GetSubscription(ctx, id)
+	switch {
+	case err != nil:
+		return nil, stacktrace.Propagate(err, "Could not get Subscription from repo")
+	case sub == nil:
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", id.String())
+	}
+
+	return &auxpb.GetSubscriptionWithOwnerResponse{
+		Subscription: subscriptionWithOwnerToProto(sub),
+	}, nil
+}
+
+func subscriptionWithOwnerToProto(sub *scdmodels.Subscription) *auxpb.SubscriptionWithOwner {
+	result := &auxpb.SubscriptionWithOwner{
+		Id:                   sub.ID.String(),
+		Owner:                sub.Owner.String(),
+		Version:              int32(sub.Version),
+		NotificationIndex:    int32(sub.NotificationIndex),
+		UssBaseUrl:           sub.BaseURL,
+		NotifyForOperations:  sub.NotifyForOperations,
+		NotifyForConstraints: sub.NotifyForConstraints,
+		ImplicitSubscription: sub.ImplicitSubscription,
+	}
+	if sub.StartTime != nil {
+		result.TimeStart = sub.StartTime.Format(time.RFC3339Nano)
+	}
+	if sub.EndTime != nil {
+		result.TimeEnd = sub.EndTime.Format(time.RFC3339Nano)
+	}
+	return result
+}
+
+// ListOperationsTouchingSubscription returns the strategic conflict detection
+// Operations of any USS overlapping the Subscription identified in req, in
+// both area and time. Access is restricted to AdminScope by AuthScopes.
+func (a *Server) ListOperationsTouchingSubscription(ctx context.Context, req *auxpb.ListOperationsTouchingSubscriptionRequest) (*auxpb.ListOperationsTouchingSubscriptionResponse, error) {
+	if a.SCDStore == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+
+	id, err := dssmodels.IDFromString(req.GetId())
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format: `%s`", req.GetId())
+	}
+
+	repo, err := a.SCDStore.Interact(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to interact with store")
+	}
+	sub, err := repo.GetSubscription(ctx, id)
+	switch {
+	case err != nil:
+		return nil, stacktrace.Propagate(err, "Could not get Subscription from repo")
+	case sub == nil:
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", id.String())
+	}
+
+	ops, err := repo.SearchOperationsTouchingSubscription(ctx, id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not search Operations touching Subscription")
+	}
+
+	result := &auxpb.ListOperationsTouchingSubscriptionResponse{}
+	for _, op := range ops {
+		result.Operations = append(result.Operations, operationWithOwnerToProto(op))
+	}
+	return result, nil
+}
+
+// operationWithOwnerToProto converts op without its OVN, which is only ever
+// disclosed to its owner.
+func operationWithOwnerToProto(op *scdmodels.Operation) *auxpb.OperationWithOwner {
+	result := &auxpb.OperationWithOwner{
+		Id:             op.ID.String(),
+		Owner:          op.Owner.String(),
+		Version:        int32(op.Version),
+		UssBaseUrl:     op.USSBaseURL,
+		SubscriptionId: op.SubscriptionID.String(),
+	}
+	if op.StartTime != nil {
+		result.TimeStart = op.StartTime.Format(time.RFC3339Nano)
+	}
+	if op.EndTime != nil {
+		result.TimeEnd = op.EndTime.Format(time.RFC3339Nano)
+	}
+	return result
+}
+
+// maxCountBuckets bounds the number of buckets of a single
+// CountIdentificationServiceAreas request.
+const maxCountBuckets = 1000
+
+// CountIdentificationServiceAreas returns the number of remote ID ISAs active
+// during each of the time buckets described by req.
+func (a *Server) CountIdentificationServiceAreas(ctx context.Context, req *auxpb.CountIdentificationServiceAreasRequest) (*auxpb.CountIdentificationServiceAreasResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, req.GetStart())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid start time")
+	}
+	end, err := time.Parse(time.RFC3339Nano, req.GetEnd())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid end time")
+	}
+	interval, err := time.ParseDuration(req.GetInterval())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid interval")
+	}
+	switch {
+	case !start.Before(end):
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Start time must be before end time")
+	case interval <= 0:
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Interval must be positive")
+	case (end.Sub(start)-1)/interval >= maxCountBuckets:
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Time range may span at most %d intervals", maxCountBuckets)
+	}
+
+	counts, err := a.RIDApp.CountISAsByInterval(ctx, start, end, interval)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not count ISAs")
+	}
+
+	result := &auxpb.CountIdentificationServiceAreasResponse{}
+	for i, count := range counts {
+		bucketStart := start.Add(time.Duration(i) * interval)
+		bucketEnd := bucketStart.Add(interval)
+		if bucketEnd.After(end) {
+			bucketEnd = end
+		}
+		result.Buckets = append(result.Buckets, &auxpb.IdentificationServiceAreaCount{
+			Start: bucketStart.Format(time.RFC3339Nano),
+			End:   bucketEnd.Format(time.RFC3339Nano),
+			Count: int32(count),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,206 @@
+package aux
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/rid/application"
+	"github.com/interuss/dss/pkg/scd"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSCDStore struct {
+	scdstore.Store
+	repos.Repository
+	subs map[dssmodels.ID]*scdmodels.Subscription
+	// touching are the Operations touching each Subscription.
+	touching map[dssmodels.ID][]*scdmodels.Operation
+}
+
+func (f *fakeSCDStore) Interact(ctx context.Context) (repos.Repository, error) {
+	return f, nil
+}
+
+func (f *fakeSCDStore) Transact(ctx context.Context, action func(context.Context, repos.Repository) error) error {
+	return action(ctx, f)
+}
+
+func (f *fakeSCDStore) Read(ctx context.Context, action func(context.Context, repos.Repository) error) error {
+	return action(ctx, f)
+}
+
+func (f *fakeSCDStore) GetSubscription(ctx context.Context, id dssmodels.ID) (*scdmodels.Subscription, error) {
+	return f.subs[id], nil
+}
+
+func (f *fakeSCDStore) SearchOperationsTouchingSubscription(ctx context.Context, id dssmodels.ID) ([]*scdmodels.Operation, error) {
+	return f.touching[id], nil
+}
+
+func (f *fakeSCDStore) GetDependentOperations(ctx context.Context, id dssmodels.ID) ([]dssmodels.ID, error) {
+	return nil, nil
+}
+
+func TestGetSubscriptionWithOwnerRequiresAdmin(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		start = time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+		sub   = &scdmodels.Subscription{
+			ID:                  dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765"),
+			Owner:               "owner",
+			Version:             3,
+			BaseURL:             "https://no/place/like/home",
+			NotifyForOperations: true,
+			StartTime:           &start,
+		}
+		store = &fakeSCDStore{subs: map[dssmodels.ID]*scdmodels.Subscription{sub.ID: sub}}
+		s     = &Server{SCDStore: store}
+	)
+
+	// A USS other than the owner can neither retrieve the Subscription nor
+	// the admin view of it.
+	_, err := (&scd.Server{Store: store}).GetSubscription(auth.ContextWithOwner(ctx, "other"),
+		&scdpb.GetSubscriptionRequest{Subscriptionid: sub.ID.String()})
+	require.Equal(t, dsserr.PermissionDenied, stacktrace.GetCode(err))
+
+	validator := s.AuthScopes()["/auxpb.DSSAuxService/GetSubscriptionWithOwner"]
+	require.Error(t, validator.ValidateKeyClaimedScopes(ctx, auth.ScopeSet{
+		scd.Scopes.StrategicCoordination: {},
+	}))
+	require.NoError(t, validator.ValidateKeyClaimedScopes(ctx, auth.ScopeSet{AdminScope: {}}))
+
+	// An admin sees the Subscription along with its owner.
+	resp, err := s.GetSubscriptionWithOwner(ctx, &auxpb.GetSubscriptionWithOwnerRequest{Id: sub.ID.String()})
+	require.NoError(t, err)
+	require.Equal(t, &auxpb.SubscriptionWithOwner{
+		Id:                  sub.ID.String(),
+		Owner:               "owner",
+		Version:             3,
+		UssBaseUrl:          sub.BaseURL,
+		NotifyForOperations: true,
+		TimeStart:           start.Format(time.RFC3339Nano),
+	}, resp.Subscription)
+
+	_, err = s.GetSubscriptionWithOwner(ctx, &auxpb.GetSubscriptionWithOwnerRequest{Id: "9999c8e5-0b1c-43cf-9114-2e67a4532765"})
+	require.Equal(t, dsserr.NotFound, stacktrace.GetCode(err))
+	_, err = s.GetSubscriptionWithOwner(ctx, &auxpb.GetSubscriptionWithOwnerRequest{Id: "nope"})
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	_, err = (&Server{}).GetSubscriptionWithOwner(ctx, &auxpb.GetSubscriptionWithOwnerRequest{Id: sub.ID.String()})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
+
+func TestListOperationsTouchingSubscriptionWithholdsOVNs(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		start = time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+		sub   = &scdmodels.Subscription{
+			ID:    dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765"),
+			Owner: "owner",
+		}
+		op = &scdmodels.Operation{
+			ID:             dssmodels.ID("1348c8e5-0b1c-43cf-9114-2e67a4532765"),
+			Owner:          "other",
+			Version:        2,
+			OVN:            scdmodels.NewOVNFromTime(start, "1348c8e5-0b1c-43cf-9114-2e67a4532765"),
+			USSBaseURL:     "https://no/place/like/home",
+			StartTime:      &start,
+			SubscriptionID: dssmodels.ID("2348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		}
+		store = &fakeSCDStore{
+			subs:     map[dssmodels.ID]*scdmodels.Subscription{sub.ID: sub},
+			touching: map[dssmodels.ID][]*scdmodels.Operation{sub.ID: {op}},
+		}
+		s = &Server{SCDStore: store}
+	)
+
+	validator := s.AuthScopes()["/auxpb.DSSAuxService/ListOperationsTouchingSubscription"]
+	require.Error(t, validator.ValidateKeyClaimedScopes(ctx, auth.ScopeSet{
+		scd.Scopes.StrategicCoordination: {},
+	}))
+	require.NoError(t, validator.ValidateKeyClaimedScopes(ctx, auth.ScopeSet{AdminScope: {}}))
+
+	resp, err := s.ListOperationsTouchingSubscription(ctx, &auxpb.ListOperationsTouchingSubscriptionRequest{Id: sub.ID.String()})
+	require.NoError(t, err)
+	require.Equal(t, []*auxpb.OperationWithOwner{{
+		Id:             op.ID.String(),
+		Owner:          "other",
+		Version:        2,
+		UssBaseUrl:     op.USSBaseURL,
+		SubscriptionId: op.SubscriptionID.String(),
+		TimeStart:      start.Format(time.RFC3339Nano),
+	}}, resp.Operations)
+
+	_, err = s.ListOperationsTouchingSubscription(ctx, &auxpb.ListOperationsTouchingSubscriptionRequest{Id: "9999c8e5-0b1c-43cf-9114-2e67a4532765"})
+	require.Equal(t, dsserr.NotFound, stacktrace.GetCode(err))
+	_, err = (&Server{}).ListOperationsTouchingSubscription(ctx, &auxpb.ListOperationsTouchingSubscriptionRequest{Id: sub.ID.String()})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
+
+type fakeCountApp struct {
+	application.App
+	isas [][2]time.Time
+}
+
+func (f *fakeCountApp) CountISAsByInterval(ctx context.Context, start, end time.Time, interval time.Duration) ([]int, error) {
+	var counts []int
+	for bucket := start; bucket.Before(end); bucket = bucket.Add(interval) {
+		count := 0
+		for _, isa := range f.isas {
+			if isa[0].Before(bucket.Add(interval)) && isa[0].Before(end) && isa[1].After(bucket) {
+				count++
+			}
+		}
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
+
+func TestCountIdentificationServiceAreas(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		base = time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+		s    = &Server{RIDApp: &fakeCountApp{isas: [][2]time.Time{
+			{base, base.Add(90 * time.Minute)},
+			{base.Add(30 * time.Minute), base.Add(45 * time.Minute)},
+			{base.Add(130 * time.Minute), base.Add(3 * time.Hour)},
+		}}}
+		at = func(d time.Duration) string { return base.Add(d).Format(time.RFC3339Nano) }
+	)
+
+	resp, err := s.CountIdentificationServiceAreas(ctx, &auxpb.CountIdentificationServiceAreasRequest{
+		Start:    at(0),
+		End:      at(150 * time.Minute),
+		Interval: "1h",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*auxpb.IdentificationServiceAreaCount{
+		{Start: at(0), End: at(time.Hour), Count: 2},
+		{Start: at(time.Hour), End: at(2 * time.Hour), Count: 1},
+		{Start: at(2 * time.Hour), End: at(150 * time.Minute), Count: 1},
+	}, resp.Buckets)
+
+	for _, req := range []*auxpb.CountIdentificationServiceAreasRequest{
+		{Start: "today", End: at(time.Hour), Interval: "1h"},
+		{Start: at(0), End: at(time.Hour), Interval: "hourly"},
+		{Start: at(time.Hour), End: at(0), Interval: "1h"},
+		{Start: at(0), End: at(time.Hour), Interval: "-1m"},
+		{Start: at(0), End: at(1001 * time.Minute), Interval: "1m"},
+	} {
+		_, err := s.CountIdentificationServiceAreas(ctx, req)
+		require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	}
+	_, err = s.CountIdentificationServiceAreas(ctx, &auxpb.CountIdentificationServiceAreasRequest{
+		Start: at(0), End: at(1000 * time.Minute), Interval: "1m",
+	})
+	require.NoError(t, err)
+}
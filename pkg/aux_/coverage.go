@@ -0,0 +1,47 @@
+package aux
+
+import (
+	"context"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/interuss/stacktrace"
+)
+
+// GetIdentificationServiceAreaCoverage reports whether any remote ID ISA
+// covers part of the area or cells in req, without reading the ISAs
+// themselves.
+func (a *Server) GetIdentificationServiceAreaCoverage(ctx context.Context, req *auxpb.GetIdentificationServiceAreaCoverageRequest) (*auxpb.GetIdentificationServiceAreaCoverageResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+
+	var (
+		cells s2.CellUnion
+		err   error
+	)
+	switch {
+	case req.GetArea() != "" && len(req.GetCells()) > 0:
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Only one of area and cells may be set")
+	case req.GetArea() != "":
+		cells, err = geo.AreaToCellIDs(req.GetArea())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid area")
+		}
+	case len(req.GetCells()) > 0:
+		cells, err = geo.CellsFromTokens(req.GetCells())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid cells")
+		}
+	default:
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing area")
+	}
+
+	covered, err := a.RIDApp.AnyISAInCells(ctx, cells)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not check ISA coverage")
+	}
+	return &auxpb.GetIdentificationServiceAreaCoverageResponse{Covered: covered}, nil
+}
@@ -0,0 +1,91 @@
+package aux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
+	"github.com/interuss/dss/pkg/geo/testdata"
+	"github.com/interuss/dss/pkg/rid/application"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCoverageApp struct {
+	application.App
+	isaCells []s2.CellUnion
+}
+
+func (f *fakeCoverageApp) AnyISAInCells(ctx context.Context, cells s2.CellUnion) (bool, error) {
+	for _, isaCells := range f.isaCells {
+		if isaCells.Intersects(cells) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestGetIdentificationServiceAreaCoverageOfCells(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		app   = &fakeCoverageApp{}
+		s     = &Server{RIDApp: app}
+		cells = s2.CellUnion{s2.CellIDFromLatLng(s2.LatLngFromDegrees(37.4047, -122.1474)).Parent(geo.DefaultMinimumCellLevel)}
+	)
+	app.isaCells = append(app.isaCells, cells)
+
+	resp, err := s.GetIdentificationServiceAreaCoverage(ctx, &auxpb.GetIdentificationServiceAreaCoverageRequest{
+		Cells: []string{cells[0].ToToken()},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Covered)
+
+	// Malformed tokens are reported, each with the offending token.
+	_, err = s.GetIdentificationServiceAreaCoverage(ctx, &auxpb.GetIdentificationServiceAreaCoverageRequest{
+		Cells: []string{"not-a-cell", cells[0].ToToken()},
+	})
+	require.Error(t, err)
+	multi, ok := stacktrace.RootCause(err).(*dsserr.MultiError)
+	require.True(t, ok)
+	require.Equal(t, dsserr.BadRequest, multi.Code())
+	require.Len(t, multi.Items, 1)
+	require.Equal(t, "not-a-cell", multi.Items[0].Item)
+}
+
+func TestGetIdentificationServiceAreaCoverage(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		app       = &fakeCoverageApp{}
+		s         = &Server{RIDApp: app}
+		elsewhere = "-33.8,151.2,-33.9,151.3,-33.7,151.3"
+		covered   = func(area string) bool {
+			resp, err := s.GetIdentificationServiceAreaCoverage(ctx, &auxpb.GetIdentificationServiceAreaCoverageRequest{Area: area})
+			require.NoError(t, err)
+			return resp.Covered
+		}
+	)
+
+	require.False(t, covered(testdata.Loop))
+
+	cells, err := geo.AreaToCellIDs(testdata.Loop)
+	require.NoError(t, err)
+	app.isaCells = append(app.isaCells, cells)
+	require.True(t, covered(testdata.Loop))
+	require.False(t, covered(elsewhere))
+
+	_, err = s.GetIdentificationServiceAreaCoverage(ctx, &auxpb.GetIdentificationServiceAreaCoverageRequest{})
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+	_, err = s.GetIdentificationServiceAreaCoverage(ctx, &auxpb.GetIdentificationServiceAreaCoverageRequest{
+		Area:  testdata.Loop,
+		Cells: []string{cells[0].ToToken()},
+	})
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+	_, err = (&Server{}).GetIdentificationServiceAreaCoverage(ctx, &auxpb.GetIdentificationServiceAreaCoverageRequest{Area: testdata.Loop})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
@@ -0,0 +1,119 @@
+package aux
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/build"
+	"github.com/interuss/dss/pkg/version"
+)
+
+// redacted replaces the secrets in the configuration reported by
+// GetDiagnostics.
+const redacted = "REDACTED"
+
+// secretFlagWords mark the names of the flags whose values are secrets.
+var secretFlagWords = []string{"secret", "password", "passwd", "credential", "private_key"}
+
+// PoolStatser is implemented by the stores whose database connection pools
+// are reported by GetDiagnostics.
+type PoolStatser interface {
+	Stats() sql.DBStats
+}
+
+// GetDiagnostics returns the version, build, configuration, schema versions
+// and database pool statistics of this DSS instance. Flags whose name marks
+// them as secrets, and passwords in URLs, are redacted. Access is restricted
+// to AdminScope by AuthScopes.
+func (a *Server) GetDiagnostics(ctx context.Context, req *auxpb.GetDiagnosticsRequest) (*auxpb.GetDiagnosticsResponse, error) {
+	description := build.Describe()
+	result := &auxpb.GetDiagnosticsResponse{
+		Version: version.Current().String(),
+		Build: &auxpb.BuildDescription{
+			Time:   description.Time,
+			Commit: description.Commit,
+			Host:   description.Host,
+		},
+	}
+
+	if a.Flags != nil {
+		// VisitAll visits flags in lexicographical order.
+		a.Flags.VisitAll(func(f *flag.Flag) {
+			value, wasRedacted := redactFlag(f)
+			result.Configuration = append(result.Configuration, &auxpb.ConfigurationFlag{
+				Name:     f.Name,
+				Value:    value,
+				Redacted: wasRedacted,
+			})
+		})
+	}
+
+	for api, v := range a.SchemaVersions {
+		result.SchemaVersions = append(result.SchemaVersions, &auxpb.SchemaVersion{
+			Api:     api,
+			Version: v.String(),
+		})
+	}
+	sort.Slice(result.SchemaVersions, func(i, j int) bool {
+		return result.SchemaVersions[i].Api < result.SchemaVersions[j].Api
+	})
+
+	for api, pool := range a.Pools {
+		stats := pool.Stats()
+		result.Pools = append(result.Pools, &auxpb.DatabasePoolStats{
+			Api:                api,
+			MaxOpenConnections: int32(stats.MaxOpenConnections),
+			OpenConnections:    int32(stats.OpenConnections),
+			InUse:              int32(stats.InUse),
+			Idle:               int32(stats.Idle),
+			WaitCount:          stats.WaitCount,
+			WaitDurationMs:     stats.WaitDuration.Milliseconds(),
+			MaxIdleClosed:      stats.MaxIdleClosed,
+			MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+		})
+	}
+	sort.Slice(result.Pools, func(i, j int) bool {
+		return result.Pools[i].Api < result.Pools[j].Api
+	})
+
+	return result, nil
+}
+
+// redactFlag returns the value of f with any secret in it redacted, and
+// whether there was one.
+func redactFlag(f *flag.Flag) (string, bool) {
+	value := f.Value.String()
+	name := strings.ToLower(f.Name)
+	for _, word := range secretFlagWords {
+		if strings.Contains(name, word) {
+			if value == "" {
+				return "", false
+			}
+			return redacted, true
+		}
+	}
+
+	// URLs, possibly separated by commas, may embed credentials.
+	wasRedacted := false
+	values := strings.Split(value, ",")
+	for i, v := range values {
+		u, err := url.Parse(v)
+		if err != nil || u.User == nil {
+			continue
+		}
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), redacted)
+			values[i] = u.String()
+			wasRedacted = true
+		}
+	}
+	if !wasRedacted {
+		return value, false
+	}
+	return strings.Join(values, ","), true
+}
@@ -0,0 +1,77 @@
+package aux
+
+import (
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/logging"
+	"github.com/interuss/stacktrace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamLogEvents streams the recent events of a.LogEvents at or above the
+// level in req, then those logged until the client disconnects. Events beyond
+// a.MaxStreamMessageSize are skipped or streamed without their content, so
+// that they do not break the stream.
+func (a *Server) StreamLogEvents(req *auxpb.StreamLogEventsRequest, stream auxpb.DSSAuxService_StreamLogEventsServer) error {
+	if a.LogEvents == nil || !a.LogEvents.Enabled() {
+		return stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Log event streaming is not enabled on this DSS instance")
+	}
+
+	level := zapcore.InfoLevel
+	if req.GetLevel() != "" {
+		if err := level.UnmarshalText([]byte(req.GetLevel())); err != nil {
+			return stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid log level")
+		}
+	}
+
+	recent, subscription := a.LogEvents.Subscribe(level)
+	defer subscription.Close()
+
+	skipped := 0
+	send := func(event logging.Event) error {
+		event.Dropped += skipped
+		p := logEventToProto(event)
+		if size := proto.Size(p); a.MaxStreamMessageSize > 0 && size > a.MaxStreamMessageSize {
+			logging.WithValuesFromContext(stream.Context(), logging.Logger).Warn("log event exceeds maximum message size",
+				zap.Int("size", size), zap.Int("max_size", a.MaxStreamMessageSize), zap.Bool("skipped", a.SkipOversized))
+			if a.SkipOversized {
+				skipped = event.Dropped + 1
+				return nil
+			}
+			p.Message, p.Entry, p.Oversized = "", "", true
+		}
+		skipped = 0
+		return stacktrace.Propagate(stream.Send(p), "Error sending log event")
+	}
+
+	for _, event := range recent {
+		if err := send(event); err != nil {
+			return err // No need to Propagate this error as this stack layer does not add useful information
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-subscription.C:
+			if err := send(event); err != nil {
+				return err // No need to Propagate this error as this stack layer does not add useful information
+			}
+		}
+	}
+}
+
+func logEventToProto(event logging.Event) *auxpb.LogEvent {
+	return &auxpb.LogEvent{
+		Time:    event.Time.Format(time.RFC3339Nano),
+		Level:   event.Level.String(),
+		Logger:  event.Logger,
+		Message: event.Message,
+		Entry:   event.Entry,
+		Dropped: int32(event.Dropped),
+	}
+}
@@ -0,0 +1,177 @@
+package aux
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/logging"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type staticKeyResolver struct {
+	keys []interface{}
+}
+
+func (r *staticKeyResolver) ResolveKeys(context.Context) ([]interface{}, error) {
+	return r.keys, nil
+}
+
+func tokenContext(ctx context.Context, t *testing.T, key *rsa.PrivateKey, scope string) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub":   "operator",
+		"iss":   "baz",
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"scope": scope,
+	}).SignedString(key)
+	require.NoError(t, err)
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+func TestStreamLogEventsToAdministrators(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logging.Events.SetCapacity(64)
+	defer logging.Events.SetCapacity(0)
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	server := &Server{LogEvents: logging.Events}
+	authorizer, err := auth.NewRSAAuthorizer(ctx, auth.Configuration{
+		KeyResolver:       &staticKeyResolver{keys: []interface{}{&key.PublicKey}},
+		KeyRefreshTimeout: time.Second,
+		ScopesValidators:  server.AuthScopes(),
+		AcceptedAudiences: []string{""},
+	})
+	require.NoError(t, err)
+
+	var (
+		listener = bufconn.Listen(1 << 20)
+		s        = grpc.NewServer(grpc.ChainStreamInterceptor(
+			dsserr.StreamInterceptor(zap.NewNop(), 0, 0),
+			authorizer.StreamAuthInterceptor,
+		))
+	)
+	auxpb.RegisterDSSAuxServiceServer(s, server)
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := auxpb.NewDSSAuxServiceClient(conn)
+
+	// Streaming log events requires the admin scope.
+	stream, err := client.StreamLogEvents(tokenContext(ctx, t, key, "dss.read.identification_service_areas"), &auxpb.StreamLogEventsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	logging.Logger.Warn("logged before streaming", zap.String("isa", "recent"))
+	logging.Logger.Debug("logged below the requested level")
+
+	stream, err = client.StreamLogEvents(tokenContext(ctx, t, key, "dss.admin"), &auxpb.StreamLogEventsRequest{Level: "warn"})
+	require.NoError(t, err)
+
+	// Recent events are streamed first, then those logged while connected.
+	next := func(message string) *auxpb.LogEvent {
+		for {
+			event, err := stream.Recv()
+			require.NoError(t, err)
+			require.NotEqual(t, "logged below the requested level", event.Message)
+			if event.Message == message {
+				return event
+			}
+		}
+	}
+	event := next("logged before streaming")
+	require.Equal(t, "warn", event.Level)
+	require.Contains(t, event.Entry, `"isa":"recent"`)
+
+	logging.Logger.Info("logged below the requested level")
+	logging.Logger.Error("logged while streaming")
+	event = next("logged while streaming")
+	require.Equal(t, "error", event.Level)
+	require.Zero(t, event.Dropped)
+}
+
+func TestStreamLogEventsRequiresCapturedEvents(t *testing.T) {
+	err := (&Server{}).StreamLogEvents(&auxpb.StreamLogEventsRequest{}, nil)
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
+
+// eventStream collects the log events streamed to it until it receives one
+// with the last message.
+type eventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	last   string
+	events []*auxpb.LogEvent
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventStream) Send(event *auxpb.LogEvent) error {
+	s.events = append(s.events, event)
+	if event.Message == s.last {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestStreamLogEventsContinuesPastOversizedEvents(t *testing.T) {
+	logging.Events.SetCapacity(64)
+	defer logging.Events.SetCapacity(0)
+
+	logging.Logger.Error("before oversized event")
+	logging.Logger.Error("oversized event", zap.String("payload", strings.Repeat("x", 1024)))
+	logging.Logger.Error("after oversized event")
+
+	for _, skip := range []bool{false, true} {
+		ctx, cancel := context.WithCancel(context.Background())
+		stream := &eventStream{ctx: ctx, cancel: cancel, last: "after oversized event"}
+		server := &Server{LogEvents: logging.Events, MaxStreamMessageSize: 512, SkipOversized: skip}
+		require.NoError(t, server.StreamLogEvents(&auxpb.StreamLogEventsRequest{Level: "error"}, stream))
+
+		var messages []string
+		for _, event := range stream.events {
+			messages = append(messages, event.Message)
+		}
+		require.Contains(t, messages, "before oversized event")
+		require.Contains(t, messages, "after oversized event")
+		require.NotContains(t, messages, "oversized event")
+
+		last := stream.events[len(stream.events)-1]
+		if skip {
+			require.Equal(t, int32(1), last.Dropped)
+			continue
+		}
+		flagged := stream.events[len(stream.events)-2]
+		require.True(t, flagged.Oversized)
+		require.Empty(t, flagged.Entry)
+		require.Equal(t, "error", flagged.Level)
+		require.Zero(t, last.Dropped)
+	}
+}
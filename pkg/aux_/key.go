@@ -0,0 +1,105 @@
+package aux
+
+import (
+	"context"
+	"sort"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+)
+
+// maxKeyEntities bounds the number of Operations and Constraints of a single
+// GetCurrentKey request.
+const maxKeyEntities = 1000
+
+// GetCurrentKey returns the current OVNs of the strategic conflict detection
+// Operations and Constraints identified in req that the client owns, and
+// where to obtain those of the others, which are withheld as they are by
+// every other handler.
+func (a *Server) GetCurrentKey(ctx context.Context, req *auxpb.GetCurrentKeyRequest) (*auxpb.GetCurrentKeyResponse, error) {
+	if a.SCDStore == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Strategic conflict detection is not enabled on this DSS instance")
+	}
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	}
+	if n := len(req.GetOperationIds()) + len(req.GetConstraintIds()); n > maxKeyEntities {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Key may cover at most %d Operations and Constraints, not %d", maxKeyEntities, n)
+	}
+
+	intentID, err := dssmodels.IDFromOptionalString(req.GetOperationId())
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format: `%s`", req.GetOperationId())
+	}
+	var opIDs []dssmodels.ID
+	for _, s := range req.GetOperationIds() {
+		id, err := dssmodels.IDFromString(s)
+		if err != nil {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid Operation ID format: `%s`", s)
+		}
+		// The Operation being updated does not conflict with its own prior
+		// version.
+		if id != intentID {
+			opIDs = append(opIDs, id)
+		}
+	}
+	var constraintIDs []dssmodels.ID
+	for _, s := range req.GetConstraintIds() {
+		id, err := dssmodels.IDFromString(s)
+		if err != nil {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid Constraint ID format: `%s`", s)
+		}
+		constraintIDs = append(constraintIDs, id)
+	}
+
+	var (
+		ops         []*scdmodels.Operation
+		constraints []*scdmodels.Constraint
+	)
+	// The OVNs returned are meant to be written in the key of a retry, so they
+	// are read in a transaction rather than from a possibly stale snapshot.
+	err = a.SCDStore.Transact(ctx, func(ctx context.Context, r repos.Repository) (err error) {
+		ops, err = r.GetOperations(ctx, opIDs)
+		if err != nil {
+			return stacktrace.Propagate(err, "Could not get Operations from repo")
+		}
+		constraints, err = r.GetConstraints(ctx, constraintIDs)
+		if err != nil {
+			return stacktrace.Propagate(err, "Could not get Constraints from repo")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	result := &auxpb.GetCurrentKeyResponse{}
+	for _, op := range ops {
+		if op.Owner == owner {
+			result.Key = append(result.Key, op.OVN.String())
+			continue
+		}
+		result.WithheldOperations = append(result.WithheldOperations, &auxpb.WithheldOVN{
+			Id:         op.ID.String(),
+			UssBaseUrl: op.USSBaseURL,
+		})
+	}
+	for _, constraint := range constraints {
+		if constraint.Owner == owner {
+			result.Key = append(result.Key, constraint.OVN.String())
+			continue
+		}
+		result.WithheldConstraints = append(result.WithheldConstraints, &auxpb.WithheldOVN{
+			Id:         constraint.ID.String(),
+			UssBaseUrl: constraint.USSBaseURL,
+		})
+	}
+	sort.Strings(result.Key)
+	return result, nil
+}
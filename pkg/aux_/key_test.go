@@ -0,0 +1,191 @@
+package aux
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/scd"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeKeyStore holds Operations all overlapping each other, and serves the
+// strategic conflict detection Server as well as GetCurrentKey.
+type fakeKeyStore struct {
+	scdstore.Store
+	repos.Repository
+	ops  map[dssmodels.ID]*scdmodels.Operation
+	subs []*scdmodels.Subscription
+}
+
+func (f *fakeKeyStore) Transact(ctx context.Context, action func(context.Context, repos.Repository) error) error {
+	return action(ctx, f)
+}
+
+func (f *fakeKeyStore) GetOperation(ctx context.Context, id dssmodels.ID) (*scdmodels.Operation, error) {
+	return f.ops[id], nil
+}
+
+func (f *fakeKeyStore) GetOperations(ctx context.Context, ids []dssmodels.ID) ([]*scdmodels.Operation, error) {
+	var ops []*scdmodels.Operation
+	for _, id := range ids {
+		if op, ok := f.ops[id]; ok {
+			copied := *op
+			ops = append(ops, &copied)
+		}
+	}
+	return ops, nil
+}
+
+func (f *fakeKeyStore) GetConstraints(ctx context.Context, ids []dssmodels.ID) ([]*scdmodels.Constraint, error) {
+	return nil, nil
+}
+
+func (f *fakeKeyStore) SearchOperations(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Operation, error) {
+	var ops []*scdmodels.Operation
+	for _, op := range f.ops {
+		copied := *op
+		ops = append(ops, &copied)
+	}
+	return ops, nil
+}
+
+func (f *fakeKeyStore) OperationHasOVN(ctx context.Context, id dssmodels.ID, ovn scdmodels.OVN) (bool, error) {
+	op, ok := f.ops[id]
+	return ok && op.OVN == ovn, nil
+}
+
+func (f *fakeKeyStore) UpsertOperation(ctx context.Context, op *scdmodels.Operation) (*scdmodels.Operation, error) {
+	op.OVN = scdmodels.OVN(fmt.Sprintf("%s-%d", op.ID, op.Version))
+	f.ops[op.ID] = op
+	return op, nil
+}
+
+func (f *fakeKeyStore) GetSubscription(ctx context.Context, id dssmodels.ID) (*scdmodels.Subscription, error) {
+	for _, sub := range f.subs {
+		if sub.ID == id {
+			return sub, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeKeyStore) SearchSubscriptions(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Subscription, error) {
+	return nil, nil
+}
+
+func (f *fakeKeyStore) IncrementNotificationIndices(ctx context.Context, ids []dssmodels.ID) ([]int, error) {
+	return nil, nil
+}
+
+// conflictingOperationIDs returns the IDs of the Operations reported as
+// missing from the key by the MissingOVNs error err.
+func conflictingOperationIDs(t *testing.T, err error) []string {
+	st, ok := status.FromError(stacktrace.RootCause(err))
+	require.True(t, ok)
+	require.Equal(t, codes.Code(dsserr.MissingOVNs), st.Code())
+	details := st.Proto().GetDetails()
+	require.Len(t, details, 1)
+	conflict := &scdpb.AirspaceConflictResponse{}
+	require.NoError(t, proto.Unmarshal(details[0].GetValue(), conflict))
+
+	var ids []string
+	for _, entity := range conflict.GetEntityConflicts() {
+		ids = append(ids, entity.GetOperationReference().GetId())
+	}
+	return ids
+}
+
+func TestGetCurrentKeyEnablesRetry(t *testing.T) {
+	var (
+		ctx     = auth.ContextWithOwner(context.Background(), "owner")
+		ownID   = dssmodels.ID("1348c8e5-0b1c-43cf-9114-2e67a4532765")
+		otherID = dssmodels.ID("2348c8e5-0b1c-43cf-9114-2e67a4532765")
+		id      = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		subID   = dssmodels.ID("5348c8e5-0b1c-43cf-9114-2e67a4532765")
+		store   = &fakeKeyStore{
+			ops: map[dssmodels.ID]*scdmodels.Operation{
+				ownID:   {ID: ownID, Owner: "owner", Version: 3, OVN: "own-ovn", USSBaseURL: "https://uss"},
+				otherID: {ID: otherID, Owner: "other", Version: 1, OVN: "other-ovn", USSBaseURL: "https://other"},
+			},
+			subs: []*scdmodels.Subscription{{
+				ID:                  subID,
+				Owner:               "owner",
+				BaseURL:             "https://uss",
+				NotifyForOperations: true,
+				Cells: s2.CellUnion{
+					s2.CellIDFromFace(0), s2.CellIDFromFace(1), s2.CellIDFromFace(2),
+					s2.CellIDFromFace(3), s2.CellIDFromFace(4), s2.CellIDFromFace(5),
+				},
+			}},
+		}
+		s   = &Server{SCDStore: store}
+		put = func(key []string) error {
+			start, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			end, err := ptypes.TimestampProto(time.Now().Add(2 * time.Hour))
+			require.NoError(t, err)
+			_, err = (&scd.Server{Store: store}).PutOperationReference(ctx, &scdpb.PutOperationReferenceRequest{
+				Entityuuid: id.String(),
+				Params: &scdpb.PutOperationReferenceParameters{
+					Extents: []*scdpb.Volume4D{{
+						Volume: &scdpb.Volume3D{
+							OutlineCircle: &scdpb.Circle{
+								Center: &scdpb.LatLngPoint{Lat: 37.4, Lng: -122.1},
+								Radius: &scdpb.Radius{Units: dssmodels.UnitsM, Value: 100},
+							},
+						},
+						TimeStart: &scdpb.Time{Value: start, Format: dssmodels.TimeFormatRFC3339},
+						TimeEnd:   &scdpb.Time{Value: end, Format: dssmodels.TimeFormatRFC3339},
+					}},
+					Key:            key,
+					State:          "Accepted",
+					UssBaseUrl:     "https://uss",
+					SubscriptionId: subID.String(),
+				},
+			})
+			return err
+		}
+	)
+
+	validator := s.AuthScopes()["/auxpb.DSSAuxService/GetCurrentKey"]
+	require.NoError(t, validator.ValidateKeyClaimedScopes(ctx, auth.ScopeSet{scd.Scopes.StrategicCoordination: {}}))
+
+	conflicting := conflictingOperationIDs(t, put(nil))
+	require.ElementsMatch(t, []string{ownID.String(), otherID.String()}, conflicting)
+
+	resp, err := s.GetCurrentKey(ctx, &auxpb.GetCurrentKeyRequest{
+		OperationId:  id.String(),
+		OperationIds: conflicting,
+	})
+	require.NoError(t, err)
+	// Only the OVN of the client's own Operation is disclosed; the other is
+	// to be obtained from its owner.
+	require.Equal(t, []string{"own-ovn"}, resp.Key)
+	require.Equal(t, []*auxpb.WithheldOVN{{Id: otherID.String(), UssBaseUrl: "https://other"}}, resp.WithheldOperations)
+
+	require.Equal(t, []string{otherID.String()}, conflictingOperationIDs(t, put(resp.Key)))
+
+	// With the withheld OVN, as disclosed by its owner, the key returned
+	// completes the retry.
+	require.NoError(t, put(append(resp.Key, "other-ovn")))
+	require.Equal(t, scdmodels.Version(1), store.ops[id].Version)
+
+	_, err = (&Server{}).GetCurrentKey(ctx, &auxpb.GetCurrentKeyRequest{})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
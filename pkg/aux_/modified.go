@@ -0,0 +1,120 @@
+package aux
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+)
+
+const (
+	defaultModifiedPageSize = 100
+	maxModifiedPageSize     = 1000
+)
+
+// ListModifiedIdentificationServiceAreas lists a page of the remote ID ISAs
+// written after the time or page token in req.
+//
+// Pages are delimited by a keyset cursor of the last ISA's version and ID
+// rather than an offset, so ISAs written while a client is paging do not
+// shift earlier pages. The cursor is not a snapshot though: an ISA's version
+// is the start time of the transaction writing it, so an ISA committed after
+// a page was read may sort before its cursor and never be listed.
+func (a *Server) ListModifiedIdentificationServiceAreas(ctx context.Context, req *auxpb.ListModifiedIdentificationServiceAreasRequest) (*auxpb.ListModifiedIdentificationServiceAreasResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+
+	limit := int(req.GetPageSize())
+	switch {
+	case limit == 0:
+		limit = defaultModifiedPageSize
+	case limit < 0 || limit > maxModifiedPageSize:
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+			"Page size must be between 1 and %d", maxModifiedPageSize)
+	}
+
+	var (
+		since   time.Time
+		afterID dssmodels.ID
+		err     error
+	)
+	if req.GetPageToken() != "" {
+		since, afterID, err = decodeModifiedPageToken(req.GetPageToken())
+		if err != nil {
+			return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid page token")
+		}
+	} else {
+		since, err = time.Parse(time.RFC3339Nano, req.GetSince())
+		if err != nil {
+			return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid since time")
+		}
+	}
+
+	isas, err := a.RIDApp.ListISAsModifiedSince(ctx, since, afterID, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list modified ISAs")
+	}
+
+	result := &auxpb.ListModifiedIdentificationServiceAreasResponse{}
+	for _, isa := range isas {
+		result.ServiceAreas = append(result.ServiceAreas, modifiedISAToProto(isa))
+	}
+	if len(isas) > 0 {
+		last := isas[len(isas)-1]
+		since, afterID = *last.Version.ToTimestamp(), last.ID
+	}
+	result.NextPageToken = encodeModifiedPageToken(since, afterID)
+	return result, nil
+}
+
+func modifiedISAToProto(isa *ridmodels.IdentificationServiceArea) *auxpb.ModifiedIdentificationServiceArea {
+	result := &auxpb.ModifiedIdentificationServiceArea{
+		Id:         isa.ID.String(),
+		Owner:      isa.Owner.String(),
+		FlightsUrl: isa.URL,
+		Version:    isa.Version.String(),
+		UpdatedAt:  isa.Version.ToTimestamp().Format(time.RFC3339Nano),
+	}
+	if isa.StartTime != nil {
+		result.TimeStart = isa.StartTime.Format(time.RFC3339Nano)
+	}
+	if isa.EndTime != nil {
+		result.TimeEnd = isa.EndTime.Format(time.RFC3339Nano)
+	}
+	return result
+}
+
+// encodeModifiedPageToken returns an opaque token identifying the position
+// after the ISA with ID afterID written at since, or after every ISA written
+// at since if afterID is empty.
+func encodeModifiedPageToken(since time.Time, afterID dssmodels.ID) string {
+	cursor := dssmodels.VersionFromTime(since).String() + "." + afterID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(cursor))
+}
+
+func decodeModifiedPageToken(token string) (time.Time, dssmodels.ID, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, "", stacktrace.Propagate(err, "Error decoding page token")
+	}
+	parts := strings.SplitN(string(cursor), ".", 2)
+	if len(parts) != 2 {
+		return time.Time{}, "", stacktrace.NewError("Malformed page token")
+	}
+	version, err := dssmodels.VersionFromString(parts[0])
+	if err != nil {
+		return time.Time{}, "", stacktrace.Propagate(err, "Error parsing page token time")
+	}
+	afterID, err := dssmodels.IDFromOptionalString(parts[1])
+	if err != nil {
+		return time.Time{}, "", stacktrace.Propagate(err, "Error parsing page token ID")
+	}
+	return *version.ToTimestamp(), afterID, nil
+}
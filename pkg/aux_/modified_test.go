@@ -0,0 +1,124 @@
+package aux
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/rid/application"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeISAApp struct {
+	application.App
+	isas []*ridmodels.IdentificationServiceArea
+}
+
+func (f *fakeISAApp) ListISAsModifiedSince(ctx context.Context, since time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var result []*ridmodels.IdentificationServiceArea
+	for _, isa := range f.isas {
+		updated := *isa.Version.ToTimestamp()
+		if updated.After(since) || (updated.Equal(since) && !afterID.Empty() && isa.ID > afterID) {
+			result = append(result, isa)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		ti, tj := *result[i].Version.ToTimestamp(), *result[j].Version.ToTimestamp()
+		if ti.Equal(tj) {
+			return result[i].ID < result[j].ID
+		}
+		return ti.Before(tj)
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func TestListModifiedIdentificationServiceAreas(t *testing.T) {
+	var (
+		ctx  = context.Background()
+		base = time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+		isa  = func(id string, updated time.Time) *ridmodels.IdentificationServiceArea {
+			return &ridmodels.IdentificationServiceArea{
+				ID:      dssmodels.ID(id),
+				Owner:   "me",
+				URL:     "https://no/place/like/home",
+				Version: dssmodels.VersionFromTime(updated),
+			}
+		}
+		unchanged = isa("1111c8e5-0b1c-43cf-9114-2e67a4532765", base)
+		updated   = isa("2222c8e5-0b1c-43cf-9114-2e67a4532765", base.Add(time.Minute))
+		// Two ISAs written in the same transaction share a version.
+		insertedA = isa("3333c8e5-0b1c-43cf-9114-2e67a4532765", base.Add(2*time.Minute))
+		insertedB = isa("4444c8e5-0b1c-43cf-9114-2e67a4532765", base.Add(2*time.Minute))
+		app       = &fakeISAApp{isas: []*ridmodels.IdentificationServiceArea{insertedB, unchanged, insertedA, updated}}
+		s         = &Server{RIDApp: app}
+	)
+
+	resp, err := s.ListModifiedIdentificationServiceAreas(ctx, &auxpb.ListModifiedIdentificationServiceAreasRequest{
+		Since: base.Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+	var ids []string
+	for _, area := range resp.ServiceAreas {
+		ids = append(ids, area.Id)
+	}
+	require.Equal(t, []string{updated.ID.String(), insertedA.ID.String(), insertedB.ID.String()}, ids)
+	require.Equal(t, updated.Version.String(), resp.ServiceAreas[0].Version)
+	require.Equal(t, base.Add(time.Minute).Format(time.RFC3339Nano), resp.ServiceAreas[0].UpdatedAt)
+
+	// Paging one ISA at a time visits the same ISAs, including both of those
+	// sharing a version.
+	ids = nil
+	req := &auxpb.ListModifiedIdentificationServiceAreasRequest{
+		Since:    base.Format(time.RFC3339),
+		PageSize: 1,
+	}
+	for {
+		resp, err := s.ListModifiedIdentificationServiceAreas(ctx, req)
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.NextPageToken)
+		if len(resp.ServiceAreas) == 0 {
+			break
+		}
+		require.Len(t, resp.ServiceAreas, 1)
+		ids = append(ids, resp.ServiceAreas[0].Id)
+		req.PageToken = resp.NextPageToken
+	}
+	require.Equal(t, []string{updated.ID.String(), insertedA.ID.String(), insertedB.ID.String()}, ids)
+
+	// The final token picks up ISAs written after the listing finished.
+	later := isa("5555c8e5-0b1c-43cf-9114-2e67a4532765", base.Add(3*time.Minute))
+	app.isas = append(app.isas, later)
+	resp, err = s.ListModifiedIdentificationServiceAreas(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.ServiceAreas, 1)
+	require.Equal(t, later.ID.String(), resp.ServiceAreas[0].Id)
+}
+
+func TestListModifiedIdentificationServiceAreasRejectsBadRequests(t *testing.T) {
+	var (
+		ctx = context.Background()
+		s   = &Server{RIDApp: &fakeISAApp{}}
+	)
+	for _, req := range []*auxpb.ListModifiedIdentificationServiceAreasRequest{
+		{Since: "yesterday"},
+		{Since: time.Now().Format(time.RFC3339), PageSize: maxModifiedPageSize + 1},
+		{Since: time.Now().Format(time.RFC3339), PageSize: -1},
+		{PageToken: "not a token"},
+	} {
+		_, err := s.ListModifiedIdentificationServiceAreas(ctx, req)
+		require.Error(t, err)
+		require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	}
+
+	_, err := (&Server{}).ListModifiedIdentificationServiceAreas(ctx, &auxpb.ListModifiedIdentificationServiceAreasRequest{})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
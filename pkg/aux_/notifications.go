@@ -0,0 +1,140 @@
+package aux
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/api/v1/ridpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// testNotificationTimeout bounds the time spent waiting for a USS callback
+	// to respond to a test notification.
+	testNotificationTimeout = 10 * time.Second
+)
+
+// notificationClient delivers test notifications when the Server has no
+// HTTPClient.
+var notificationClient = &http.Client{Timeout: testNotificationTimeout}
+
+// TestSubscriptionNotification delivers a test notification to the callback
+// URL of the remote ID Subscription identified in req. Only the owner of the
+// Subscription may trigger a test notification, and only to the URL stored
+// with that Subscription, so this endpoint cannot be used to probe arbitrary
+// URLs.
+func (a *Server) TestSubscriptionNotification(ctx context.Context, req *auxpb.TestSubscriptionNotificationRequest) (*auxpb.TestSubscriptionNotificationResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	}
+
+	id, err := dssmodels.IDFromString(req.GetId())
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
+	}
+
+	sub, err := a.RIDApp.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not get Subscription")
+	}
+	if sub == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", req.GetId())
+	}
+	if sub.Owner != owner {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied,
+			"Subscription owned by %s, but %s attempted to test its notifications", sub.Owner, owner)
+	}
+
+	body, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(&ridpb.PutIdentificationServiceAreaNotificationParameters{
+		Subscriptions: []*ridpb.SubscriptionState{
+			{
+				SubscriptionId:    sub.ID.String(),
+				NotificationIndex: int32(sub.NotificationIndex),
+			},
+		},
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error marshaling test notification")
+	}
+
+	result := &auxpb.TestSubscriptionNotificationResponse{
+		Url: sub.URL,
+	}
+	statusCode, err := a.notify(ctx, sub.URL, body)
+	if err != nil {
+		// Report only the root cause, as the error interceptor does, rather
+		// than the stacktrace leading to it.
+		result.Error = stacktrace.RootCause(err).Error()
+	}
+	result.StatusCode = int32(statusCode)
+	return result, nil
+}
+
+// GetSubscriptionNotificationIndices returns the current and next
+// notification indices of the remote ID Subscription identified in req.
+// Access is restricted to AdminScope by AuthScopes.
+func (a *Server) GetSubscriptionNotificationIndices(ctx context.Context, req *auxpb.GetSubscriptionNotificationIndicesRequest) (*auxpb.GetSubscriptionNotificationIndicesResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+
+	id, err := dssmodels.IDFromString(req.GetId())
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format: `%s`", req.GetId())
+	}
+
+	current, next, err := a.RIDApp.GetNotificationIndices(ctx, id)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not get notification indices of Subscription")
+	}
+	return &auxpb.GetSubscriptionNotificationIndicesResponse{
+		CurrentIndex: int32(current),
+		NextIndex:    int32(next),
+	}, nil
+}
+
+// notify POSTs body to url and returns the HTTP status code of the response.
+// Redirects are reported rather than followed, so that a callback cannot
+// bounce the notification to a URL other than the one stored.
+func (a *Server) notify(ctx context.Context, url string, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, testNotificationTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error creating notification request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := *notificationClient
+	if a.HTTPClient != nil {
+		client = *a.HTTPClient
+	}
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error delivering notification")
+	}
+	defer resp.Body.Close()
+	// Drain the body so the underlying connection can be reused.
+	if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+		return resp.StatusCode, stacktrace.Propagate(err, "Error reading notification response")
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,148 @@
+package aux
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/rid/application"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSubscriptionApp struct {
+	application.App
+	subscriptions map[dssmodels.ID]*ridmodels.Subscription
+}
+
+func (f *fakeSubscriptionApp) GetSubscription(ctx context.Context, id dssmodels.ID) (*ridmodels.Subscription, error) {
+	return f.subscriptions[id], nil
+}
+
+func TestTestSubscriptionNotificationDoesNotFollowRedirects(t *testing.T) {
+	redirected := false
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	callback := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer callback.Close()
+
+	var (
+		id = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		s  = &Server{
+			RIDApp: &fakeSubscriptionApp{
+				subscriptions: map[dssmodels.ID]*ridmodels.Subscription{
+					id: {ID: id, Owner: "me", URL: callback.URL},
+				},
+			},
+		}
+		ctx = auth.ContextWithOwner(context.Background(), "me")
+	)
+
+	resp, err := s.TestSubscriptionNotification(ctx, &auxpb.TestSubscriptionNotificationRequest{Id: id.String()})
+	require.NoError(t, err)
+	require.Equal(t, int32(http.StatusTemporaryRedirect), resp.StatusCode)
+	require.False(t, redirected)
+}
+
+func TestTestSubscriptionNotification(t *testing.T) {
+	var received []map[string]interface{}
+	callback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		payload := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(body, &payload))
+		received = append(received, payload)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer callback.Close()
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachableURL := unreachable.URL
+	unreachable.Close()
+
+	var (
+		ownID         = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		othersID      = dssmodels.ID("6666c8e5-0b1c-43cf-9114-2e67a4532765")
+		unreachableID = dssmodels.ID("7777c8e5-0b1c-43cf-9114-2e67a4532765")
+		missingID     = dssmodels.ID("8888c8e5-0b1c-43cf-9114-2e67a4532765")
+		s             = &Server{
+			RIDApp: &fakeSubscriptionApp{
+				subscriptions: map[dssmodels.ID]*ridmodels.Subscription{
+					ownID:         {ID: ownID, Owner: "me", URL: callback.URL, NotificationIndex: 3},
+					othersID:      {ID: othersID, Owner: "someone-else", URL: callback.URL},
+					unreachableID: {ID: unreachableID, Owner: "me", URL: unreachableURL},
+				},
+			},
+		}
+		ctx = auth.ContextWithOwner(context.Background(), "me")
+	)
+
+	resp, err := s.TestSubscriptionNotification(ctx, &auxpb.TestSubscriptionNotificationRequest{Id: ownID.String()})
+	require.NoError(t, err)
+	require.Equal(t, int32(http.StatusNoContent), resp.StatusCode)
+	require.Equal(t, callback.URL, resp.Url)
+	require.Empty(t, resp.Error)
+	require.Len(t, received, 1)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"subscription_id": ownID.String(), "notification_index": float64(3)},
+	}, received[0]["subscriptions"])
+
+	_, err = s.TestSubscriptionNotification(ctx, &auxpb.TestSubscriptionNotificationRequest{Id: othersID.String()})
+	require.Error(t, err)
+	require.Equal(t, dsserr.PermissionDenied, stacktrace.GetCode(err))
+	require.Len(t, received, 1)
+
+	_, err = s.TestSubscriptionNotification(ctx, &auxpb.TestSubscriptionNotificationRequest{Id: missingID.String()})
+	require.Error(t, err)
+	require.Equal(t, dsserr.NotFound, stacktrace.GetCode(err))
+
+	resp, err = s.TestSubscriptionNotification(ctx, &auxpb.TestSubscriptionNotificationRequest{Id: unreachableID.String()})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), resp.StatusCode)
+	require.NotEmpty(t, resp.Error)
+	require.NotContains(t, resp.Error, ".go:")
+	require.NotContains(t, resp.Error, "Error delivering notification")
+}
+
+func (f *fakeSubscriptionApp) GetNotificationIndices(ctx context.Context, id dssmodels.ID) (int, int, error) {
+	sub, ok := f.subscriptions[id]
+	if !ok {
+		return 0, 0, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", id)
+	}
+	return sub.NotificationIndex, sub.NotificationIndex + 1, nil
+}
+
+func TestGetSubscriptionNotificationIndices(t *testing.T) {
+	var (
+		ctx = context.Background()
+		id  = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		s   = &Server{RIDApp: &fakeSubscriptionApp{
+			subscriptions: map[dssmodels.ID]*ridmodels.Subscription{
+				id: {ID: id, Owner: "me", NotificationIndex: 7},
+			},
+		}}
+	)
+
+	resp, err := s.GetSubscriptionNotificationIndices(ctx, &auxpb.GetSubscriptionNotificationIndicesRequest{Id: id.String()})
+	require.NoError(t, err)
+	require.Equal(t, int32(7), resp.CurrentIndex)
+	require.Equal(t, int32(8), resp.NextIndex)
+
+	_, err = s.GetSubscriptionNotificationIndices(ctx, &auxpb.GetSubscriptionNotificationIndicesRequest{Id: "8888c8e5-0b1c-43cf-9114-2e67a4532765"})
+	require.Equal(t, dsserr.NotFound, stacktrace.GetCode(err))
+	_, err = s.GetSubscriptionNotificationIndices(ctx, &auxpb.GetSubscriptionNotificationIndicesRequest{Id: "not-a-uuid"})
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	_, err = (&Server{}).GetSubscriptionNotificationIndices(ctx, &auxpb.GetSubscriptionNotificationIndicesRequest{Id: id.String()})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
@@ -0,0 +1,144 @@
+package aux
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+)
+
+const (
+	defaultScanPageSize = 100
+	maxScanPageSize     = 1000
+)
+
+// ScanIdentificationServiceAreas lists a page of every remote ID ISA ordered
+// by ID. Access is restricted to AdminScope by AuthScopes.
+func (a *Server) ScanIdentificationServiceAreas(ctx context.Context, req *auxpb.ScanRemoteIDRequest) (*auxpb.ScanIdentificationServiceAreasResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+	afterID, limit, err := parseScanRequest(req)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	isas, err := a.RIDApp.ListISAs(ctx, afterID, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list ISAs")
+	}
+
+	result := &auxpb.ScanIdentificationServiceAreasResponse{}
+	for _, isa := range isas {
+		result.ServiceAreas = append(result.ServiceAreas, storedISAToProto(isa))
+	}
+	if len(isas) == limit {
+		result.NextPageToken = encodeScanPageToken(isas[len(isas)-1].ID)
+	}
+	return result, nil
+}
+
+// ScanSubscriptions lists a page of every remote ID Subscription ordered by
+// ID. Access is restricted to AdminScope by AuthScopes.
+func (a *Server) ScanSubscriptions(ctx context.Context, req *auxpb.ScanRemoteIDRequest) (*auxpb.ScanSubscriptionsResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+	afterID, limit, err := parseScanRequest(req)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	subs, err := a.RIDApp.ListSubscriptions(ctx, afterID, limit)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not list Subscriptions")
+	}
+
+	result := &auxpb.ScanSubscriptionsResponse{}
+	for _, sub := range subs {
+		result.Subscriptions = append(result.Subscriptions, storedSubscriptionToProto(sub))
+	}
+	if len(subs) == limit {
+		result.NextPageToken = encodeScanPageToken(subs[len(subs)-1].ID)
+	}
+	return result, nil
+}
+
+func parseScanRequest(req *auxpb.ScanRemoteIDRequest) (dssmodels.ID, int, error) {
+	limit := int(req.GetPageSize())
+	switch {
+	case limit == 0:
+		limit = defaultScanPageSize
+	case limit < 0 || limit > maxScanPageSize:
+		return "", 0, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+			"Page size must be between 1 and %d", maxScanPageSize)
+	}
+	if req.GetPageToken() == "" {
+		return "", limit, nil
+	}
+	afterID, err := decodeScanPageToken(req.GetPageToken())
+	if err != nil {
+		return "", 0, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid page token")
+	}
+	return afterID, limit, nil
+}
+
+// encodeScanPageToken returns an opaque token identifying the position after
+// the entity with ID afterID.
+func encodeScanPageToken(afterID dssmodels.ID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(afterID.String()))
+}
+
+func decodeScanPageToken(token string) (dssmodels.ID, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Error decoding page token")
+	}
+	return dssmodels.IDFromString(string(cursor))
+}
+
+func storedISAToProto(isa *ridmodels.IdentificationServiceArea) *auxpb.StoredIdentificationServiceArea {
+	result := &auxpb.StoredIdentificationServiceArea{
+		Id:         isa.ID.String(),
+		Owner:      isa.Owner.String(),
+		FlightsUrl: isa.URL,
+		Version:    isa.Version.String(),
+		Writer:     isa.Writer,
+	}
+	if isa.StartTime != nil {
+		result.TimeStart = isa.StartTime.Format(time.RFC3339Nano)
+	}
+	if isa.EndTime != nil {
+		result.TimeEnd = isa.EndTime.Format(time.RFC3339Nano)
+	}
+	for _, cell := range isa.Cells {
+		result.Cells = append(result.Cells, uint64(cell))
+	}
+	return result
+}
+
+func storedSubscriptionToProto(sub *ridmodels.Subscription) *auxpb.StoredSubscription {
+	result := &auxpb.StoredSubscription{
+		Id:                sub.ID.String(),
+		Owner:             sub.Owner.String(),
+		CallbackUrl:       sub.URL,
+		NotificationIndex: int32(sub.NotificationIndex),
+		Version:           sub.Version.String(),
+		Writer:            sub.Writer,
+	}
+	if sub.StartTime != nil {
+		result.TimeStart = sub.StartTime.Format(time.RFC3339Nano)
+	}
+	if sub.EndTime != nil {
+		result.TimeEnd = sub.EndTime.Format(time.RFC3339Nano)
+	}
+	for _, cell := range sub.Cells {
+		result.Cells = append(result.Cells, uint64(cell))
+	}
+	return result
+}
@@ -0,0 +1,133 @@
+package aux
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/rid/application"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeScanApp struct {
+	application.App
+	isas []*ridmodels.IdentificationServiceArea
+	subs []*ridmodels.Subscription
+}
+
+func (f *fakeScanApp) ListISAs(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var result []*ridmodels.IdentificationServiceArea
+	for _, isa := range f.isas {
+		if isa.ID > afterID {
+			result = append(result, isa)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (f *fakeScanApp) ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	var result []*ridmodels.Subscription
+	for _, sub := range f.subs {
+		if sub.ID > afterID {
+			result = append(result, sub)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func TestScanVisitsEveryEntityOnce(t *testing.T) {
+	var (
+		ctx = context.Background()
+		app = &fakeScanApp{}
+		s   = &Server{RIDApp: app}
+	)
+	// Insert out of order, so that the scan has to order them.
+	for _, i := range []int{4, 1, 6, 3, 0, 5, 2} {
+		id := dssmodels.ID(fmt.Sprintf("%d000c8e5-0b1c-43cf-9114-2e67a4532765", i))
+		app.isas = append(app.isas, &ridmodels.IdentificationServiceArea{ID: id, Owner: "owner"})
+		app.subs = append(app.subs, &ridmodels.Subscription{ID: id, Owner: "owner", NotificationIndex: i})
+	}
+
+	var isaIDs []string
+	req := &auxpb.ScanRemoteIDRequest{PageSize: 3}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 10)
+		resp, err := s.ScanIdentificationServiceAreas(ctx, req)
+		require.NoError(t, err)
+		for _, isa := range resp.ServiceAreas {
+			isaIDs = append(isaIDs, isa.Id)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	var subIDs []string
+	req = &auxpb.ScanRemoteIDRequest{PageSize: 2}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 10)
+		resp, err := s.ScanSubscriptions(ctx, req)
+		require.NoError(t, err)
+		for _, sub := range resp.Subscriptions {
+			subIDs = append(subIDs, sub.Id)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	var want []string
+	for i := 0; i < 7; i++ {
+		want = append(want, fmt.Sprintf("%d000c8e5-0b1c-43cf-9114-2e67a4532765", i))
+	}
+	require.Equal(t, want, isaIDs)
+	require.Equal(t, want, subIDs)
+}
+
+func TestScanRequiresAdminAndRejectsBadRequests(t *testing.T) {
+	var (
+		ctx = context.Background()
+		s   = &Server{RIDApp: &fakeScanApp{}}
+	)
+	for _, op := range []auth.Operation{
+		"/auxpb.DSSAuxService/ScanIdentificationServiceAreas",
+		"/auxpb.DSSAuxService/ScanSubscriptions",
+	} {
+		validator := s.AuthScopes()[op]
+		require.Error(t, validator.ValidateKeyClaimedScopes(ctx, auth.ScopeSet{
+			auth.Scope("dss.read.identification_service_areas"): {},
+		}))
+		require.NoError(t, validator.ValidateKeyClaimedScopes(ctx, auth.ScopeSet{AdminScope: {}}))
+	}
+
+	for _, req := range []*auxpb.ScanRemoteIDRequest{
+		{PageSize: maxScanPageSize + 1},
+		{PageSize: -1},
+		{PageToken: "not a token"},
+	} {
+		_, err := s.ScanIdentificationServiceAreas(ctx, req)
+		require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+		_, err = s.ScanSubscriptions(ctx, req)
+		require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	}
+
+	_, err := (&Server{}).ScanSubscriptions(ctx, &auxpb.ScanRemoteIDRequest{})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+}
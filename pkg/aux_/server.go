@@ -2,22 +2,92 @@ package aux
 
 import (
 	"context"
+	"flag"
+	"net/http"
+	"sort"
 
+	"github.com/coreos/go-semver/semver"
 	"github.com/interuss/dss/pkg/api/v1/auxpb"
 	"github.com/interuss/dss/pkg/auth"
 	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/gc"
+	"github.com/interuss/dss/pkg/logging"
+	"github.com/interuss/dss/pkg/rid/application"
 	ridserver "github.com/interuss/dss/pkg/rid/server"
+	"github.com/interuss/dss/pkg/scd"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
 	"github.com/interuss/dss/pkg/version"
 	"github.com/interuss/stacktrace"
 )
 
+var (
+	// AdminScope authorizes the maintenance operations of the aux API.
+	AdminScope = auth.Scope("dss.admin")
+)
+
 // Server implements auxpb.DSSAuxService.
-type Server struct{}
+type Server struct {
+	// RIDApp provides access to the remote ID Subscriptions whose callbacks
+	// may be tested and to the ISAs listed for synchronization; nil if remote
+	// ID is not enabled.
+	RIDApp application.App
+	// GC serializes the garbage collection passes triggered through this
+	// Server with any scheduled ones; required if RIDApp is set.
+	GC *gc.Collector
+	// SCDStore provides access to the strategic conflict detection entities
+	// inspected by administrators; nil if strategic conflict detection is not
+	// enabled.
+	SCDStore scdstore.Store
+	// HTTPClient delivers test notifications, without following redirects
+	// whatever its CheckRedirect; a client with a timeout of 10s is used if
+	// nil.
+	HTTPClient *http.Client
+	// Region identifies the DSS region, or pool, of this DSS instance.
+	Region string
+	// Locality identifies this DSS instance within its region.
+	Locality string
+	// ScopesValidators are the validators authorizing every operation served
+	// alongside this Server, as reported by GetRequiredScopes.
+	ScopesValidators map[auth.Operation]auth.KeyClaimedScopesValidator
+	// LogEvents provides the events streamed to administrators; nil if log
+	// event streaming is not enabled.
+	LogEvents *logging.EventHub
+	// MaxStreamMessageSize is the size in bytes beyond which an item is not
+	// streamed as is, since clients would reject the message and the rest of
+	// the stream with it; 0 for no limit.
+	MaxStreamMessageSize int
+	// SkipOversized makes streams skip the items beyond MaxStreamMessageSize,
+	// rather than stream them without their content, flagged as oversized.
+	SkipOversized bool
+	// Flags are the command line flags configuring this DSS instance, as
+	// reported, with secrets redacted, by GetDiagnostics.
+	Flags *flag.FlagSet
+	// SchemaVersions are the versions of the database schemas backing each
+	// enabled API, by API name.
+	SchemaVersions map[string]*semver.Version
+	// Pools report the statistics of the database connection pools backing
+	// each enabled API, by API name.
+	Pools map[string]PoolStatser
+}
 
 // AuthScopes returns a map of endpoint to required Oauth scope.
 func (a *Server) AuthScopes() map[auth.Operation]auth.KeyClaimedScopesValidator {
 	return map[auth.Operation]auth.KeyClaimedScopesValidator{
-		"/auxpb.DSSAuxService/ValidateOauth": auth.RequireAnyScope(ridserver.Scopes.ISA.Read, ridserver.Scopes.ISA.Write),
+		"/auxpb.DSSAuxService/ValidateOauth":                          auth.RequireAnyScope(ridserver.Scopes.ISA.Read, ridserver.Scopes.ISA.Write),
+		"/auxpb.DSSAuxService/TestSubscriptionNotification":           auth.RequireAllScopes(ridserver.Scopes.ISA.Read),
+		"/auxpb.DSSAuxService/GetIdentificationServiceAreaCoverage":   auth.RequireAllScopes(ridserver.Scopes.ISA.Read),
+		"/auxpb.DSSAuxService/GetCurrentKey":                          auth.RequireAllScopes(scd.Scopes.StrategicCoordination),
+		"/auxpb.DSSAuxService/TriggerGarbageCollection":               auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/GetSubscriptionWithOwner":               auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ListOperationsTouchingSubscription":     auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/CountIdentificationServiceAreas":        auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ScanIdentificationServiceAreas":         auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ListModifiedIdentificationServiceAreas": auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/ScanSubscriptions":                      auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/GetSubscriptionNotificationIndices":     auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/StreamLogEvents":                        auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/RefreshStatistics":                      auth.RequireAllScopes(AdminScope),
+		"/auxpb.DSSAuxService/GetDiagnostics":                         auth.RequireAllScopes(AdminScope),
 	}
 }
 
@@ -30,6 +100,14 @@ func (a *Server) GetVersion(context.Context, *auxpb.GetVersionRequest) (*auxpb.G
 	}, nil
 }
 
+// GetRegion returns the identity of the DSS region of the server.
+func (a *Server) GetRegion(context.Context, *auxpb.GetRegionRequest) (*auxpb.GetRegionResponse, error) {
+	return &auxpb.GetRegionResponse{
+		Region:   a.Region,
+		Locality: a.Locality,
+	}, nil
+}
+
 // ValidateOauth will exercise validating the Oauth token
 func (a *Server) ValidateOauth(ctx context.Context, req *auxpb.ValidateOauthRequest) (*auxpb.ValidateOauthResponse, error) {
 	owner, ok := auth.OwnerFromContext(ctx)
@@ -41,3 +119,79 @@ func (a *Server) ValidateOauth(ctx context.Context, req *auxpb.ValidateOauthRequ
 	}
 	return &auxpb.ValidateOauthResponse{}, nil
 }
+
+// GetRequiredScopes returns the scopes required by each operation in
+// ScopesValidators.
+func (a *Server) GetRequiredScopes(context.Context, *auxpb.GetRequiredScopesRequest) (*auxpb.GetRequiredScopesResponse, error) {
+	result := &auxpb.GetRequiredScopesResponse{}
+	for operation, validator := range a.ScopesValidators {
+		describer, ok := validator.(auth.ScopesDescriber)
+		if !ok {
+			continue
+		}
+		required := describer.RequiredScopes()
+		result.Operations = append(result.Operations, &auxpb.OperationScopes{
+			Operation: operation.String(),
+			AllOf:     scopeStrings(required.AllOf),
+			AnyOf:     scopeStrings(required.AnyOf),
+		})
+	}
+	sort.Slice(result.Operations, func(i, j int) bool {
+		return result.Operations[i].Operation < result.Operations[j].Operation
+	})
+	return result, nil
+}
+
+func scopeStrings(scopes []auth.Scope) []string {
+	var result []string
+	for _, scope := range scopes {
+		result = append(result, scope.String())
+	}
+	return result
+}
+
+// TriggerGarbageCollection runs one garbage collection pass over the expired
+// remote ID entities, waiting for any pass already in progress to complete
+// first.
+func (a *Server) TriggerGarbageCollection(ctx context.Context, req *auxpb.TriggerGarbageCollectionRequest) (*auxpb.TriggerGarbageCollectionResponse, error) {
+	if a.RIDApp == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "Remote ID is not enabled on this DSS instance")
+	}
+
+	result := &auxpb.TriggerGarbageCollectionResponse{}
+	err := a.GC.Run(ctx, func(ctx context.Context) error {
+		isas, subs, err := a.RIDApp.CollectGarbage(ctx)
+		result.IdentificationServiceAreasRemoved = int32(isas)
+		result.SubscriptionsRemoved = int32(subs)
+		return err
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error collecting garbage")
+	}
+	return result, nil
+}
+
+// RefreshStatistics refreshes the table statistics of the remote ID and
+// strategic conflict detection stores, whichever are enabled.
+func (a *Server) RefreshStatistics(ctx context.Context, req *auxpb.RefreshStatisticsRequest) (*auxpb.RefreshStatisticsResponse, error) {
+	if a.RIDApp == nil && a.SCDStore == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.Unimplemented, "No API is enabled on this DSS instance")
+	}
+
+	result := &auxpb.RefreshStatisticsResponse{}
+	if a.RIDApp != nil {
+		tables, err := a.RIDApp.RefreshStatistics(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error refreshing remote ID statistics")
+		}
+		result.Tables = append(result.Tables, tables...)
+	}
+	if a.SCDStore != nil {
+		tables, err := a.SCDStore.RefreshStatistics(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error refreshing strategic conflict detection statistics")
+		}
+		result.Tables = append(result.Tables, tables...)
+	}
+	return result, nil
+}
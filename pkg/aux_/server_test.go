@@ -0,0 +1,135 @@
+package aux
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/gc"
+	"github.com/interuss/dss/pkg/rid/application"
+	ridserver "github.com/interuss/dss/pkg/rid/server"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequiredScopes(t *testing.T) {
+	s := &Server{}
+	s.ScopesValidators = auth.MergeOperationsAndScopesValidators(
+		s.AuthScopes(), (&ridserver.Server{}).AuthScopes(),
+	)
+
+	resp, err := s.GetRequiredScopes(context.Background(), &auxpb.GetRequiredScopesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Operations, len(s.ScopesValidators))
+
+	for i, operation := range resp.Operations {
+		if i > 0 {
+			require.Less(t, resp.Operations[i-1].Operation, operation.Operation)
+		}
+		validator, ok := s.ScopesValidators[auth.Operation(operation.Operation)]
+		require.True(t, ok)
+		required := validator.(auth.ScopesDescriber).RequiredScopes()
+		require.Equal(t, scopeStrings(required.AllOf), operation.AllOf)
+		require.Equal(t, scopeStrings(required.AnyOf), operation.AnyOf)
+	}
+
+	require.Contains(t, resp.Operations, &auxpb.OperationScopes{
+		Operation: "/auxpb.DSSAuxService/ValidateOauth",
+		AnyOf:     []string{ridserver.Scopes.ISA.Read.String(), ridserver.Scopes.ISA.Write.String()},
+	})
+	require.Contains(t, resp.Operations, &auxpb.OperationScopes{
+		Operation: "/ridpb.DiscoveryAndSynchronizationService/CreateIdentificationServiceArea",
+		AllOf:     []string{ridserver.Scopes.ISA.Write.String()},
+	})
+}
+
+type fakeGCApp struct {
+	application.App
+	isas int
+}
+
+func (f *fakeGCApp) CollectGarbage(ctx context.Context) (int, int, error) {
+	removed := f.isas
+	f.isas = 0
+	return removed, 0, nil
+}
+
+func TestTriggerGarbageCollectionWaitsForRunningPass(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		collector = &gc.Collector{}
+		s         = &Server{RIDApp: &fakeGCApp{isas: 2}, GC: collector}
+		running   = make(chan struct{})
+		release   = make(chan struct{})
+		scheduled = make(chan error)
+	)
+	go func() {
+		scheduled <- collector.Run(ctx, func(context.Context) error {
+			close(running)
+			<-release
+			return nil
+		})
+	}()
+	<-running
+
+	triggered := make(chan *auxpb.TriggerGarbageCollectionResponse)
+	go func() {
+		resp, err := s.TriggerGarbageCollection(ctx, &auxpb.TriggerGarbageCollectionRequest{})
+		require.NoError(t, err)
+		triggered <- resp
+	}()
+	select {
+	case <-triggered:
+		t.Fatal("triggered pass overlapped the running one")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-scheduled)
+	resp := <-triggered
+	require.Equal(t, int32(2), resp.IdentificationServiceAreasRemoved)
+	require.Equal(t, int32(0), resp.SubscriptionsRemoved)
+
+	// Nothing is left for a second pass.
+	resp, err := s.TriggerGarbageCollection(ctx, &auxpb.TriggerGarbageCollectionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), resp.IdentificationServiceAreasRemoved)
+}
+
+type fakeStatisticsApp struct {
+	application.App
+}
+
+func (f *fakeStatisticsApp) RefreshStatistics(ctx context.Context) ([]string, error) {
+	return []string{"identification_service_areas", "subscriptions"}, nil
+}
+
+type fakeStatisticsStore struct {
+	scdstore.Store
+}
+
+func (f *fakeStatisticsStore) RefreshStatistics(ctx context.Context) ([]string, error) {
+	return []string{"scd_operations"}, nil
+}
+
+func TestRefreshStatisticsOfEnabledAPIs(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := (&Server{}).RefreshStatistics(ctx, &auxpb.RefreshStatisticsRequest{})
+	require.Equal(t, dsserr.Unimplemented, stacktrace.GetCode(err))
+
+	resp, err := (&Server{RIDApp: &fakeStatisticsApp{}}).RefreshStatistics(ctx, &auxpb.RefreshStatisticsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"identification_service_areas", "subscriptions"}, resp.Tables)
+
+	resp, err = (&Server{RIDApp: &fakeStatisticsApp{}, SCDStore: &fakeStatisticsStore{}}).RefreshStatistics(ctx, &auxpb.RefreshStatisticsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"identification_service_areas", "subscriptions", "scd_operations"}, resp.Tables)
+
+	describer := (&Server{}).AuthScopes()["/auxpb.DSSAuxService/RefreshStatistics"].(auth.ScopesDescriber)
+	require.Equal(t, []auth.Scope{AdminScope}, describer.RequiredScopes().AllOf)
+}
@@ -0,0 +1,98 @@
+package cockroach
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	dssql "github.com/interuss/dss/pkg/sql"
+	"github.com/interuss/stacktrace"
+	"go.uber.org/zap"
+)
+
+const (
+	// dbClockQueryTimeout bounds the time spent asking the database for its
+	// current time.
+	dbClockQueryTimeout = 5 * time.Second
+)
+
+// DBClock is a clockwork.Clock whose Now reports the current time according
+// to the database rather than the local host, so that expiry comparisons made
+// by the DSS agree with timestamps written by the database.
+//
+// The offset between the database and local clocks is cached for a
+// configurable duration; in between refreshes Now advances with the local
+// clock. If the database cannot be queried, the last known offset (initially
+// zero) is used until the next refresh. Only the call to Now finding the
+// offset due for a refresh waits for the database; concurrent calls use the
+// last known offset meanwhile.
+type DBClock struct {
+	clockwork.Clock // Local clock used for Sleep, After and between refreshes.
+
+	db       dssql.Queryable
+	cacheFor time.Duration
+	logger   *zap.Logger
+
+	mu         sync.Mutex
+	offset     time.Duration
+	refreshed  time.Time // Local time of the last refresh attempt.
+	refreshing bool      // Whether a refresh is in progress.
+}
+
+// NewDBClock returns a DBClock reading the current time from db, caching the
+// result for cacheFor.
+func NewDBClock(db dssql.Queryable, local clockwork.Clock, cacheFor time.Duration, logger *zap.Logger) *DBClock {
+	return &DBClock{
+		Clock:    local,
+		db:       db,
+		cacheFor: cacheFor,
+		logger:   logger,
+	}
+}
+
+// Local returns the local clock underlying c. Unlike c, whose readings step
+// by the change in offset at every refresh, it measures elapsed time
+// reliably.
+func (c *DBClock) Local() clockwork.Clock {
+	return c.Clock
+}
+
+// Now returns the database's current time.
+func (c *DBClock) Now() time.Time {
+	c.mu.Lock()
+	local := c.Clock.Now()
+	if c.refreshing || (!c.refreshed.IsZero() && local.Sub(c.refreshed) < c.cacheFor) {
+		defer c.mu.Unlock()
+		return local.Add(c.offset)
+	}
+	c.refreshing = true
+	c.mu.Unlock()
+
+	offset, err := c.queryOffset(local)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.logger.Warn("Failed to read time from database, using cached offset",
+			zap.Duration("offset", c.offset), zap.Error(err))
+	} else {
+		c.offset = offset
+	}
+	c.refreshed = local
+	c.refreshing = false
+	return c.Clock.Now().Add(c.offset)
+}
+
+// queryOffset returns the offset of the database's clock from local, the
+// current time of the local clock.
+func (c *DBClock) queryOffset(local time.Time) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), dbClockQueryTimeout)
+	defer cancel()
+
+	var now time.Time
+	if err := c.db.QueryRowContext(ctx, "SELECT now()").Scan(&now); err != nil {
+		return 0, stacktrace.Propagate(err, "Error querying database time")
+	}
+	return now.Sub(local), nil
+}
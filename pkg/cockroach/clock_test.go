@@ -0,0 +1,165 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"github.com/interuss/dss/pkg/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedTimeDriver answers every query with a single row holding now, counting
+// the queries it serves. Queries fail while err is set, and, while started is
+// set, signal it when they start and wait for release before answering.
+type fixedTimeDriver struct {
+	mu      sync.Mutex
+	now     time.Time
+	err     error
+	queries int
+	started chan struct{}
+	release chan struct{}
+}
+
+func (d *fixedTimeDriver) Open(string) (driver.Conn, error) {
+	return &fixedTimeConn{d: d}, nil
+}
+
+type fixedTimeConn struct {
+	d *fixedTimeDriver
+}
+
+func (c *fixedTimeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *fixedTimeConn) Close() error {
+	return nil
+}
+
+func (c *fixedTimeConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *fixedTimeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.d.mu.Lock()
+	started, release := c.d.started, c.d.release
+	c.d.mu.Unlock()
+	if started != nil {
+		started <- struct{}{}
+		<-release
+	}
+
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	if c.d.err != nil {
+		return nil, c.d.err
+	}
+	c.d.queries++
+	return &fixedTimeRows{now: c.d.now}, nil
+}
+
+type fixedTimeRows struct {
+	now  time.Time
+	done bool
+}
+
+func (r *fixedTimeRows) Columns() []string {
+	return []string{"now"}
+}
+
+func (r *fixedTimeRows) Close() error {
+	return nil
+}
+
+func (r *fixedTimeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = r.now
+	return nil
+}
+
+var dbTime = &fixedTimeDriver{}
+
+func init() {
+	sql.Register("fixed-time", dbTime)
+}
+
+func TestDBClockUsesDatabaseTime(t *testing.T) {
+	db, err := sql.Open("fixed-time", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	var (
+		local    = clockwork.NewFakeClock()
+		skew     = 90 * time.Second
+		cacheFor = time.Second
+		clock    = NewDBClock(db, local, cacheFor, logging.Logger)
+	)
+	dbTime.now = local.Now().Add(skew)
+
+	require.Equal(t, local.Now().Add(skew), clock.Now())
+	require.Equal(t, 1, dbTime.queries)
+	require.Equal(t, local, clock.Local())
+
+	// Within the cache window, the cached offset is applied to the local clock.
+	local.Advance(cacheFor / 2)
+	require.Equal(t, local.Now().Add(skew), clock.Now())
+	require.Equal(t, 1, dbTime.queries)
+
+	// Once the cache expires, the database is consulted again.
+	local.Advance(cacheFor)
+	dbTime.now = local.Now().Add(2 * skew)
+	require.Equal(t, local.Now().Add(2*skew), clock.Now())
+	require.Equal(t, 2, dbTime.queries)
+
+	// If the database cannot be reached, the last known offset still applies.
+	dbTime.err = errors.New("unreachable")
+	defer func() { dbTime.err = nil }()
+	local.Advance(cacheFor)
+	require.Equal(t, local.Now().Add(2*skew), clock.Now())
+}
+
+func TestDBClockServesCachedOffsetDuringRefresh(t *testing.T) {
+	db, err := sql.Open("fixed-time", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	var (
+		local    = clockwork.NewFakeClock()
+		skew     = 90 * time.Second
+		cacheFor = time.Second
+		clock    = NewDBClock(db, local, cacheFor, logging.Logger)
+	)
+	dbTime.now = local.Now().Add(skew)
+	require.Equal(t, local.Now().Add(skew), clock.Now())
+
+	local.Advance(cacheFor)
+	dbTime.mu.Lock()
+	dbTime.now = local.Now().Add(2 * skew)
+	dbTime.started, dbTime.release = make(chan struct{}), make(chan struct{})
+	dbTime.mu.Unlock()
+	defer func() {
+		dbTime.mu.Lock()
+		dbTime.started, dbTime.release = nil, nil
+		dbTime.mu.Unlock()
+	}()
+
+	refreshed := make(chan time.Time)
+	go func() { refreshed <- clock.Now() }()
+	<-dbTime.started
+
+	// While the database is slow to answer, the cached offset still applies.
+	require.Equal(t, local.Now().Add(skew), clock.Now())
+
+	close(dbTime.release)
+	require.Equal(t, local.Now().Add(2*skew), <-refreshed)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/interuss/stacktrace"
@@ -27,6 +28,14 @@ type (
 		Dir  string
 	}
 
+	// Pool models the configuration of a pool of connections, following the
+	// semantics of the corresponding sql.DB setters.
+	Pool struct {
+		MaxOpenConns    int           // No limit if not positive.
+		MaxIdleConns    int           // No idle connection kept if not positive.
+		ConnMaxLifetime time.Duration // Connections reused forever if not positive.
+	}
+
 	// ConnectParameters bundles up parameters used for connecting to a CRDB instance.
 	ConnectParameters struct {
 		ApplicationName string
@@ -35,6 +44,7 @@ type (
 		DBName          string
 		Credentials     Credentials
 		SSL             SSL
+		Pool            Pool
 	}
 )
 
@@ -122,8 +132,39 @@ func Dial(uri string) (*DB, error) {
 	}, nil
 }
 
+// Validate returns an error if p keeps more idle connections than it may open,
+// which sql.DB would otherwise silently reduce.
+func (p Pool) Validate() error {
+	if p.MaxOpenConns > 0 && p.MaxIdleConns > p.MaxOpenConns {
+		return stacktrace.NewError("Maximum of %d idle connections exceeds maximum of %d open connections", p.MaxIdleConns, p.MaxOpenConns)
+	}
+	return nil
+}
+
+// ConfigurePool applies p to the connection pool of db, once validated.
+func (db *DB) ConfigurePool(p Pool) error {
+	if err := p.Validate(); err != nil {
+		return stacktrace.Propagate(err, "Invalid connection pool configuration")
+	}
+	db.SetMaxOpenConns(p.MaxOpenConns)
+	db.SetMaxIdleConns(p.MaxIdleConns)
+	db.SetConnMaxLifetime(p.ConnMaxLifetime)
+	return nil
+}
+
+// DefaultVersionTable is the table recording the schema version of a
+// database bootstrapped by the Schema Manager.
+const DefaultVersionTable = "schema_versions"
+
 // GetVersion returns the Schema Version of the requested DB Name
 func (db *DB) GetVersion(ctx context.Context, dbName string) (*semver.Version, error) {
+	return db.GetVersionFromTable(ctx, dbName, DefaultVersionTable)
+}
+
+// GetVersionFromTable returns the Schema Version recorded in table of the
+// requested DB Name. Schemas sharing a database record their versions in
+// separate tables.
+func (db *DB) GetVersionFromTable(ctx context.Context, dbName string, table string) (*semver.Version, error) {
 	const query = `
 		SELECT EXISTS (
 			SELECT
@@ -131,7 +172,7 @@ func (db *DB) GetVersion(ctx context.Context, dbName string) (*semver.Version, e
 			FROM
 				information_schema.tables
 			WHERE
-				table_name = 'schema_versions'
+				table_name = $2
 			AND
 				table_catalog = $1
 		)
@@ -143,12 +184,12 @@ func (db *DB) GetVersion(ctx context.Context, dbName string) (*semver.Version, e
 		SELECT
 			schema_version
 		FROM
-			%s.schema_versions
+			%s.%s
 		WHERE
-			onerow_enforcer = TRUE`, dbName)
+			onerow_enforcer = TRUE`, dbName, table)
 	)
 
-	if err := db.QueryRowContext(ctx, query, dbName).Scan(&exists); err != nil {
+	if err := db.QueryRowContext(ctx, query, dbName, table).Scan(&exists); err != nil {
 		return nil, stacktrace.Propagate(err, "Error scanning table listing row")
 	}
 
@@ -167,3 +208,18 @@ func (db *DB) GetVersion(ctx context.Context, dbName string) (*semver.Version, e
 
 	return semver.NewVersion(dbVersion)
 }
+
+// statisticsName names the statistics collected by RefreshStatistics.
+const statisticsName = "dss_refresh"
+
+// RefreshStatistics collects new statistics for each of tables, from which
+// the optimizer plans the queries reading them. Statistics may go stale after
+// bulk loads until they are next collected automatically.
+func (db *DB) RefreshStatistics(ctx context.Context, tables ...string) error {
+	for _, table := range tables {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("CREATE STATISTICS %s FROM %s", statisticsName, table)); err != nil {
+			return stacktrace.Propagate(err, "Error refreshing statistics of %s", table)
+		}
+	}
+	return nil
+}
@@ -1,11 +1,55 @@
 package cockroach
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
 
+// recordingDriver records the statements executed on its connections.
+type recordingDriver struct {
+	mu         sync.Mutex
+	statements []string
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) {
+	return &recordingConn{d: d}, nil
+}
+
+type recordingConn struct {
+	d *recordingDriver
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *recordingConn) Close() error {
+	return nil
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.statements = append(c.d.statements, query)
+	return driver.RowsAffected(0), nil
+}
+
+var recorder = &recordingDriver{}
+
+func init() {
+	sql.Register("recording", recorder)
+}
+
 func TestBuildURI(t *testing.T) {
 	cases := []struct {
 		name   string
@@ -90,3 +134,33 @@ func TestBuildURI(t *testing.T) {
 		require.Equal(t, c.want, got)
 	}
 }
+
+func TestRefreshStatistics(t *testing.T) {
+	sqlDB, err := sql.Open("recording", "")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	db := &DB{DB: sqlDB}
+	require.NoError(t, db.RefreshStatistics(context.Background(), "identification_service_areas", "subscriptions"))
+	require.Equal(t, []string{
+		"CREATE STATISTICS dss_refresh FROM identification_service_areas",
+		"CREATE STATISTICS dss_refresh FROM subscriptions",
+	}, recorder.statements)
+}
+
+func TestConfigurePool(t *testing.T) {
+	sqlDB, err := sql.Open("recording", "")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	db := &DB{DB: sqlDB}
+
+	require.NoError(t, db.ConfigurePool(Pool{MaxOpenConns: 8, MaxIdleConns: 4, ConnMaxLifetime: time.Minute}))
+	require.Equal(t, 8, db.Stats().MaxOpenConnections)
+
+	// More idle than open connections is rejected, unless open connections
+	// are not limited.
+	require.Error(t, db.ConfigurePool(Pool{MaxOpenConns: 2, MaxIdleConns: 4}))
+	require.Equal(t, 8, db.Stats().MaxOpenConnections)
+	require.NoError(t, db.ConfigurePool(Pool{MaxIdleConns: 4}))
+	require.Zero(t, db.Stats().MaxOpenConnections)
+}
@@ -23,4 +23,7 @@ func init() {
 	flag.StringVar(&connectParameters.SSL.Mode, "cockroach_ssl_mode", "disable", "cockroach sslmode")
 	flag.StringVar(&connectParameters.SSL.Dir, "cockroach_ssl_dir", "", "directory to ssl certificates. Must contain files: ca.crt, client.<user>.crt, client.<user>.key")
 	flag.StringVar(&connectParameters.Credentials.Username, "cockroach_user", "root", "cockroach user to authenticate as")
+	flag.IntVar(&connectParameters.Pool.MaxOpenConns, "cockroach_max_open_conns", 0, "maximum number of open connections to cockroach; 0 does not limit them")
+	flag.IntVar(&connectParameters.Pool.MaxIdleConns, "cockroach_max_idle_conns", 2, "maximum number of idle connections to cockroach kept for reuse, which may not exceed --cockroach_max_open_conns; 0 keeps none")
+	flag.DurationVar(&connectParameters.Pool.ConnMaxLifetime, "cockroach_conn_max_lifetime", 0, "maximum time a connection to cockroach is reused for; 0 reuses connections forever")
 }
@@ -0,0 +1,40 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/interuss/stacktrace"
+)
+
+// asOfFollowerReadTimestamp makes a transaction read as of the most recent
+// time at which any replica, and not only the leaseholder, is guaranteed to
+// be able to serve it.
+const asOfFollowerReadTimestamp = "SET TRANSACTION AS OF SYSTEM TIME follower_read_timestamp()"
+
+// ExecuteFollowerReadTx runs fn in a transaction of db reading as of
+// follower_read_timestamp(), so that it may be served by the nearest replica
+// rather than the leaseholder of each range.
+//
+// The data read is therefore stale: it reflects the database as it was a few
+// seconds ago (about 5s with the default cluster settings), missing any
+// later write, including those of the same client. The transaction is also
+// read-only, and fn fails if it attempts to write. fn must therefore only
+// serve reads whose caller tolerates bounded staleness, and never read data
+// on which a subsequent write depends. Transactions reading in the past do
+// not conflict with writes, and are not retried.
+func (db *DB) ExecuteFollowerReadTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error beginning follower read transaction")
+	}
+	if _, err := tx.ExecContext(ctx, asOfFollowerReadTimestamp); err != nil {
+		tx.Rollback()
+		return stacktrace.Propagate(err, "Error setting follower read timestamp")
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return stacktrace.Propagate(tx.Commit(), "Error committing follower read transaction")
+}
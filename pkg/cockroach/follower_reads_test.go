@@ -0,0 +1,39 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteFollowerReadTxReadsAsOfFollowerReadTimestamp(t *testing.T) {
+	sqlDB, err := sql.Open("transactions", "")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	var (
+		ctx = context.Background()
+		db  = &DB{DB: sqlDB}
+	)
+	*transactions = txnDriver{}
+
+	require.NoError(t, db.ExecuteFollowerReadTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "SELECT 1")
+		return err
+	}))
+	require.Equal(t, []string{asOfFollowerReadTimestamp, "SELECT 1"}, transactions.execs)
+	require.Equal(t, 1, transactions.commits)
+
+	// Failures are not retried.
+	failure := errors.New("read failed")
+	calls := 0
+	require.Equal(t, failure, db.ExecuteFollowerReadTx(ctx, func(*sql.Tx) error {
+		calls++
+		return failure
+	}))
+	require.Equal(t, 1, calls)
+	require.Equal(t, 1, transactions.commits)
+	require.Equal(t, 1, transactions.rollbacks)
+}
@@ -0,0 +1,118 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"math/rand"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// serializationFailure is the SQLSTATE of transactions aborted by
+	// CockroachDB to preserve serializable isolation, and safe to retry.
+	serializationFailure = "40001"
+
+	// minRetryDelay and maxRetryDelay bound the delay before retrying a
+	// transaction, doubled after each failed attempt.
+	minRetryDelay = 10 * time.Millisecond
+	maxRetryDelay = 1 * time.Second
+)
+
+var (
+	// TransactionAttempts is the number of attempts ExecuteTx makes at a
+	// transaction failing with serialization errors, at least 1.
+	TransactionAttempts = 10
+
+	transactionRetries = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "dss",
+		Subsystem: "cockroach",
+		Name:      "transaction_retries_total",
+		Help:      "Number of transactions retried after failing with a serialization error.",
+	})
+)
+
+// RegisterMetrics registers the metrics of the transactions executed with
+// ExecuteTx with registerer, unless they were registered already.
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	err := registerer.Register(transactionRetries)
+	if registered, ok := err.(prometheus.AlreadyRegisteredError); ok && registered.ExistingCollector == transactionRetries {
+		return nil
+	}
+	return stacktrace.Propagate(err, "Error registering transaction retry counter")
+}
+
+// ExecuteTx runs fn in a transaction of db, and commits it if fn succeeds. If
+// the transaction fails with a serialization error, it is retried from
+// scratch in a new transaction, up to TransactionAttempts times in total,
+// after a delay growing exponentially with each attempt. fn must therefore be
+// safe to run several times.
+func (db *DB) ExecuteTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	attempts := TransactionAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = db.executeTxOnce(ctx, fn)
+		if err == nil || !isSerializationFailure(err) {
+			return err // No need to Propagate this error as this stack layer does not add useful information
+		}
+		if attempt >= attempts {
+			return stacktrace.Propagate(err, "Transaction failed with serialization errors %d times", attempt)
+		}
+
+		transactionRetries.Inc()
+		if sleepErr := sleep(ctx, retryDelay(attempt)); sleepErr != nil {
+			return stacktrace.Propagate(err, "Transaction not retried after %d attempts: %s", attempt, sleepErr)
+		}
+	}
+}
+
+func (db *DB) executeTxOnce(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error beginning transaction")
+	}
+	if err := fn(tx); err != nil {
+		// Rolling back only releases the connection; fn's error is the cause.
+		tx.Rollback()
+		return err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return stacktrace.Propagate(tx.Commit(), "Error committing transaction")
+}
+
+// isSerializationFailure returns true if err was caused by CockroachDB
+// aborting a transaction for it to be retried.
+func isSerializationFailure(err error) bool {
+	pqErr, ok := stacktrace.RootCause(err).(*pq.Error)
+	return ok && pqErr.Code == serializationFailure
+}
+
+// retryDelay returns the delay before retrying a transaction after its
+// attempt-th failure: a random duration between half and all of the minimum
+// delay doubled for each previous attempt, up to the maximum delay. The
+// randomness keeps transactions conflicting with each other from retrying in
+// lockstep.
+func retryDelay(attempt int) time.Duration {
+	delay := maxRetryDelay
+	if attempt < 32 && minRetryDelay<<uint(attempt-1) < maxRetryDelay {
+		delay = minRetryDelay << uint(attempt-1)
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// sleep waits for d, or returns ctx's error if it is done first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,150 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// txnDriver counts the transactions committed and rolled back on its
+// connections, and records the statements executed on them.
+type txnDriver struct {
+	mu        sync.Mutex
+	commits   int
+	rollbacks int
+	execs     []string
+}
+
+func (d *txnDriver) Open(string) (driver.Conn, error) {
+	return &txnConn{d: d}, nil
+}
+
+type txnConn struct {
+	d *txnDriver
+}
+
+func (c *txnConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *txnConn) Close() error {
+	return nil
+}
+
+func (c *txnConn) Begin() (driver.Tx, error) {
+	return c, nil
+}
+
+func (c *txnConn) Commit() error {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.commits++
+	return nil
+}
+
+func (c *txnConn) Rollback() error {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.rollbacks++
+	return nil
+}
+
+func (c *txnConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.d.mu.Lock()
+	defer c.d.mu.Unlock()
+	c.d.execs = append(c.d.execs, query)
+	return driver.RowsAffected(0), nil
+}
+
+var transactions = &txnDriver{}
+
+func init() {
+	sql.Register("transactions", transactions)
+}
+
+func TestExecuteTxRetriesSerializationFailures(t *testing.T) {
+	defer func(attempts int) { TransactionAttempts = attempts }(TransactionAttempts)
+	TransactionAttempts = 3
+
+	sqlDB, err := sql.Open("transactions", "")
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	var (
+		ctx          = context.Background()
+		db           = &DB{DB: sqlDB}
+		conflict     = stacktrace.Propagate(&pq.Error{Code: serializationFailure}, "Error updating ISA")
+		retriesAtEnd = testutil.ToFloat64(transactionRetries)
+		failing      = func(failures int) func(*sql.Tx) error {
+			return func(*sql.Tx) error {
+				if failures > 0 {
+					failures--
+					return conflict
+				}
+				return nil
+			}
+		}
+	)
+	*transactions = txnDriver{}
+
+	// Serialization failures are retried in new transactions.
+	require.NoError(t, db.ExecuteTx(ctx, failing(2)))
+	require.Equal(t, 1, transactions.commits)
+	require.Equal(t, 2, transactions.rollbacks)
+	require.Equal(t, retriesAtEnd+2, testutil.ToFloat64(transactionRetries))
+
+	// Up to TransactionAttempts times.
+	err = db.ExecuteTx(ctx, failing(3))
+	require.True(t, isSerializationFailure(err))
+	require.Equal(t, 1, transactions.commits)
+	require.Equal(t, retriesAtEnd+4, testutil.ToFloat64(transactionRetries))
+
+	// Other errors are not retried.
+	other := errors.New("bad request")
+	calls := 0
+	require.Equal(t, other, db.ExecuteTx(ctx, func(*sql.Tx) error {
+		calls++
+		return other
+	}))
+	require.Equal(t, 1, calls)
+
+	// Nor are serialization failures once ctx is done.
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	calls = 0
+	require.Error(t, db.ExecuteTx(ctx, func(*sql.Tx) error {
+		calls++
+		return conflict
+	}))
+	require.LessOrEqual(t, calls, 1)
+}
+
+func TestRetryDelayGrowsUpToMaximum(t *testing.T) {
+	for attempt, max := range map[int]time.Duration{
+		1:  minRetryDelay,
+		2:  2 * minRetryDelay,
+		3:  4 * minRetryDelay,
+		20: maxRetryDelay,
+		64: maxRetryDelay,
+	} {
+		for i := 0; i < 10; i++ {
+			delay := retryDelay(attempt)
+			require.True(t, delay >= max/2 && delay <= max, delay)
+		}
+	}
+}
+
+func TestRegisterMetricsTwice(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	require.NoError(t, RegisterMetrics(registry))
+	require.NoError(t, RegisterMetrics(registry))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/google/uuid"
@@ -51,6 +52,10 @@ const (
 
 	// Unauthenticated is used when an OAuth token is invalid or not supplied.
 	Unauthenticated stacktrace.ErrorCode = stacktrace.ErrorCode(uint16(codes.Unauthenticated))
+
+	// Unimplemented is used when a request relies on functionality that is not
+	// enabled on this DSS instance.
+	Unimplemented stacktrace.ErrorCode = stacktrace.ErrorCode(uint16(codes.Unimplemented))
 )
 
 func init() {
@@ -67,89 +72,154 @@ func MakeErrID() string {
 	return fmt.Sprintf("E:<error ID could not be constructed: %s>", err)
 }
 
-// MakeStatusProto adds the content of a proto as a detail to a Status proto
-// consisting of the provided code and message.
-func MakeStatusProto(code codes.Code, message string, detail proto.Message) (*spb.Status, error) {
-	serialized, err := proto.MarshalOptions{Deterministic: true}.Marshal(detail)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Error serializing detail proto")
-	}
-
+// MakeStatusProto adds the content of each of details as a detail to a Status
+// proto consisting of the provided code and message.
+func MakeStatusProto(code codes.Code, message string, details ...proto.Message) (*spb.Status, error) {
 	p := &spb.Status{
 		Code:    int32(code),
 		Message: message,
-		Details: []*any.Any{
-			{
-				TypeUrl: "github.com/interuss/dss/" + string(detail.ProtoReflect().Descriptor().FullName()),
-				Value:   serialized,
-			},
-		},
+	}
+	for _, detail := range details {
+		serialized, err := proto.MarshalOptions{Deterministic: true}.Marshal(detail)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error serializing detail proto")
+		}
+		p.Details = append(p.Details, &any.Any{
+			TypeUrl: "github.com/interuss/dss/" + string(detail.ProtoReflect().Descriptor().FullName()),
+			Value:   serialized,
+		})
 	}
 	return p, nil
 }
 
+// TruncateStacktrace keeps the first "maxFrames" frames of "trace", the full
+// format of a stacktrace error, and replaces the rest with a note of how many
+// frames were omitted. A non-positive "maxFrames" keeps every frame.
+func TruncateStacktrace(trace string, maxFrames int) string {
+	if maxFrames <= 0 {
+		return trace
+	}
+	var (
+		lines  = strings.Split(trace, "\n")
+		frames = 0
+	)
+	for i, line := range lines {
+		if !strings.HasPrefix(line, " --- at ") {
+			continue
+		}
+		frames++
+		if frames == maxFrames {
+			omitted := 0
+			for _, rest := range lines[i+1:] {
+				if strings.HasPrefix(rest, " --- at ") {
+					omitted++
+				}
+			}
+			if omitted == 0 {
+				return trace
+			}
+			return strings.Join(append(lines[:i+1], fmt.Sprintf(" --- %d more frames omitted ---", omitted)), "\n")
+		}
+	}
+	return trace
+}
+
 // Interceptor returns a grpc.UnaryServerInterceptor that inspects outgoing
 // errors and logs (to "logger") and replaces errors that are not *status.Status
 // instances or status instances that indicate an internal/unknown error.
-func Interceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+// Logged stacktraces are limited to "maxStackFrames" frames, unless it is 0,
+// after errors are flattened to at most "maxDepth" wrapped errors.
+func Interceptor(logger *zap.Logger, maxStackFrames int, maxDepth int) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		resp, err := handler(ctx, req)
 
 		if err == nil {
 			return resp, nil
 		}
+		return resp, toStatusError(logger, "unary server call", info.FullMethod, Flatten(err, maxDepth), maxStackFrames)
+	}
+}
 
-		errID := MakeErrID()
-
-		// Separate the root cause and code from the stacktrace wrapping.
-		trace := err.Error()
-		rootErr := stacktrace.RootCause(err)
-		code := stacktrace.GetCode(err)
+// StreamInterceptor is the equivalent of Interceptor for streaming gRPC
+// requests.
+func StreamInterceptor(logger *zap.Logger, maxStackFrames int, maxDepth int) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, stream)
+		if err == nil {
+			return nil
+		}
+		return toStatusError(logger, "streaming server call", info.FullMethod, Flatten(err, maxDepth), maxStackFrames)
+	}
+}
 
-		statusErr, ok := status.FromError(rootErr)
-		if ok {
-			// The root cause is a Status error; return it exactly as-is.
-			logger.Error(
-				fmt.Sprintf("Status error %s during unary server call", errID),
-				zap.String("method", info.FullMethod),
-				zap.String("stacktrace", trace),
-				zap.String("grpc_code", statusErr.Code().String()),
-				zap.Error(rootErr))
-			return resp, rootErr
+// toStatusError logs err, returned by a "call" to method, and returns the
+// status error reported to the client in its place.
+func toStatusError(logger *zap.Logger, call string, method string, err error, maxStackFrames int) error {
+	errID := MakeErrID()
+
+	// Separate the root cause and code from the stacktrace wrapping.
+	trace := TruncateStacktrace(err.Error(), maxStackFrames)
+	rootErr := stacktrace.RootCause(err)
+	code := stacktrace.GetCode(err)
+
+	// Batch failures additionally report the status of each failed item.
+	var details []proto.Message
+	if batch, ok := rootErr.(*MultiError); ok {
+		if code == stacktrace.NoCode {
+			code = batch.Code()
 		}
+		details = append(details, batch.response(errID))
+	}
 
-		if code != stacktrace.NoCode {
+	statusErr, ok := status.FromError(rootErr)
+	if ok {
+		// The root cause is a Status error; return it exactly as-is.
+		logger.Error(
+			fmt.Sprintf("Status error %s during %s", errID, call),
+			zap.String("method", method),
+			zap.String("stacktrace", trace),
+			zap.String("grpc_code", statusErr.Code().String()),
+			zap.Error(rootErr))
+		return rootErr
+	}
+
+	if code != stacktrace.NoCode {
+		logger.Error(
+			fmt.Sprintf("Error %s during %s", errID, call),
+			zap.String("method", method),
+			zap.String("stacktrace", trace),
+			zap.String("grpc_code", codes.Code(uint16(code)).String()),
+			zap.Int("code", int(code)),
+			zap.Error(rootErr))
+		p, constructionErr := MakeStatusProto(codes.Code(uint16(code)), rootErr.Error(), append([]proto.Message{&auxpb.StandardErrorResponse{
+			Error:   rootErr.Error(),
+			Code:    int32(code),
+			Message: rootErr.Error(),
+			ErrorId: errID,
+		}}, details...)...)
+		if constructionErr == nil {
+			err = status.ErrorProto(p)
+		} else {
+			constructionErrID := MakeErrID()
 			logger.Error(
-				fmt.Sprintf("Error %s during unary server call", errID),
-				zap.String("method", info.FullMethod),
-				zap.String("stacktrace", trace),
-				zap.String("grpc_code", codes.Code(uint16(code)).String()),
-				zap.Int("code", int(code)),
-				zap.Error(rootErr))
-			p, constructionErr := MakeStatusProto(codes.Code(uint16(code)), rootErr.Error(), &auxpb.StandardErrorResponse{
-				Error:   rootErr.Error(),
-				Code:    int32(code),
-				Message: rootErr.Error(),
-				ErrorId: errID,
-			})
-			if constructionErr == nil {
+				fmt.Sprintf("Error %s constructing StandardErrorResponse from %s", constructionErrID, errID),
+				zap.Error(constructionErr))
+			err = status.Error(codes.Internal, fmt.Sprintf("Internal server error %s", constructionErrID))
+		}
+	} else {
+		logger.Error(
+			fmt.Sprintf("Uncoded error %s during %s", errID, call),
+			zap.String("method", method),
+			zap.String("stacktrace", trace),
+			zap.Error(rootErr))
+		message := fmt.Sprintf("Internal server error %s", errID)
+		err = status.Error(codes.Internal, message)
+		if len(details) > 0 {
+			if p, constructionErr := MakeStatusProto(codes.Internal, message, details...); constructionErr == nil {
 				err = status.ErrorProto(p)
-			} else {
-				constructionErrID := MakeErrID()
-				logger.Error(
-					fmt.Sprintf("Error %s constructing StandardErrorResponse from %s", constructionErrID, errID),
-					zap.Error(constructionErr))
-				err = status.Error(codes.Internal, fmt.Sprintf("Internal server error %s", constructionErrID))
 			}
-		} else {
-			logger.Error(
-				fmt.Sprintf("Uncoded error %s during unary server call", errID),
-				zap.String("method", info.FullMethod),
-				zap.String("stacktrace", trace),
-				zap.Error(rootErr))
-			err = status.Error(codes.Internal, fmt.Sprintf("Internal server error %s", errID))
 		}
-
-		return resp, err
 	}
+
+	return err
 }
@@ -1,14 +1,58 @@
 package errors
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/interuss/stacktrace"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
 )
 
 func TestStacktraceUnwrap(t *testing.T) {
 	cause := errors.New("test")
 	assert.Equal(t, cause, errors.Unwrap(stacktrace.Propagate(cause, "test")))
 }
+
+func TestInterceptorTruncatesLoggedStacktrace(t *testing.T) {
+	err := stacktrace.NewErrorWithCode(BadRequest, "innermost")
+	for i := 0; i < 4; i++ {
+		err = stacktrace.Propagate(err, "layer %d", i)
+	}
+
+	for _, test := range []struct {
+		maxFrames int
+		frames    int
+		omitted   string
+	}{
+		{maxFrames: 0, frames: 5},
+		{maxFrames: 2, frames: 2, omitted: " --- 3 more frames omitted ---"},
+		{maxFrames: 5, frames: 5},
+		{maxFrames: 10, frames: 5},
+	} {
+		core, logs := observer.New(zapcore.DebugLevel)
+		_, _ = Interceptor(zap.New(core), test.maxFrames, 0)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, err
+			})
+		require.Len(t, logs.All(), 1)
+		trace := logs.All()[0].ContextMap()["stacktrace"].(string)
+
+		assert.Equal(t, test.frames, strings.Count(trace, " --- at "))
+		// The outermost frames are kept.
+		assert.True(t, strings.HasPrefix(trace, "layer 3\n --- at "))
+		if test.omitted == "" {
+			assert.NotContains(t, trace, "omitted")
+			assert.Contains(t, trace, "innermost")
+		} else {
+			assert.True(t, strings.HasSuffix(trace, "\n"+test.omitted))
+			assert.NotContains(t, trace, "layer 0")
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/interuss/stacktrace"
+)
+
+// Flatten returns err if it consists of at most maxDepth wrapped errors,
+// counting its root cause. Otherwise, it returns an error with the code and
+// outermost message of err, caused directly by the root cause of err, so that
+// the context added by intermediate calls to stacktrace.Propagate is dropped.
+// A maxDepth below 2, which could not keep both, leaves err unchanged.
+func Flatten(err error, maxDepth int) error {
+	if err == nil || maxDepth < 2 {
+		return err
+	}
+	var (
+		depth = 1
+		root  = err
+	)
+	for cause := errors.Unwrap(root); cause != nil; cause = errors.Unwrap(root) {
+		root = cause
+		depth++
+	}
+	if depth <= maxDepth {
+		return err
+	}
+	return stacktrace.PropagateWithCode(root, stacktrace.GetCode(err), "%s", outermostMessage(err))
+}
+
+// outermostMessage returns the message of err without that of its cause.
+func outermostMessage(err error) string {
+	brief := fmt.Sprintf("%#s", err)
+	cause := errors.Unwrap(err)
+	if cause == nil {
+		return brief
+	}
+	causeBrief := fmt.Sprintf("%#s", cause)
+	if brief == causeBrief {
+		return ""
+	}
+	return strings.TrimSuffix(brief, ": "+causeBrief)
+}
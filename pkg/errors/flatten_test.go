@@ -0,0 +1,42 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenKeepsRootCauseAndOutermostContext(t *testing.T) {
+	root := stacktrace.NewErrorWithCode(NotFound, "ISA not found")
+	err := root
+	for i := 0; i < 4; i++ {
+		err = stacktrace.Propagate(err, "layer %d", i)
+	}
+	err = stacktrace.PropagateWithCode(err, BadRequest, "Could not update ISA")
+
+	flattened := Flatten(err, 3)
+	require.Equal(t, root, errors.Unwrap(flattened))
+	require.Equal(t, BadRequest, stacktrace.GetCode(flattened))
+	require.Equal(t, "Could not update ISA: ISA not found", fmt.Sprintf("%#s", flattened))
+	require.Equal(t, stacktrace.RootCause(err), stacktrace.RootCause(flattened))
+
+	// Errors within the limit, and limits that could not keep both the
+	// context and root cause, leave errors unchanged.
+	require.Equal(t, err, Flatten(err, 6))
+	require.Equal(t, err, Flatten(err, 1))
+	require.Equal(t, err, Flatten(err, 0))
+	require.Nil(t, Flatten(nil, 3))
+}
+
+func TestFlattenKeepsNonStacktraceRootCause(t *testing.T) {
+	root := errors.New("connection refused")
+	err := stacktrace.Propagate(stacktrace.Propagate(root, "Error in query"), "")
+
+	flattened := Flatten(err, 2)
+	require.Equal(t, root, errors.Unwrap(flattened))
+	require.Equal(t, stacktrace.NoCode, stacktrace.GetCode(flattened))
+	require.Equal(t, "connection refused", fmt.Sprintf("%#s", flattened))
+}
@@ -0,0 +1,32 @@
+package errors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// HeadersInterceptor is a grpc.UnaryServerInterceptor sending response
+// headers ahead of any error returned by the rest of the chain. Without
+// headers, gRPC responds to errors with a trailers-only response, which some
+// proxies mishandle.
+func HeadersInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		// Fails harmlessly if headers were sent already.
+		grpc.SendHeader(ctx, metadata.MD{})
+	}
+	return resp, err
+}
+
+// StreamHeadersInterceptor is the grpc.StreamServerInterceptor counterpart
+// of HeadersInterceptor.
+func StreamHeadersInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, ss)
+	if err != nil {
+		// Fails harmlessly if headers were sent already.
+		ss.SendHeader(metadata.MD{})
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+package errors
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// checkUnknownServiceHeaders returns the headers of the NotFound error
+// returned when checking the health of an unknown service on a server with
+// opts.
+func checkUnknownServiceHeaders(t *testing.T, opts ...grpc.ServerOption) metadata.MD {
+	var (
+		listener = bufconn.Listen(1 << 20)
+		s        = grpc.NewServer(opts...)
+	)
+	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var header metadata.MD
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(),
+		&grpc_health_v1.HealthCheckRequest{Service: "unknown"}, grpc.Header(&header))
+	require.Equal(t, codes.NotFound, status.Code(err))
+	return header
+}
+
+func TestHeadersInterceptorSendsHeadersWithErrors(t *testing.T) {
+	// By default, errors are responded to with trailers only.
+	require.Empty(t, checkUnknownServiceHeaders(t))
+
+	header := checkUnknownServiceHeaders(t, grpc.UnaryInterceptor(HeadersInterceptor))
+	require.Equal(t, []string{"application/grpc"}, header.Get("content-type"))
+}
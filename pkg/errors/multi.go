@@ -0,0 +1,86 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc/codes"
+)
+
+// ItemError is the failure of a single item of a batch operation.
+type ItemError struct {
+	Item string // Identifies the item within the batch.
+	Err  error
+}
+
+// MultiError aggregates the failures of every item of a batch operation that
+// failed, so that callers learn about all of them rather than just the first.
+//
+// The zero value is ready to use; Add failures as they occur and return
+// ErrorOrNil once the batch completes.
+type MultiError struct {
+	Items []ItemError
+}
+
+// Add records that item failed with err. A nil err is ignored.
+func (e *MultiError) Add(item string, err error) {
+	if err != nil {
+		e.Items = append(e.Items, ItemError{Item: item, Err: err})
+	}
+}
+
+// ErrorOrNil returns e if any item failed, nil otherwise.
+func (e *MultiError) ErrorOrNil() error {
+	if len(e.Items) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Error implements error.
+func (e *MultiError) Error() string {
+	messages := make([]string, len(e.Items))
+	for i, item := range e.Items {
+		messages[i] = fmt.Sprintf("%s: %s", item.Item, item.Err)
+	}
+	return fmt.Sprintf("%d items failed: %s", len(e.Items), strings.Join(messages, "; "))
+}
+
+// Code returns the code shared by every item, or stacktrace.NoCode if the
+// items failed for different reasons.
+func (e *MultiError) Code() stacktrace.ErrorCode {
+	code := stacktrace.NoCode
+	for i, item := range e.Items {
+		itemCode := stacktrace.GetCode(item.Err)
+		if i > 0 && itemCode != code {
+			return stacktrace.NoCode
+		}
+		code = itemCode
+	}
+	return code
+}
+
+// response returns the per-item statuses reported to clients. Items without
+// a code are reported as internal errors without further detail, in line
+// with uncoded errors of single operations.
+func (e *MultiError) response(errID string) *auxpb.BatchErrorResponse {
+	result := &auxpb.BatchErrorResponse{}
+	for _, item := range e.Items {
+		var (
+			code    = stacktrace.GetCode(item.Err)
+			message = stacktrace.RootCause(item.Err).Error()
+		)
+		if code == stacktrace.NoCode {
+			code = stacktrace.ErrorCode(uint16(codes.Internal))
+			message = fmt.Sprintf("Internal server error %s", errID)
+		}
+		result.Items = append(result.Items, &auxpb.ItemErrorResponse{
+			Item:    item.Item,
+			Code:    int32(code),
+			Message: message,
+		})
+	}
+	return result
+}
@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/interuss/dss/pkg/api/v1/auxpb"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func callWithError(t *testing.T, err error) *status.Status {
+	_, err = Interceptor(zap.NewNop(), 0, 0)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Batch"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, err
+		})
+	require.Error(t, err)
+	return status.Convert(err)
+}
+
+func batchDetail(t *testing.T, st *status.Status) *auxpb.BatchErrorResponse {
+	for _, detail := range st.Proto().Details {
+		if detail.TypeUrl == "github.com/interuss/dss/auxpb.BatchErrorResponse" {
+			result := &auxpb.BatchErrorResponse{}
+			require.NoError(t, proto.Unmarshal(detail.Value, result))
+			return result
+		}
+	}
+	t.Fatal("missing BatchErrorResponse detail")
+	return nil
+}
+
+func TestInterceptorReportsEveryItemOfMultiError(t *testing.T) {
+	var errs MultiError
+	errs.Add("a", stacktrace.NewErrorWithCode(BadRequest, "a is malformed"))
+	errs.Add("b", nil)
+	errs.Add("c", stacktrace.NewErrorWithCode(NotFound, "c does not exist"))
+	errs.Add("d", stacktrace.NewError("d broke the database"))
+
+	st := callWithError(t, stacktrace.Propagate(errs.ErrorOrNil(), "Batch failed"))
+	// The items failed for different reasons, so the batch as a whole is an
+	// internal error.
+	require.Equal(t, codes.Internal, st.Code())
+
+	items := batchDetail(t, st).Items
+	require.Len(t, items, 3)
+	require.Equal(t, "a", items[0].Item)
+	require.Equal(t, int32(BadRequest), items[0].Code)
+	require.Equal(t, "a is malformed", items[0].Message)
+	require.Equal(t, "c", items[1].Item)
+	require.Equal(t, int32(NotFound), items[1].Code)
+	require.Equal(t, "c does not exist", items[1].Message)
+	require.Equal(t, "d", items[2].Item)
+	require.Equal(t, int32(codes.Internal), items[2].Code)
+	require.NotContains(t, items[2].Message, "database")
+}
+
+func TestInterceptorUsesCodeSharedByAllItems(t *testing.T) {
+	var errs MultiError
+	errs.Add("a", stacktrace.NewErrorWithCode(BadRequest, "a is malformed"))
+	errs.Add("b", stacktrace.NewErrorWithCode(BadRequest, "b is malformed"))
+
+	st := callWithError(t, stacktrace.Propagate(errs.ErrorOrNil(), "Batch failed"))
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Proto().Details, 2)
+	require.Len(t, batchDetail(t, st).Items, 2)
+}
+
+func TestEmptyMultiErrorIsNil(t *testing.T) {
+	var errs MultiError
+	errs.Add("a", nil)
+	require.NoError(t, errs.ErrorOrNil())
+}
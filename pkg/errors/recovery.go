@@ -0,0 +1,63 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RecoveryInterceptor returns a grpc.UnaryServerInterceptor that recovers
+// from panics in the rest of the chain, logging (to "logger") the panic along
+// with its stack and responding with an Internal error. If "repanic" is true,
+// the panic is resumed once logged, crashing the server; this keeps the panic
+// visible to debuggers and crash reporting.
+func RecoveryInterceptor(logger *zap.Logger, repanic bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			errID := MakeErrID()
+			logger.Error(
+				fmt.Sprintf("Panic %s during unary server call", errID),
+				zap.String("method", info.FullMethod),
+				zap.Any("panic", r),
+				zap.String("stack", string(debug.Stack())))
+			if repanic {
+				panic(r)
+			}
+			resp, err = nil, status.Error(codes.Internal, fmt.Sprintf("Internal server error %s", errID))
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamRecoveryInterceptor is the equivalent of RecoveryInterceptor for
+// streaming gRPC requests.
+func StreamRecoveryInterceptor(logger *zap.Logger, repanic bool) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+			errID := MakeErrID()
+			logger.Error(
+				fmt.Sprintf("Panic %s during stream server call", errID),
+				zap.String("method", info.FullMethod),
+				zap.Any("panic", r),
+				zap.String("stack", string(debug.Stack())))
+			if repanic {
+				panic(r)
+			}
+			err = status.Error(codes.Internal, fmt.Sprintf("Internal server error %s", errID))
+		}()
+		return handler(srv, stream)
+	}
+}
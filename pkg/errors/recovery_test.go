@@ -0,0 +1,108 @@
+package errors
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// panickingHealthServer panics when checking any service other than "".
+type panickingHealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+}
+
+func (*panickingHealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if req.Service != "" {
+		panic("handler bug")
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVING}, nil
+}
+
+func (*panickingHealthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	panic("stream handler bug")
+}
+
+func TestRecoveryInterceptorReportsPanicsAsInternal(t *testing.T) {
+	var (
+		core, logs = observer.New(zapcore.DebugLevel)
+		listener   = bufconn.Listen(1 << 20)
+		s          = grpc.NewServer(grpc.UnaryInterceptor(RecoveryInterceptor(zap.New(core), false)))
+	)
+	grpc_health_v1.RegisterHealthServer(s, &panickingHealthServer{})
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	ctx := context.Background()
+	_, err = client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "panic"})
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	require.Len(t, logs.All(), 1)
+	entry := logs.All()[0].ContextMap()
+	require.Equal(t, "/grpc.health.v1.Health/Check", entry["method"])
+	require.Equal(t, "handler bug", entry["panic"])
+	require.Contains(t, entry["stack"], "panickingHealthServer")
+
+	// The server keeps serving after the panic.
+	resp, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.Status)
+}
+
+func TestRecoveryInterceptorRepanicsWhenConfigured(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	interceptor := RecoveryInterceptor(zap.New(core), true)
+
+	require.PanicsWithValue(t, "handler bug", func() {
+		_, _ = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/test/Method"},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				panic("handler bug")
+			})
+	})
+	require.Len(t, logs.All(), 1)
+}
+
+func TestStreamRecoveryInterceptorReportsPanicsAsInternal(t *testing.T) {
+	var (
+		core, logs = observer.New(zapcore.DebugLevel)
+		listener   = bufconn.Listen(1 << 20)
+		s          = grpc.NewServer(grpc.StreamInterceptor(StreamRecoveryInterceptor(zap.New(core), false)))
+	)
+	grpc_health_v1.RegisterHealthServer(s, &panickingHealthServer{})
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := grpc_health_v1.NewHealthClient(conn).Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Internal, status.Code(err))
+
+	require.Len(t, logs.All(), 1)
+	entry := logs.All()[0].ContextMap()
+	require.Equal(t, "/grpc.health.v1.Health/Watch", entry["method"])
+	require.Equal(t, "stream handler bug", entry["panic"])
+}
@@ -0,0 +1,51 @@
+package gc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"go.uber.org/zap"
+)
+
+// Pass performs one garbage collection cycle.
+type Pass func(ctx context.Context) error
+
+// Collector runs garbage collection passes one at a time, whether they are
+// scheduled or requested by an operator, and marks each in Window if set.
+type Collector struct {
+	Window *Window
+	Clock  clockwork.Clock
+
+	mu sync.Mutex
+}
+
+// Run runs pass once any other pass run by c has completed.
+func (c *Collector) Run(ctx context.Context, pass Pass) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Window != nil {
+		defer c.Window.Begin()()
+	}
+	return pass(ctx)
+}
+
+// RunEvery runs pass every interval until ctx is done. Failed passes are
+// logged to logger and retried at the next interval.
+func (c *Collector) RunEvery(ctx context.Context, interval time.Duration, pass Pass, logger *zap.Logger) {
+	clock := c.Clock
+	if clock == nil {
+		clock = DefaultClock
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-clock.After(interval):
+			if err := c.Run(ctx, pass); err != nil {
+				logger.Warn("Scheduled garbage collection failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCollectorOpensWindowDuringPass(t *testing.T) {
+	c := &Collector{Window: &Window{MaxDuration: time.Minute, Clock: clockwork.NewFakeClock()}}
+	require.NoError(t, c.Run(context.Background(), func(context.Context) error {
+		require.True(t, c.Window.Open())
+		return nil
+	}))
+	require.False(t, c.Window.Open())
+}
+
+func TestCollectorRunsEveryInterval(t *testing.T) {
+	var (
+		ctx, cancel = context.WithCancel(context.Background())
+		clock       = clockwork.NewFakeClock()
+		c           = &Collector{Clock: clock}
+		passes      = make(chan struct{})
+		done        = make(chan struct{})
+	)
+	go func() {
+		c.RunEvery(ctx, time.Minute, func(context.Context) error {
+			passes <- struct{}{}
+			return nil
+		}, zap.NewNop())
+		close(done)
+	}()
+
+	for i := 0; i < 2; i++ {
+		clock.BlockUntil(1)
+		clock.Advance(time.Minute)
+		<-passes
+	}
+	cancel()
+	<-done
+}
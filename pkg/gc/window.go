@@ -0,0 +1,84 @@
+// Package gc coordinates garbage collection of expired DSS entities with the
+// serving path.
+package gc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultClock is used by Windows without a Clock.
+var DefaultClock = clockwork.NewRealClock()
+
+// Window tracks whether a garbage collection batch is currently running.
+// Batches mark their duration with Begin; a Window is considered open while
+// any batch is running, but never for longer than MaxDuration after the most
+// recent Begin, so that a stuck batch cannot keep it open indefinitely.
+type Window struct {
+	MaxDuration time.Duration
+	Clock       clockwork.Clock
+
+	mu      sync.Mutex
+	running int
+	closes  time.Time
+}
+
+func (w *Window) now() time.Time {
+	if w.Clock == nil {
+		return DefaultClock.Now()
+	}
+	return w.Clock.Now()
+}
+
+// Begin opens w for the duration of a garbage collection batch. The returned
+// function must be called once the batch completes.
+func (w *Window) Begin() (end func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.running++
+	w.closes = w.now().Add(w.MaxDuration)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			w.running--
+		})
+	}
+}
+
+// Open returns true if a garbage collection batch is running.
+func (w *Window) Open() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.running > 0 && w.now().Before(w.closes)
+}
+
+// SheddingInterceptor returns a grpc.UnaryServerInterceptor rejecting, with
+// Unavailable and a hint to retry after retryAfter, the operations for which
+// sheddable returns true while w is open.
+func SheddingInterceptor(w *Window, sheddable func(fullMethod string) bool, retryAfter time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !sheddable(info.FullMethod) || !w.Open() {
+			return handler(ctx, req)
+		}
+
+		st := status.New(codes.Unavailable, "Garbage collection in progress; retry later")
+		if withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+			RetryDelay: ptypes.DurationProto(retryAfter),
+		}); err == nil {
+			st = withDetails
+		}
+		return nil, st.Err()
+	}
+}
@@ -0,0 +1,68 @@
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSheddingInterceptorOnlyShedsDuringGC(t *testing.T) {
+	var (
+		clock  = clockwork.NewFakeClock()
+		window = &Window{MaxDuration: time.Minute, Clock: clock}
+		ic     = SheddingInterceptor(window, func(method string) bool {
+			return method == "/svc/Search"
+		}, 5*time.Second)
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+		call = func(method string) error {
+			_, err := ic(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return err
+		}
+	)
+
+	// No GC running.
+	require.NoError(t, call("/svc/Search"))
+
+	end := window.Begin()
+	err := call("/svc/Search")
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	retryDelay, err := ptypes.Duration(details[0].(*errdetails.RetryInfo).RetryDelay)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, retryDelay)
+
+	// Operations not marked sheddable are always served.
+	require.NoError(t, call("/svc/Put"))
+
+	end()
+	require.NoError(t, call("/svc/Search"))
+
+	// A batch that never ends stops shedding once the window's bound passes.
+	window.Begin()
+	require.Error(t, call("/svc/Search"))
+	clock.Advance(time.Minute)
+	require.NoError(t, call("/svc/Search"))
+}
+
+func TestWindowStaysOpenWhileAnyBatchRuns(t *testing.T) {
+	window := &Window{MaxDuration: time.Minute, Clock: clockwork.NewFakeClock()}
+
+	end1 := window.Begin()
+	end2 := window.Begin()
+	end1()
+	end1()
+	require.True(t, window.Open())
+	end2()
+	require.False(t, window.Open())
+}
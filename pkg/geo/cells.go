@@ -0,0 +1,62 @@
+package geo
+
+import (
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+)
+
+// DefaultMaxStoredCells is the default value of MaxStoredCells, several times
+// the number of cells covering a compact area of the maximum allowed size.
+const DefaultMaxStoredCells = 10000
+
+// MaxStoredCells is the largest number of cells a stored resource may cover.
+// If zero, coverings are not limited.
+var MaxStoredCells = DefaultMaxStoredCells
+
+// ValidateStoredCells returns an error with code AreaTooLarge if cells
+// exceeds MaxStoredCells.
+func ValidateStoredCells(cells s2.CellUnion) error {
+	if MaxStoredCells > 0 && len(cells) > MaxStoredCells {
+		return stacktrace.Propagate(ErrAreaTooLarge,
+			"Area is covered by %d cells, more than the %d allowed", len(cells), MaxStoredCells)
+	}
+	return nil
+}
+
+// ValidateCells validates every cell in cells, returning a *dsserr.MultiError
+// with code BadRequest reporting each invalid cell by its token.
+func ValidateCells(cells s2.CellUnion) error {
+	var errs dsserr.MultiError
+	for _, cell := range cells {
+		errs.Add(cell.ToToken(), ValidateCell(cell))
+	}
+	return errs.ErrorOrNil()
+}
+
+// CellsFromTokens parses client-supplied S2 cell tokens, returning a
+// *dsserr.MultiError with code BadRequest reporting each token that is
+// malformed or does not identify a cell usable by this implementation.
+func CellsFromTokens(tokens []string) (s2.CellUnion, error) {
+	var (
+		cells = make(s2.CellUnion, 0, len(tokens))
+		errs  dsserr.MultiError
+	)
+	for _, token := range tokens {
+		// CellIDFromToken maps anything it cannot parse to the invalid cell 0.
+		cell := s2.CellIDFromToken(token)
+		if !cell.IsValid() {
+			errs.Add(token, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Malformed S2 cell token `%s`", token))
+			continue
+		}
+		if err := ValidateCell(cell); err != nil {
+			errs.Add(token, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Unusable S2 cell token `%s`", token))
+			continue
+		}
+		cells = append(cells, cell)
+	}
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+	return cells, nil
+}
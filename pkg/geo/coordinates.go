@@ -0,0 +1,42 @@
+package geo
+
+import (
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+)
+
+// WrapLongitudes defines how longitudes beyond the antimeridian are handled.
+// If false, longitudes outside [-180, 180] are rejected. Otherwise, those
+// within [-360, 360] are wrapped onto [-180, 180], so that an area crossing
+// the antimeridian may be given with continuous longitudes, such as 179 and
+// 181 for 179 and -179.
+var WrapLongitudes = false
+
+// LatLngFromDegrees returns the s2.LatLng at lat and lng, in degrees, or an
+// error with code BadRequest naming the coordinate out of range. Either of -180
+// and 180 designates the antimeridian.
+func LatLngFromDegrees(lat, lng float64) (s2.LatLng, error) {
+	// Comparisons are written so that NaN is out of range.
+	if !(lat >= -90 && lat <= 90) {
+		return s2.LatLng{}, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+			"Latitude %v is outside of [-90, 90]", lat)
+	}
+	if WrapLongitudes && lng >= -360 && lng <= 360 {
+		switch {
+		case lng > 180:
+			lng -= 360
+		case lng < -180:
+			lng += 360
+		}
+	}
+	if !(lng >= -180 && lng <= 180) {
+		if WrapLongitudes {
+			return s2.LatLng{}, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+				"Longitude %v is outside of [-360, 360]", lng)
+		}
+		return s2.LatLng{}, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+			"Longitude %v is outside of [-180, 180]", lng)
+	}
+	return s2.LatLngFromDegrees(lat, lng), nil
+}
@@ -34,4 +34,8 @@ var (
 	// was supposed to contain lat,lng,lat,lng,... contained only lat for its last
 	// coordinate pair.
 	ErrOddNumberOfCoordinatesInAreaString = stacktrace.NewErrorWithCode(dsserr.BadRequest, "Odd number of coordinates in area string")
+
+	// ErrZeroAreaQuery indicates that a query area was a single point or a set
+	// of collinear points, and ZeroAreaQueryBufferMeters is not set.
+	ErrZeroAreaQuery = stacktrace.NewErrorWithCode(dsserr.BadRequest, "Query area encloses no area")
 )
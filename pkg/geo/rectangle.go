@@ -0,0 +1,78 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/golang/geo/r1"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"github.com/interuss/stacktrace"
+)
+
+// maxRectangleEdgeDeviationMeters is how far the edges of a rectangle may
+// stray from its bounds for it to be covered as an s2.Rect.
+const maxRectangleEdgeDeviationMeters = 1.0
+
+// rectangleBounds returns the bounds of the area delimited by latLngs if it
+// is a rectangle whose edges lie along lines of latitude and longitude, and
+// which spans less than half of the globe in longitude so that its extent is
+// not ambiguous.
+//
+// The edges of the area are geodesics, so that those between corners of the
+// same latitude bow towards the pole rather than following the line of
+// latitude bounding an s2.Rect. Rectangles are only reported if they are
+// small enough for their edges to stay within
+// maxRectangleEdgeDeviationMeters of their bounds, and their bounds are
+// expanded in latitude to contain their edges.
+func rectangleBounds(latLngs []s2.LatLng) (s2.Rect, bool) {
+	if len(latLngs) != 4 {
+		return s2.EmptyRect(), false
+	}
+	var (
+		lats = map[s1.Angle]bool{}
+		lngs = map[s1.Angle]bool{}
+		rect = s2.EmptyRect()
+	)
+	for i, ll := range latLngs {
+		next := latLngs[(i+1)%len(latLngs)]
+		// Consecutive corners must share either their latitude or their
+		// longitude, but not both.
+		if (ll.Lat == next.Lat) == (ll.Lng == next.Lng) {
+			return s2.EmptyRect(), false
+		}
+		lats[ll.Lat] = true
+		lngs[ll.Lng] = true
+		rect = rect.AddPoint(ll)
+	}
+	if len(lats) != 2 || len(lngs) != 2 || rect.Lng.Length() >= math.Pi {
+		return s2.EmptyRect(), false
+	}
+	deviation := math.Max(
+		edgeDeviation(rect.Lat.Lo, rect.Lng.Length()),
+		edgeDeviation(rect.Lat.Hi, rect.Lng.Length()))
+	if deviation > DistanceMetersToAngle(maxRectangleEdgeDeviationMeters).Radians() {
+		return s2.EmptyRect(), false
+	}
+	rect.Lat = r1.Interval{
+		Lo: math.Max(rect.Lat.Lo-deviation, -math.Pi/2),
+		Hi: math.Min(rect.Lat.Hi+deviation, math.Pi/2),
+	}
+	return rect, true
+}
+
+// edgeDeviation returns the angle by which the geodesic between two points at
+// latitude lat and lngSpan radians of longitude apart strays from lat.
+func edgeDeviation(lat float64, lngSpan float64) float64 {
+	lat = math.Abs(lat)
+	return math.Atan(math.Tan(lat)/math.Cos(lngSpan/2)) - lat
+}
+
+// rectangleCovering calculates the S2 covering of rect without building the
+// loop required to cover an arbitrary polygon.
+func rectangleCovering(rect s2.Rect) (s2.CellUnion, error) {
+	if areaKm2 := rect.Area() * earthAreaKm2 / (4.0 * math.Pi); areaKm2 > maxAllowedAreaKm2 {
+		return nil, stacktrace.Propagate(
+			ErrAreaTooLarge, "Area is too large (%fkm² > %fkm²)", areaKm2, maxAllowedAreaKm2)
+	}
+	return RegionCoverer.Covering(rect), nil
+}
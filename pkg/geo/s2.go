@@ -9,6 +9,7 @@ import (
 
 	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/stacktrace"
 )
 
@@ -44,9 +45,14 @@ func Levelify(cells *s2.CellUnion) {
 	cells.Denormalize(DefaultMinimumCellLevel, 1)
 }
 
+// ValidateCell returns an error with code BadRequest if cell is not a valid
+// cell usable by this implementation.
 func ValidateCell(cell s2.CellID) error {
+	if !cell.IsValid() {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid S2 cell ID %d", uint64(cell))
+	}
 	if cell.Level() < DefaultMinimumCellLevel || cell.Level() > DefaultMaximumCellLevel {
-		return stacktrace.NewError("Cells must be at level 13 at current implementation")
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Cells must be at level 13 at current implementation")
 	}
 	return nil
 }
@@ -121,13 +127,48 @@ func Covering(points []s2.Point) (s2.CellUnion, error) {
 // * ErrOddNumberOfCoordinatesInAreaString
 // * ErrNotEnoughPointsInPolygon
 // * ErrBadCoordSet
+// * ErrZeroAreaQuery
 //
 // TODO(tvoss):
 //   * Agree and implement a maximum number of points in area
 func AreaToCellIDs(area string) (s2.CellUnion, error) {
+	latLngs, err := parseArea(area)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	if rect, ok := rectangleBounds(latLngs); ok {
+		return rectangleCovering(rect)
+	}
+	return queryCovering(pointsFromLatLngs(latLngs))
+}
+
+// AreaKm2 returns the area in km² of the polygon described by "area", in the
+// format of AreaToCellIDs, or any of the errors of AreaToCellIDs parsing it.
+// The polygon is measured on the sphere, so that one crossing the antimeridian
+// is measured like any other, and, as the orientation of its vertices is not
+// specified, the smaller of the two regions it delimits is measured.
+func AreaKm2(area string) (float64, error) {
+	latLngs, err := parseArea(area)
+	if err != nil {
+		return 0, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	steradians := s2.LoopFromPoints(pointsFromLatLngs(latLngs)).Area()
+	return math.Min(steradians, 4*math.Pi-steradians) * earthAreaKm2 / (4.0 * math.Pi), nil
+}
+
+func pointsFromLatLngs(latLngs []s2.LatLng) []s2.Point {
+	points := make([]s2.Point, len(latLngs))
+	for i, ll := range latLngs {
+		points[i] = s2.PointFromLatLng(ll)
+	}
+	return points
+}
+
+// parseArea parses the vertices of "area" in the format of AreaToCellIDs.
+func parseArea(area string) ([]s2.LatLng, error) {
 	var (
 		lat, lng float64
-		points   = []s2.Point{}
+		latLngs  = []s2.LatLng{}
 		counter  = 0
 		scanner  = bufio.NewScanner(strings.NewReader(area))
 	)
@@ -155,10 +196,14 @@ func AreaToCellIDs(area string) (s2.CellUnion, error) {
 				return nil, stacktrace.Propagate(ErrBadCoordSet, "Unable to parse lng: %s", err.Error())
 			}
 			lng = f
-			points = append(points, s2.PointFromLatLng(s2.LatLngFromDegrees(lat, lng)))
+			ll, err := LatLngFromDegrees(lat, lng)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "Invalid vertex %d", len(latLngs))
+			}
+			latLngs = append(latLngs, ll)
 		}
 
 		counter++
 	}
-	return Covering(points)
+	return latLngs, nil
 }
@@ -1,10 +1,14 @@
 package geo_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/dss/pkg/geo"
 	"github.com/interuss/dss/pkg/geo/testdata"
+	"github.com/interuss/stacktrace"
 
 	"github.com/stretchr/testify/require"
 )
@@ -50,3 +54,265 @@ func TestParseAreaFailsForLoopWithOddNumberOfCoordinates(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, cells)
 }
+
+func TestParseAreaRejectsLatitudeOutOfRange(t *testing.T) {
+	for _, area := range []string{
+		`90.5,-122.1474,37.4037,-122.1485,37.4035,-122.1466`,
+		`37.4047,-122.1474,-91,-122.1485,37.4035,-122.1466`,
+		`37.4047,-122.1474,37.4037,-122.1485,NaN,-122.1466`,
+	} {
+		cells, err := geo.AreaToCellIDs(area)
+		require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err), area)
+		require.Contains(t, stacktrace.RootCause(err).Error(), "Latitude", area)
+		require.Nil(t, cells)
+	}
+}
+
+func TestParseAreaRejectsLongitudeOutOfRange(t *testing.T) {
+	cells, err := geo.AreaToCellIDs(`0.0,179.99,0.005,180.01,-0.005,180.0`)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	require.Equal(t, "Longitude 180.01 is outside of [-180, 180]", stacktrace.RootCause(err).Error())
+	require.Nil(t, cells)
+
+	// Both -180 and 180 designate the antimeridian.
+	east, err := geo.AreaToCellIDs(`0.0,179.99,0.005,180,-0.005,180`)
+	require.NoError(t, err)
+	west, err := geo.AreaToCellIDs(`0.0,179.99,0.005,-180,-0.005,-180`)
+	require.NoError(t, err)
+	require.Equal(t, east, west)
+
+	// Once wrapped, longitudes beyond the antimeridian cover the same area as
+	// their equivalents within [-180, 180].
+	defer func(previous bool) { geo.WrapLongitudes = previous }(geo.WrapLongitudes)
+	geo.WrapLongitudes = true
+	wrapped, err := geo.AreaToCellIDs(`0.0,179.99,0.005,180.01,-0.005,180.0`)
+	require.NoError(t, err)
+	unwrapped, err := geo.AreaToCellIDs(`0.0,179.99,0.005,-179.99,-0.005,180.0`)
+	require.NoError(t, err)
+	require.Equal(t, unwrapped, wrapped)
+
+	_, err = geo.AreaToCellIDs(`0.0,179.99,0.005,360.01,-0.005,180.0`)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	require.Equal(t, "Longitude 360.01 is outside of [-360, 360]", stacktrace.RootCause(err).Error())
+}
+
+func TestValidateCellsReportsEveryInvalidCell(t *testing.T) {
+	var (
+		valid   = s2.CellIDFromLatLng(s2.LatLngFromDegrees(37.4047, -122.1474)).Parent(13)
+		tooBig  = valid.Parent(10)
+		tooDeep = valid.ChildBegin().ChildBegin()
+	)
+
+	require.NoError(t, geo.ValidateCells(s2.CellUnion{valid}))
+
+	err := geo.ValidateCells(s2.CellUnion{tooBig, valid, tooDeep})
+	require.Error(t, err)
+	multi, ok := err.(*dsserr.MultiError)
+	require.True(t, ok)
+	require.Len(t, multi.Items, 2)
+	require.Equal(t, tooBig.ToToken(), multi.Items[0].Item)
+	require.Equal(t, tooDeep.ToToken(), multi.Items[1].Item)
+	require.Equal(t, dsserr.BadRequest, multi.Code())
+}
+
+func TestCellsFromTokensRejectsMalformedTokens(t *testing.T) {
+	var (
+		valid   = s2.CellIDFromLatLng(s2.LatLngFromDegrees(37.4047, -122.1474)).Parent(13)
+		tooDeep = valid.ChildBegin()
+	)
+
+	cells, err := geo.CellsFromTokens([]string{valid.ToToken()})
+	require.NoError(t, err)
+	require.Equal(t, s2.CellUnion{valid}, cells)
+
+	cells, err = geo.CellsFromTokens([]string{"not-a-cell", valid.ToToken(), tooDeep.ToToken(), ""})
+	require.Error(t, err)
+	require.Nil(t, cells)
+	multi, ok := err.(*dsserr.MultiError)
+	require.True(t, ok)
+	require.Equal(t, dsserr.BadRequest, multi.Code())
+	require.Len(t, multi.Items, 3)
+	require.Equal(t, "not-a-cell", multi.Items[0].Item)
+	require.Contains(t, multi.Items[0].Err.Error(), "`not-a-cell`")
+	require.Equal(t, tooDeep.ToToken(), multi.Items[1].Item)
+	require.Equal(t, "", multi.Items[2].Item)
+}
+
+func TestPointQueryRejectedByDefault(t *testing.T) {
+	_, err := geo.AreaToCellIDs(`37.4047,-122.1474,37.4047,-122.1474,37.4047,-122.1474`)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+}
+
+func TestPointQueryBufferedWhenConfigured(t *testing.T) {
+	defer func(previous float64) { geo.ZeroAreaQueryBufferMeters = previous }(geo.ZeroAreaQueryBufferMeters)
+	geo.ZeroAreaQueryBufferMeters = 2000
+
+	var (
+		point = s2.LatLngFromDegrees(37.4047, -122.1474)
+		cell  = s2.CellIDFromLatLng(point).Parent(geo.DefaultMinimumCellLevel)
+	)
+	cells, err := geo.AreaToCellIDs(`37.4047,-122.1474,37.4047,-122.1474,37.4047,-122.1474`)
+	require.NoError(t, err)
+	// A 2km buffer spans more than the ~1km² cell containing the point.
+	require.Greater(t, len(cells), 1)
+	require.True(t, cells.ContainsCellID(cell))
+	for _, c := range cells {
+		require.NoError(t, geo.ValidateCell(c))
+	}
+
+	geo.ZeroAreaQueryBufferMeters = 30000
+	_, err = geo.AreaToCellIDs(`37.4047,-122.1474,37.4047,-122.1474,37.4047,-122.1474`)
+	require.Error(t, err)
+	require.Equal(t, dsserr.AreaTooLarge, stacktrace.GetCode(err))
+}
+
+const rectangleArea = `37.40,-122.15,37.40,-122.13,37.42,-122.13,37.42,-122.15`
+
+func rectanglePoints() []s2.Point {
+	return []s2.Point{
+		s2.PointFromLatLng(s2.LatLngFromDegrees(37.40, -122.15)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(37.40, -122.13)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(37.42, -122.13)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(37.42, -122.15)),
+	}
+}
+
+func TestAreaToCellIDsRectangleMatchesPolygonCovering(t *testing.T) {
+	polygon, err := geo.Covering(rectanglePoints())
+	require.NoError(t, err)
+
+	for _, area := range []string{
+		rectangleArea,
+		// Clockwise corners describe the same rectangle.
+		`37.42,-122.15,37.42,-122.13,37.40,-122.13,37.40,-122.15`,
+	} {
+		cells, err := geo.AreaToCellIDs(area)
+		require.NoError(t, err)
+		require.Equal(t, polygon, cells)
+	}
+}
+
+func TestAreaToCellIDsRectangleMatchesPolygonCoveringAnywhere(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		latLo, latHi float64
+		lngLo, lngHi float64
+	}{
+		{"small", 60, 60.01, 10, 10.02},
+		{"small at the equator", -0.2, 0.2, 0, 0.4},
+		// The edges of these rectangles bow tens of meters away from their
+		// bounds, towards the pole.
+		{"large", 45, 45.4, 0, 0.5},
+		{"large in the southern hemisphere", -45.4, -45, 0, 0.5},
+		{"large at high latitude", 70, 70.2, 20, 21},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			polygon, err := geo.Covering([]s2.Point{
+				s2.PointFromLatLng(s2.LatLngFromDegrees(tc.latLo, tc.lngLo)),
+				s2.PointFromLatLng(s2.LatLngFromDegrees(tc.latLo, tc.lngHi)),
+				s2.PointFromLatLng(s2.LatLngFromDegrees(tc.latHi, tc.lngHi)),
+				s2.PointFromLatLng(s2.LatLngFromDegrees(tc.latHi, tc.lngLo)),
+			})
+			require.NoError(t, err)
+
+			cells, err := geo.AreaToCellIDs(fmt.Sprintf("%f,%f,%f,%f,%f,%f,%f,%f",
+				tc.latLo, tc.lngLo, tc.latLo, tc.lngHi, tc.latHi, tc.lngHi, tc.latHi, tc.lngLo))
+			require.NoError(t, err)
+			require.Equal(t, polygon, cells)
+		})
+	}
+}
+
+func TestAreaToCellIDsRectangleTooLarge(t *testing.T) {
+	_, err := geo.AreaToCellIDs(`30,-120,30,-110,40,-110,40,-120`)
+	require.Error(t, err)
+	require.Equal(t, dsserr.AreaTooLarge, stacktrace.GetCode(err))
+}
+
+func TestAreaKm2(t *testing.T) {
+	// A degree of latitude or longitude at the equator is about 111.2km.
+	const squareDegreeKm2 = 12364.0
+
+	for _, area := range []string{
+		`-0.5,-0.5,-0.5,0.5,0.5,0.5,0.5,-0.5`,
+		// Clockwise vertices delimit the same polygon.
+		`0.5,-0.5,0.5,0.5,-0.5,0.5,-0.5,-0.5`,
+		// As does a polygon crossing the antimeridian.
+		`-0.5,179.5,-0.5,-179.5,0.5,-179.5,0.5,179.5`,
+	} {
+		km2, err := geo.AreaKm2(area)
+		require.NoError(t, err)
+		require.InDelta(t, squareDegreeKm2, km2, 20)
+	}
+
+	_, err := geo.AreaKm2(testdata.LoopWithOnlyTwoPoints)
+	require.Error(t, err)
+}
+
+func TestAreasCrossingAntimeridianOverlap(t *testing.T) {
+	// Coverings are computed on the sphere, so that areas crossing the
+	// antimeridian need no special handling; these areas overlap both their
+	// western and eastern neighbours.
+	var (
+		west = s2.CellIDFromLatLng(s2.LatLngFromDegrees(60.05, 179.5)).Parent(geo.DefaultMinimumCellLevel)
+		east = s2.CellIDFromLatLng(s2.LatLngFromDegrees(60.05, -179.5)).Parent(geo.DefaultMinimumCellLevel)
+	)
+	westOnly, err := geo.AreaToCellIDs(`60,179.2,60,179.8,60.1,179.8,60.1,179.2`)
+	require.NoError(t, err)
+	eastOnly, err := geo.AreaToCellIDs(`60,-179.8,60,-179.2,60.1,-179.2,60.1,-179.8`)
+	require.NoError(t, err)
+
+	for _, area := range []string{
+		// Rectangle.
+		`60,179,60,-179,60.1,-179,60.1,179`,
+		// Clockwise corners describe the same rectangle.
+		`60,179,60.1,179,60.1,-179,60,-179`,
+		// Arbitrary polygon.
+		`60,179,60,-179,60.1,-179.5,60.1,179`,
+		// Vertices on the antimeridian, given as either of 180 and -180.
+		`60,179,60,180,60,-179,60.1,-179,60.1,-180,60.1,179`,
+	} {
+		cells, err := geo.AreaToCellIDs(area)
+		require.NoError(t, err, area)
+		require.True(t, cells.ContainsCellID(west), area)
+		require.True(t, cells.ContainsCellID(east), area)
+		require.True(t, cells.Intersects(westOnly), area)
+		require.True(t, cells.Intersects(eastOnly), area)
+	}
+}
+
+func BenchmarkAreaToCellIDsRectangle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := geo.AreaToCellIDs(rectangleArea); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCoveringRectangleAsPolygon(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := geo.Covering(rectanglePoints()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestValidateStoredCellsRejectsCoveringsBeyondLimit(t *testing.T) {
+	defer func(previous int) { geo.MaxStoredCells = previous }(geo.MaxStoredCells)
+
+	cells, err := geo.Covering(rectanglePoints())
+	require.NoError(t, err)
+	require.NoError(t, geo.ValidateStoredCells(cells))
+
+	geo.MaxStoredCells = len(cells)
+	require.NoError(t, geo.ValidateStoredCells(cells))
+
+	geo.MaxStoredCells = len(cells) - 1
+	err = geo.ValidateStoredCells(cells)
+	require.Error(t, err)
+	require.Equal(t, dsserr.AreaTooLarge, stacktrace.GetCode(err))
+
+	geo.MaxStoredCells = 0
+	require.NoError(t, geo.ValidateStoredCells(cells))
+}
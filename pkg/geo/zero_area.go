@@ -0,0 +1,42 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/stacktrace"
+)
+
+// ZeroAreaQueryBufferMeters defines how query areas enclosing no area, i.e. a
+// single point or collinear points, are interpreted. If zero, such queries
+// are rejected with ErrZeroAreaQuery. Otherwise, such a query covers the line
+// connecting its points along with a circle of this radius around each point.
+var ZeroAreaQueryBufferMeters = 0.0
+
+// queryCovering calculates the S2 covering of the query area delimited by
+// points.
+func queryCovering(points []s2.Point) (s2.CellUnion, error) {
+	if loopAreaKm2(s2.LoopFromPoints(points)) > 0 {
+		return Covering(points)
+	}
+	if ZeroAreaQueryBufferMeters <= 0 {
+		return nil, ErrZeroAreaQuery
+	}
+
+	var (
+		radius = DistanceMetersToAngle(ZeroAreaQueryBufferMeters)
+		pl     = s2.Polyline(points)
+		cells  = RegionCoverer.Covering(&pl)
+	)
+	for _, point := range points {
+		buffer := s2.CapFromCenterAngle(point, radius)
+		if areaKm2 := buffer.Area() * earthAreaKm2 / (4.0 * math.Pi); areaKm2 > maxAllowedAreaKm2 {
+			return nil, stacktrace.Propagate(
+				ErrAreaTooLarge, "Buffered point area is too large (%fkm² > %fkm²)", areaKm2, maxAllowedAreaKm2)
+		}
+		cells = append(cells, RegionCoverer.Covering(buffer)...)
+	}
+	cells.Normalize()
+	Levelify(&cells)
+	return cells, nil
+}
@@ -0,0 +1,67 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// clfTimeFormat is the timestamp layout of the Common Log Format.
+const clfTimeFormat = "02/Jan/2006:15:04:05 -0700"
+
+// AccessLogMiddleware returns an http.Handler serving requests with handler
+// and writing one line per request to out in the Combined Log Format,
+// followed by the time taken to serve the request in microseconds:
+//
+//	peer - - [time] "METHOD /path PROTO" status bytes "referer" "user agent" duration
+//
+// Errors writing to out are ignored.
+func AccessLogMiddleware(out io.Writer, handler http.Handler) http.Handler {
+	var mu sync.Mutex
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var (
+			start = time.Now()
+			trw   = &tracingResponseWriter{
+				next: w,
+			}
+		)
+
+		handler.ServeHTTP(trw, r)
+
+		line := formatAccessLogLine(r, trw.statusCode, trw.bytes, start, time.Since(start))
+		mu.Lock()
+		defer mu.Unlock()
+		io.WriteString(out, line)
+	})
+}
+
+func formatAccessLogLine(r *http.Request, status, bytes int, start time.Time, duration time.Duration) string {
+	peer, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		peer = r.RemoteAddr
+	}
+	if status == 0 {
+		// Nothing was written, which net/http serves as an empty 200.
+		status = http.StatusOK
+	}
+	size := "-"
+	if bytes > 0 {
+		size = strconv.Itoa(bytes)
+	}
+	return fmt.Sprintf("%s - - [%s] %q %d %s %q %q %d\n",
+		orDash(peer), start.Format(clfTimeFormat),
+		r.Method+" "+r.URL.RequestURI()+" "+r.Proto,
+		status, size, orDash(r.Referer()), orDash(r.UserAgent()),
+		duration.Microseconds())
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,44 @@
+package logging
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessLogLineFormat(t *testing.T) {
+	var (
+		out     bytes.Buffer
+		handler = AccessLogMiddleware(&out, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/empty" {
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("not here"))
+		}))
+	)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/dss/identification_service_areas?area=1,2", nil)
+	r.RemoteAddr = "10.0.0.7:53211"
+	r.Header.Set("User-Agent", "uss/1.0")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	r = httptest.NewRequest(http.MethodPut, "/empty", nil)
+	r.RemoteAddr = "10.0.0.8:53212"
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+
+	lines := bytes.Split(bytes.TrimSuffix(out.Bytes(), []byte("\n")), []byte("\n"))
+	require.Len(t, lines, 2)
+	require.Regexp(t, regexp.MustCompile(
+		`^10\.0\.0\.7 - - \[\d{2}/\w{3}/\d{4}:\d{2}:\d{2}:\d{2} [+-]\d{4}\] `+
+			`"GET /v1/dss/identification_service_areas\?area=1,2 HTTP/1\.1" 404 8 "-" "uss/1\.0" \d+$`),
+		string(lines[0]))
+	// Empty responses are reported as 200 with no bytes.
+	require.Regexp(t, regexp.MustCompile(
+		`^10\.0\.0\.8 - - \[[^]]+\] "PUT /empty HTTP/1\.1" 200 - "-" "-" \d+$`),
+		string(lines[1]))
+}
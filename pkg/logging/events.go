@@ -0,0 +1,176 @@
+package logging
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Events captures the entries written by every logger once its capacity is
+// set, so that operators can follow them without access to the logs.
+var Events = &EventHub{}
+
+// Event is a log entry captured by an EventHub.
+type Event struct {
+	Time    time.Time
+	Level   zapcore.Level
+	Logger  string
+	Message string
+	// Entry is the complete entry, with its fields, as written to the log.
+	Entry string
+	// Dropped is the number of events that were not delivered to a
+	// subscription before this one because it fell behind.
+	Dropped int
+}
+
+// EventHub keeps the most recent log events and delivers new ones to its
+// subscriptions. Both the recent events and the pending events of each
+// subscription are limited to its capacity; an EventHub without capacity
+// captures nothing.
+type EventHub struct {
+	mu            sync.Mutex
+	capacity      int
+	recent        []Event
+	next          int
+	subscriptions map[*EventSubscription]bool
+}
+
+// EventSubscription receives the events logged at or above its level on C.
+type EventSubscription struct {
+	C <-chan Event
+
+	hub     *EventHub
+	level   zapcore.Level
+	c       chan Event
+	dropped int
+}
+
+// SetCapacity sets the number of recent events h keeps, and the number of
+// events each subscription may fall behind by before events are dropped.
+// Recent events are discarded.
+func (h *EventHub) SetCapacity(capacity int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.capacity = capacity
+	h.recent = nil
+	h.next = 0
+}
+
+// Enabled returns true if h captures events.
+func (h *EventHub) Enabled() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.capacity > 0
+}
+
+// Subscribe returns the recent events at or above level, oldest first, along
+// with a subscription to the events logged afterwards. Callers must Close the
+// subscription once done.
+func (h *EventHub) Subscribe(level zapcore.Level) ([]Event, *EventSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var recent []Event
+	for i := range h.recent {
+		if e := h.recent[(h.next+i)%len(h.recent)]; e.Level >= level {
+			recent = append(recent, e)
+		}
+	}
+
+	c := make(chan Event, h.capacity)
+	s := &EventSubscription{C: c, hub: h, level: level, c: c}
+	if h.subscriptions == nil {
+		h.subscriptions = map[*EventSubscription]bool{}
+	}
+	h.subscriptions[s] = true
+	return recent, s
+}
+
+// Close stops delivering events to s.
+func (s *EventSubscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	delete(s.hub.subscriptions, s)
+}
+
+func (h *EventHub) publish(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.capacity <= 0 {
+		return
+	}
+
+	if len(h.recent) < h.capacity {
+		h.recent = append(h.recent, e)
+	} else {
+		h.recent[h.next] = e
+		h.next = (h.next + 1) % h.capacity
+	}
+
+	for s := range h.subscriptions {
+		if e.Level < s.level {
+			continue
+		}
+		delivered := e
+		delivered.Dropped = s.dropped
+		select {
+		case s.c <- delivered:
+			s.dropped = 0
+		default:
+			s.dropped++
+		}
+	}
+}
+
+// core returns a zapcore.Core publishing the entries enabled by level to h,
+// encoded using config.
+func (h *EventHub) core(level zapcore.LevelEnabler, config zapcore.EncoderConfig) zapcore.Core {
+	return &eventCore{
+		LevelEnabler: level,
+		hub:          h,
+		encoder:      zapcore.NewJSONEncoder(config),
+	}
+}
+
+type eventCore struct {
+	zapcore.LevelEnabler
+	hub     *EventHub
+	encoder zapcore.Encoder
+}
+
+func (c *eventCore) With(fields []zapcore.Field) zapcore.Core {
+	encoder := c.encoder.Clone()
+	for _, field := range fields {
+		field.AddTo(encoder)
+	}
+	return &eventCore{LevelEnabler: c.LevelEnabler, hub: c.hub, encoder: encoder}
+}
+
+func (c *eventCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) && c.hub.Enabled() {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *eventCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	buf, err := c.encoder.EncodeEntry(entry, fields)
+	if err != nil {
+		return err
+	}
+	defer buf.Free()
+	c.hub.publish(Event{
+		Time:    entry.Time,
+		Level:   entry.Level,
+		Logger:  entry.LoggerName,
+		Message: entry.Message,
+		Entry:   strings.TrimSuffix(buf.String(), "\n"),
+	})
+	return nil
+}
+
+func (c *eventCore) Sync() error {
+	return nil
+}
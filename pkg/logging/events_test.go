@@ -0,0 +1,58 @@
+package logging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestEventHubKeepsRecentEventsAndReportsDropped(t *testing.T) {
+	var (
+		hub    = &EventHub{}
+		logger = zap.New(hub.core(zapcore.DebugLevel, zap.NewProductionEncoderConfig()))
+	)
+
+	// Nothing is captured without capacity.
+	logger.Info("discarded")
+	hub.SetCapacity(2)
+	recent, subscription := hub.Subscribe(zapcore.DebugLevel)
+	require.Empty(t, recent)
+	subscription.Close()
+
+	logger.Info("first")
+	logger.Debug("second")
+	logger.Warn("third", zap.String("key", "value"))
+
+	// Only the most recent events are kept, oldest first.
+	recent, subscription = hub.Subscribe(zapcore.DebugLevel)
+	defer subscription.Close()
+	require.Len(t, recent, 2)
+	require.Equal(t, "second", recent[0].Message)
+	require.Equal(t, "third", recent[1].Message)
+	require.Equal(t, zapcore.WarnLevel, recent[1].Level)
+	require.Contains(t, recent[1].Entry, `"key":"value"`)
+
+	// Events below the level of a subscription are not delivered to it.
+	recent, infoSubscription := hub.Subscribe(zapcore.InfoLevel)
+	defer infoSubscription.Close()
+	require.Len(t, recent, 1)
+
+	// A subscription that falls behind by more than the capacity misses
+	// events, and learns how many with the next one delivered.
+	for _, message := range []string{"a", "b", "c", "d", "e"} {
+		logger.Info(message)
+	}
+	require.Equal(t, "a", (<-subscription.C).Message)
+	require.Equal(t, "b", (<-subscription.C).Message)
+	logger.Info("f")
+	event := <-subscription.C
+	require.Equal(t, "f", event.Message)
+	require.Equal(t, 3, event.Dropped)
+
+	// Closed subscriptions receive nothing more.
+	subscription.Close()
+	logger.Info("g")
+	require.Len(t, subscription.C, 0)
+}
@@ -11,6 +11,7 @@ import (
 type tracingResponseWriter struct {
 	next       http.ResponseWriter
 	statusCode int
+	bytes      int
 }
 
 func (w *tracingResponseWriter) Header() http.Header {
@@ -24,7 +25,9 @@ func (w *tracingResponseWriter) Write(data []byte) (int, error) {
 	if w.statusCode == 0 {
 		w.statusCode = 200
 	}
-	return w.next.Write(data)
+	n, err := w.next.Write(data)
+	w.bytes += n
+	return n, err
 }
 
 func (w *tracingResponseWriter) WriteHeader(statusCode int) {
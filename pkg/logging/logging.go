@@ -25,6 +25,10 @@ var (
 	FormatJSON = "json"
 	// Logger is the default, system-wide logger.
 	Logger *zap.Logger
+
+	// serviceLoggers holds the loggers of the logical services whose level
+	// overrides the default.
+	serviceLoggers = map[string]*zap.Logger{}
 )
 
 func init() {
@@ -40,17 +44,34 @@ func init() {
 		format = v
 	}
 
-	if err := setUpLogger(level, format); err != nil {
+	if err := setUpLogger(level, format, nil); err != nil {
 		panic(err)
 	}
 }
 
-func setUpLogger(level string, format string) error {
+func setUpLogger(level string, format string, serviceLevels map[string]string) error {
 	lvl := DefaultLevel
 	if err := lvl.UnmarshalText([]byte(level)); err != nil {
 		return err
 	}
 
+	// The underlying core must admit the most verbose level requested by any
+	// service; each logger then raises it to its own level.
+	var (
+		minLevel  = lvl.Level()
+		overrides = map[string]zapcore.Level{}
+	)
+	for service, serviceLevel := range serviceLevels {
+		var l zapcore.Level
+		if err := l.UnmarshalText([]byte(serviceLevel)); err != nil {
+			return err
+		}
+		overrides[service] = l
+		if l < minLevel {
+			minLevel = l
+		}
+	}
+
 	options := []zap.Option{
 		zap.AddCaller(), zap.AddStacktrace(zapcore.PanicLevel),
 	}
@@ -62,35 +83,63 @@ func setUpLogger(level string, format string) error {
 
 	config := zap.NewProductionConfig()
 	config.Level = lvl
+	if minLevel < lvl.Level() {
+		config.Level = zap.NewAtomicLevelAt(minLevel)
+	}
 	config.Encoding = format
 	config.EncoderConfig = encoderConfig
+	options = append(options, zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(core, Events.core(config.Level, encoderConfig))
+	}))
 
-	l, err := config.Build(options...)
+	base, err := config.Build(options...)
 	if err != nil {
 		return err
 	}
 
-	Logger = l
+	Logger = base
+	if minLevel < lvl.Level() {
+		Logger = base.WithOptions(zap.IncreaseLevel(lvl))
+	}
+	serviceLoggers = map[string]*zap.Logger{}
+	for service, l := range overrides {
+		serviceLoggers[service] = base.WithOptions(zap.IncreaseLevel(l)).Named(service)
+	}
 	// Make sure that log statements internal to gRPC library are logged using the Logger as well.
 	grpcReplaceLogger(Logger)
 
 	return nil
 }
 
-// Configure configures the default log "level" and the log "format".
-func Configure(level string, format string) error {
-	return setUpLogger(level, format)
+// Configure configures the default log "level" and the log "format", along
+// with the levels overriding the default for the logical services named in
+// "serviceLevels".
+func Configure(level string, format string, serviceLevels map[string]string) error {
+	return setUpLogger(level, format, serviceLevels)
+}
+
+// ForService returns the logger of the logical "service", which logs at the
+// level configured for it, or at the default level if none was.
+func ForService(service string) *zap.Logger {
+	if l, ok := serviceLoggers[service]; ok {
+		return l
+	}
+	return Logger.Named(service)
 }
 
 // Interceptor returns a grpc.UnaryServerInterceptor that logs incoming requests
-// and associated tags to "logger".
-func Interceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+// and associated tags to "logger". Request logs below error level are sampled
+// according to "sampling". Only requests failing with a code logged at error
+// level, such as Internal or Unknown, are always logged: those failing with
+// client errors such as InvalidArgument or PermissionDenied are logged at
+// info or warn level, and are sampled along with successful requests.
+func Interceptor(logger *zap.Logger, sampling SamplingConfig) grpc.UnaryServerInterceptor {
 	opts := []grpc_zap.Option{
 		grpc_zap.WithLevels(grpc_zap.DefaultCodeToLevel),
 	}
 	return grpc_middleware.ChainUnaryServer(
 		grpc_ctxtags.UnaryServerInterceptor(grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor)),
-		grpc_zap.UnaryServerInterceptor(logger, opts...),
+		grpc_zap.UnaryServerInterceptor(WithSampling(logger, sampling), opts...),
 	)
 }
 
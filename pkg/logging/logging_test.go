@@ -0,0 +1,31 @@
+package logging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestConfigureAppliesServiceLevels(t *testing.T) {
+	require.NoError(t, Configure("info", FormatJSON, map[string]string{"scd": "debug"}))
+	defer func() {
+		require.NoError(t, Configure("info", FormatJSON, nil))
+	}()
+
+	var (
+		scd = ForService("scd")
+		rid = ForService("rid")
+	)
+	require.True(t, scd.Core().Enabled(zapcore.DebugLevel))
+	require.False(t, rid.Core().Enabled(zapcore.DebugLevel))
+	require.True(t, rid.Core().Enabled(zapcore.InfoLevel))
+	require.False(t, Logger.Core().Enabled(zapcore.DebugLevel))
+
+	require.NotNil(t, scd.Check(zapcore.DebugLevel, "debug"))
+	require.Nil(t, rid.Check(zapcore.DebugLevel, "debug"))
+}
+
+func TestConfigureRejectsInvalidServiceLevel(t *testing.T) {
+	require.Error(t, Configure("info", FormatJSON, map[string]string{"scd": "chatty"}))
+}
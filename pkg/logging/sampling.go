@@ -0,0 +1,61 @@
+package logging
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const samplingTick = time.Second
+
+// SamplingConfig describes how log entries below zapcore.ErrorLevel are
+// sampled: within every second, the first Initial entries with a given level
+// and message are logged, and every Thereafter-th entry after that.
+//
+// Entries at zapcore.ErrorLevel or above are never sampled.
+type SamplingConfig struct {
+	Initial    int
+	Thereafter int
+}
+
+// Enabled returns true if c describes actual sampling.
+func (c SamplingConfig) Enabled() bool {
+	return c.Initial > 0 && c.Thereafter > 0
+}
+
+// errorPreservingCore dispatches entries below zapcore.ErrorLevel to a sampled
+// core and everything else to the unsampled core it embeds.
+type errorPreservingCore struct {
+	zapcore.Core
+	sampled zapcore.Core
+}
+
+func (c *errorPreservingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &errorPreservingCore{
+		Core:    c.Core.With(fields),
+		sampled: c.sampled.With(fields),
+	}
+}
+
+func (c *errorPreservingCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if ent.Level >= zapcore.ErrorLevel {
+		return c.Core.Check(ent, ce)
+	}
+	return c.sampled.Check(ent, ce)
+}
+
+// WithSampling returns a logger derived from "logger" that samples entries
+// below zapcore.ErrorLevel according to "config". "logger" is returned
+// unchanged if "config" does not enable sampling.
+func WithSampling(logger *zap.Logger, config SamplingConfig) *zap.Logger {
+	if !config.Enabled() {
+		return logger
+	}
+	return logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &errorPreservingCore{
+			Core:    core,
+			sampled: zapcore.NewSampler(core, samplingTick, config.Initial, config.Thereafter),
+		}
+	}))
+}
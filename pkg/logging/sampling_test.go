@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func countLevel(logs *observer.ObservedLogs, level zapcore.Level) int {
+	result := 0
+	for _, entry := range logs.All() {
+		if entry.Level == level {
+			result++
+		}
+	}
+	return result
+}
+
+func TestInterceptorSamplesInfoButKeepsErrors(t *testing.T) {
+	var (
+		core, logs  = observer.New(zapcore.DebugLevel)
+		interceptor = Interceptor(zap.New(core), SamplingConfig{Initial: 10, Thereafter: 10})
+		info        = &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+		okHandler   = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		}
+		errHandler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.Internal, "boom")
+		}
+	)
+
+	for i := 0; i < 100; i++ {
+		_, err := interceptor(context.Background(), nil, info, okHandler)
+		require.NoError(t, err)
+	}
+	for i := 0; i < 50; i++ {
+		_, err := interceptor(context.Background(), nil, info, errHandler)
+		require.Error(t, err)
+	}
+
+	// The first 10 info entries are logged, then every 10th of the remaining 90.
+	require.Equal(t, 19, countLevel(logs, zapcore.InfoLevel))
+	require.Equal(t, 50, countLevel(logs, zapcore.ErrorLevel))
+}
+
+func TestInterceptorWithoutSamplingLogsEverything(t *testing.T) {
+	var (
+		core, logs  = observer.New(zapcore.DebugLevel)
+		interceptor = Interceptor(zap.New(core), SamplingConfig{})
+		info        = &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+		okHandler   = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		}
+	)
+
+	for i := 0; i < 100; i++ {
+		_, err := interceptor(context.Background(), nil, info, okHandler)
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 100, countLevel(logs, zapcore.InfoLevel))
+}
@@ -0,0 +1,89 @@
+package logging
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+)
+
+type phaseTimingsKey struct{}
+
+// phaseTimings accumulates the time a request spends in each phase of its
+// handling, in the order the phases were first entered.
+type phaseTimings struct {
+	mu        sync.Mutex
+	phases    []string
+	durations map[string]time.Duration
+}
+
+func (t *phaseTimings) add(phase string, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.durations[phase]; !ok {
+		t.phases = append(t.phases, phase)
+	}
+	t.durations[phase] += d
+}
+
+func (t *phaseTimings) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, phase := range t.phases {
+		enc.AddDuration(phase, t.durations[phase])
+	}
+	return nil
+}
+
+// TimePhase starts timing "phase" of the request handled under ctx, and
+// returns a function to call when the phase completes. Time is only
+// accumulated for requests handled by a SlowRequestInterceptor.
+func TimePhase(ctx context.Context, phase string) func() {
+	timings, ok := ctx.Value(phaseTimingsKey{}).(*phaseTimings)
+	if !ok {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		timings.add(phase, time.Since(start))
+	}
+}
+
+// PhaseInterceptor returns a grpc.UnaryServerInterceptor that runs
+// "interceptor", accounting the time it takes to "phase". Time spent by the
+// rest of the chain is not included.
+func PhaseInterceptor(phase string, interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		done := TimePhase(ctx, phase)
+		defer func() { done() }()
+		return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			// Pause timing while the rest of the chain runs.
+			done()
+			defer func() { done = TimePhase(ctx, phase) }()
+			return handler(ctx, req)
+		})
+	}
+}
+
+// SlowRequestInterceptor returns a grpc.UnaryServerInterceptor that logs
+// requests taking at least "threshold" to "logger", along with the time they
+// spent in each phase timed by PhaseInterceptor or TimePhase.
+func SlowRequestInterceptor(logger *zap.Logger, threshold time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var (
+			timings = &phaseTimings{durations: map[string]time.Duration{}}
+			start   = time.Now()
+		)
+		resp, err := handler(context.WithValue(ctx, phaseTimingsKey{}, timings), req)
+		if elapsed := time.Since(start); elapsed >= threshold {
+			logger.Warn("Slow request",
+				zap.String("method", info.FullMethod),
+				zap.Duration("duration", elapsed),
+				zap.Object("phases", timings))
+		}
+		return resp, err
+	}
+}
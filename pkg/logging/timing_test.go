@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+)
+
+func sleepingInterceptor(d time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		time.Sleep(d)
+		return handler(ctx, req)
+	}
+}
+
+func TestSlowRequestPhaseTimingsSumToTotal(t *testing.T) {
+	var (
+		core, logs = observer.New(zapcore.DebugLevel)
+		chain      = grpc_middleware.ChainUnaryServer(
+			SlowRequestInterceptor(zap.New(core), 50*time.Millisecond),
+			PhaseInterceptor("auth", sleepingInterceptor(20*time.Millisecond)),
+			PhaseInterceptor("validation", sleepingInterceptor(10*time.Millisecond)),
+		)
+		info = &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+		call = func(storeTime time.Duration) {
+			_, err := chain(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				// Two queries accumulate to the same phase.
+				for i := 0; i < 2; i++ {
+					done := TimePhase(ctx, "store")
+					time.Sleep(storeTime / 2)
+					done()
+				}
+				return nil, nil
+			})
+			require.NoError(t, err)
+		}
+	)
+
+	// Fast requests are not logged.
+	call(0)
+	require.Empty(t, logs.All())
+
+	call(40 * time.Millisecond)
+	require.Len(t, logs.All(), 1)
+	fields := logs.All()[0].ContextMap()
+	require.Equal(t, "/test/Method", fields["method"])
+	phases := fields["phases"].(map[string]interface{})
+	require.Len(t, phases, 3)
+
+	var (
+		total = fields["duration"].(time.Duration)
+		sum   time.Duration
+	)
+	for phase, minimum := range map[string]time.Duration{
+		"auth":       20 * time.Millisecond,
+		"validation": 10 * time.Millisecond,
+		"store":      40 * time.Millisecond,
+	} {
+		d := phases[phase].(time.Duration)
+		require.True(t, d >= minimum, phase)
+		sum += d
+	}
+	require.True(t, sum <= total)
+	require.Less(t, int64(total-sum), int64(10*time.Millisecond))
+}
@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServerMetrics counts the RPCs served by a gRPC server, by type, service
+// and method, and measures how long they take. Its metrics are named like
+// those of go-grpc-prometheus so that existing dashboards apply to the DSS.
+type GRPCServerMetrics struct {
+	started  *prometheus.CounterVec
+	handled  *prometheus.CounterVec
+	handling *prometheus.HistogramVec
+	inFlight *prometheus.GaugeVec
+}
+
+// NewGRPCServerMetrics returns GRPCServerMetrics whose metrics are registered
+// with registerer.
+func NewGRPCServerMetrics(registerer prometheus.Registerer) (*GRPCServerMetrics, error) {
+	m := &GRPCServerMetrics{
+		started: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "started_total",
+			Help:      "Number of RPCs started on the server.",
+		}, []string{"grpc_type", "grpc_service", "grpc_method"}),
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "handled_total",
+			Help:      "Number of RPCs completed on the server, by status code.",
+		}, []string{"grpc_type", "grpc_service", "grpc_method", "grpc_code"}),
+		handling: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "handling_seconds",
+			Help:      "Time taken by the server to complete RPCs.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"grpc_type", "grpc_service", "grpc_method"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "grpc",
+			Subsystem: "server",
+			Name:      "in_flight",
+			Help:      "Number of RPCs started on the server but not yet completed.",
+		}, []string{"grpc_type", "grpc_service", "grpc_method"}),
+	}
+	for _, c := range []prometheus.Collector{m.started, m.handled, m.handling, m.inFlight} {
+		if err := registerer.Register(c); err != nil {
+			return nil, stacktrace.Propagate(err, "Error registering gRPC server metric")
+		}
+	}
+	return m, nil
+}
+
+// UnaryInterceptor is a grpc.UnaryServerInterceptor recording the RPCs it
+// handles. It must precede interceptors converting errors to gRPC statuses,
+// for their codes to be recorded.
+func (m *GRPCServerMetrics) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	var resp interface{}
+	err := m.observe("unary", info.FullMethod, func() (err error) {
+		resp, err = handler(ctx, req)
+		return err
+	})
+	return resp, err
+}
+
+// StreamInterceptor is the grpc.StreamServerInterceptor counterpart of
+// UnaryInterceptor.
+func (m *GRPCServerMetrics) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	rpcType := "bidi_stream"
+	switch {
+	case !info.IsClientStream && info.IsServerStream:
+		rpcType = "server_stream"
+	case info.IsClientStream && !info.IsServerStream:
+		rpcType = "client_stream"
+	}
+	return m.observe(rpcType, info.FullMethod, func() error {
+		return handler(srv, ss)
+	})
+}
+
+// observe records an RPC to fullMethod served by call. An RPC whose handler
+// panics is recorded as failing with Internal, without recovering from the
+// panic, so that its stack remains intact for a recovery interceptor further
+// up the chain.
+func (m *GRPCServerMetrics) observe(rpcType, fullMethod string, call func() error) error {
+	done := m.start(rpcType, fullMethod)
+	err := status.Error(codes.Internal, "Handler panicked")
+	defer func() { done(err) }()
+	err = call()
+	return err
+}
+
+// start records the start of an RPC to fullMethod, and returns the function
+// to call with its error once it completes.
+func (m *GRPCServerMetrics) start(rpcType, fullMethod string) func(error) {
+	service, method := splitMethodName(fullMethod)
+	start := time.Now()
+	m.started.WithLabelValues(rpcType, service, method).Inc()
+	m.inFlight.WithLabelValues(rpcType, service, method).Inc()
+	return func(err error) {
+		m.inFlight.WithLabelValues(rpcType, service, method).Dec()
+		m.handled.WithLabelValues(rpcType, service, method, status.Code(err).String()).Inc()
+		m.handling.WithLabelValues(rpcType, service, method).Observe(time.Since(start).Seconds())
+	}
+}
+
+// splitMethodName splits a full gRPC method name, such as
+// /ridpb.DiscoveryAndSynchronizationService/GetSubscription, into its service
+// and method names.
+func splitMethodName(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
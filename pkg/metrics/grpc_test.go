@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCServerMetricsRecordRPCs(t *testing.T) {
+	m, err := NewGRPCServerMetrics(prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	const (
+		service = "ridpb.DiscoveryAndSynchronizationService"
+		method  = "GetSubscription"
+	)
+	var (
+		ctx  = context.Background()
+		info = &grpc.UnaryServerInfo{FullMethod: "/" + service + "/" + method}
+	)
+	_, err = m.UnaryInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		require.Equal(t, float64(1), testutil.ToFloat64(m.inFlight.WithLabelValues("unary", service, method)))
+		return nil, nil
+	})
+	require.NoError(t, err)
+	_, err = m.UnaryInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "no such subscription")
+	})
+	require.Error(t, err)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(m.started.WithLabelValues("unary", service, method)))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.handled.WithLabelValues("unary", service, method, "OK")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.handled.WithLabelValues("unary", service, method, "NotFound")))
+	require.Zero(t, testutil.ToFloat64(m.inFlight.WithLabelValues("unary", service, method)))
+	require.Equal(t, 1, testutil.CollectAndCount(m.handling))
+
+	err = m.StreamInterceptor(nil, nil, &grpc.StreamServerInfo{
+		FullMethod:     "/auxpb.DSSAuxService/StreamLogEvents",
+		IsServerStream: true,
+	}, func(srv interface{}, stream grpc.ServerStream) error {
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, float64(1), testutil.ToFloat64(m.handled.WithLabelValues("server_stream", "auxpb.DSSAuxService", "StreamLogEvents", "OK")))
+}
+
+func TestGRPCServerMetricsRecordPanickingRPCs(t *testing.T) {
+	m, err := NewGRPCServerMetrics(prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/auxpb.DSSAuxService/GetVersion"}
+	require.PanicsWithValue(t, "handler bug", func() {
+		_, _ = m.UnaryInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("handler bug")
+		})
+	})
+
+	// The panic is left to recovery interceptors, but the RPC is no longer
+	// in flight.
+	require.Zero(t, testutil.ToFloat64(m.inFlight.WithLabelValues("unary", "auxpb.DSSAuxService", "GetVersion")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.handled.WithLabelValues("unary", "auxpb.DSSAuxService", "GetVersion", "Internal")))
+}
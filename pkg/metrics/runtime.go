@@ -0,0 +1,83 @@
+// Package metrics exports operational metrics of a DSS instance.
+package metrics
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "dss"
+)
+
+// RuntimeCollector samples Go runtime statistics into Prometheus gauges. It is
+// meant to help operators spot goroutine and memory leaks.
+type RuntimeCollector struct {
+	goroutines   prometheus.Gauge
+	heapAlloc    prometheus.Gauge
+	numGC        prometheus.Gauge
+	gcPauseTotal prometheus.Gauge
+	gcPauseLast  prometheus.Gauge
+}
+
+// NewRuntimeCollector returns a RuntimeCollector whose gauges are registered
+// with registerer.
+func NewRuntimeCollector(registerer prometheus.Registerer) (*RuntimeCollector, error) {
+	newGauge := func(name, help string) prometheus.Gauge {
+		return prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "runtime",
+			Name:      name,
+			Help:      help,
+		})
+	}
+	c := &RuntimeCollector{
+		goroutines:   newGauge("goroutines", "Number of goroutines that currently exist."),
+		heapAlloc:    newGauge("heap_alloc_bytes", "Bytes of allocated heap objects."),
+		numGC:        newGauge("gc_cycles", "Number of completed GC cycles."),
+		gcPauseTotal: newGauge("gc_pause_total_seconds", "Cumulative time spent in GC stop-the-world pauses."),
+		gcPauseLast:  newGauge("gc_pause_last_seconds", "Duration of the most recent GC stop-the-world pause."),
+	}
+	for _, g := range []prometheus.Gauge{c.goroutines, c.heapAlloc, c.numGC, c.gcPauseTotal, c.gcPauseLast} {
+		if err := registerer.Register(g); err != nil {
+			return nil, stacktrace.Propagate(err, "Error registering runtime gauge")
+		}
+	}
+	return c, nil
+}
+
+// Sample updates all gauges from the current state of the runtime.
+func (c *RuntimeCollector) Sample() {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	c.goroutines.Set(float64(runtime.NumGoroutine()))
+	c.heapAlloc.Set(float64(stats.HeapAlloc))
+	c.numGC.Set(float64(stats.NumGC))
+	c.gcPauseTotal.Set(time.Duration(stats.PauseTotalNs).Seconds())
+	if stats.NumGC > 0 {
+		// PauseNs is a circular buffer of recent pauses, most recent at
+		// (NumGC+255)%256.
+		c.gcPauseLast.Set(time.Duration(stats.PauseNs[(stats.NumGC+255)%256]).Seconds())
+	}
+}
+
+// Run samples the runtime immediately and then every interval until ctx is
+// done.
+func (c *RuntimeCollector) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		c.Sample()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
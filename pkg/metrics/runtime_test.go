@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeCollectorPopulatesGauges(t *testing.T) {
+	c, err := NewRuntimeCollector(prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	// Nothing is reported before the first collection cycle.
+	require.Zero(t, testutil.ToFloat64(c.goroutines))
+	require.Zero(t, testutil.ToFloat64(c.heapAlloc))
+
+	runtime.GC()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Run(ctx, time.Hour)
+	}()
+	// Run samples before waiting for its first tick, so once it has returned
+	// exactly one collection cycle has completed.
+	cancel()
+	<-done
+
+	require.GreaterOrEqual(t, testutil.ToFloat64(c.goroutines), float64(1))
+	require.Greater(t, testutil.ToFloat64(c.heapAlloc), float64(0))
+	require.GreaterOrEqual(t, testutil.ToFloat64(c.numGC), float64(1))
+	require.Greater(t, testutil.ToFloat64(c.gcPauseTotal), float64(0))
+	require.GreaterOrEqual(t, testutil.ToFloat64(c.gcPauseLast), float64(0))
+}
+
+func TestNewRuntimeCollectorRejectsDuplicateRegistration(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	_, err := NewRuntimeCollector(registry)
+	require.NoError(t, err)
+
+	_, err = NewRuntimeCollector(registry)
+	require.Error(t, err)
+}
@@ -11,10 +11,6 @@ import (
 const (
 	// TimeFormatRFC3339 is the string used for RFC3339
 	TimeFormatRFC3339 = "RFC3339"
-	minLat            = -90.0
-	maxLat            = 90.0
-	minLng            = -180.0
-	maxLng            = 180.0
 	UnitsM            = "M"
 	ReferenceW84      = "W84"
 )
@@ -221,8 +217,9 @@ type GeoCircle struct {
 
 // CalculateCovering returns the spatial covering of gc.
 func (gc *GeoCircle) CalculateCovering() (s2.CellUnion, error) {
-	if (gc.Center.Lat > maxLat) || (gc.Center.Lat < minLat) || (gc.Center.Lng > maxLng) || (gc.Center.Lng < minLng) {
-		return nil, geo.ErrBadCoordSet
+	center, err := geo.LatLngFromDegrees(gc.Center.Lat, gc.Center.Lng)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid circle center")
 	}
 
 	if !(gc.RadiusMeter > 0) {
@@ -231,7 +228,7 @@ func (gc *GeoCircle) CalculateCovering() (s2.CellUnion, error) {
 
 	// TODO: Use an S2 Cap as an inscribed polygon does not fully cover the defined circle
 	return geo.RegionCoverer.Covering(s2.RegularLoop(
-		s2.PointFromLatLng(s2.LatLngFromDegrees(gc.Center.Lat, gc.Center.Lng)),
+		s2.PointFromLatLng(center),
 		geo.DistanceMetersToAngle(float64(gc.RadiusMeter)),
 		20,
 	)), nil
@@ -253,12 +250,13 @@ func (gp *GeoPolygon) CalculateCovering() (s2.CellUnion, error) {
 	if gp == nil {
 		return nil, geo.ErrBadCoordSet
 	}
-	for _, v := range gp.Vertices {
+	for i, v := range gp.Vertices {
 		// ensure that coordinates passed are actually on earth
-		if (v.Lat > maxLat) || (v.Lat < minLat) || (v.Lng > maxLng) || (v.Lng < minLng) {
-			return nil, geo.ErrBadCoordSet
+		ll, err := geo.LatLngFromDegrees(v.Lat, v.Lng)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid polygon vertex %d", i)
 		}
-		points = append(points, s2.PointFromLatLng(s2.LatLngFromDegrees(v.Lat, v.Lng)))
+		points = append(points, s2.PointFromLatLng(ll))
 	}
 	if len(points) < 3 {
 		return nil, geo.ErrNotEnoughPointsInPolygon
@@ -53,3 +53,36 @@ func TestPolygonCovering(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, want, got)
 }
+
+func TestVolumesCrossingAntimeridianOverlap(t *testing.T) {
+	for name, footprint := range map[string]Geometry{
+		"polygon": &GeoPolygon{
+			Vertices: []*LatLngPoint{
+				{Lat: 60, Lng: 179},
+				{Lat: 60, Lng: -179},
+				{Lat: 60.1, Lng: -179},
+				{Lat: 60.1, Lng: 179},
+			},
+		},
+		"circle": &GeoCircle{
+			Center:      LatLngPoint{Lat: 60.05, Lng: 180},
+			RadiusMeter: 5000,
+		},
+	} {
+		crossing, err := footprint.CalculateCovering()
+		require.NoError(t, err, name)
+
+		for _, lng := range []float64{179.9, -179.9} {
+			neighbour, err := (&GeoPolygon{
+				Vertices: []*LatLngPoint{
+					{Lat: 60.04, Lng: lng - 0.05},
+					{Lat: 60.04, Lng: lng + 0.05},
+					{Lat: 60.06, Lng: lng + 0.05},
+					{Lat: 60.06, Lng: lng - 0.05},
+				},
+			}).CalculateCovering()
+			require.NoError(t, err, name)
+			require.True(t, crossing.Intersects(neighbour), name, lng)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/interuss/dss/pkg/api/v1/ridv22apb"
+	"github.com/interuss/stacktrace"
+)
+
+// === RID F3411-22a -> Business ===
+
+// Volume4DFromRIDV22aProto converts proto to model object
+func Volume4DFromRIDV22aProto(vol4 *ridv22apb.Volume4D) (*Volume4D, error) {
+	vol3, err := Volume3DFromRIDV22aProto(vol4.GetVolume())
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	result := &Volume4D{
+		SpatialVolume: vol3,
+	}
+
+	if startTime := vol4.GetTimeStart(); startTime != nil {
+		ts, err := ptypes.Timestamp(startTime.GetValue())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error converting start time from proto")
+		}
+		result.StartTime = &ts
+	}
+
+	if endTime := vol4.GetTimeEnd(); endTime != nil {
+		ts, err := ptypes.Timestamp(endTime.GetValue())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error converting end time from proto")
+		}
+		result.EndTime = &ts
+	}
+
+	return result, nil
+}
+
+// Volume3DFromRIDV22aProto converts proto to model object
+func Volume3DFromRIDV22aProto(vol3 *ridv22apb.Volume3D) (*Volume3D, error) {
+	if vol3 == nil {
+		return nil, stacktrace.NewError("Missing required volume")
+	}
+
+	altitudeLower := vol3.GetAltitudeLower()
+	var altLo *float32
+	if altitudeLower != nil {
+		if altitudeLower.Units != UnitsM {
+			return nil, stacktrace.NewError("Invalid lower altitude unit")
+		}
+		if altitudeLower.Reference != ReferenceW84 {
+			return nil, stacktrace.NewError("Invalid lower altitude reference")
+		}
+		altLo = float32p(float32(altitudeLower.GetValue()))
+	}
+
+	altitudeUpper := vol3.GetAltitudeUpper()
+	var altHi *float32
+	if altitudeUpper != nil {
+		if altitudeUpper.Units != UnitsM {
+			return nil, stacktrace.NewError("Invalid upper altitude unit")
+		}
+		if altitudeUpper.Reference != ReferenceW84 {
+			return nil, stacktrace.NewError("Invalid upper altitude reference")
+		}
+		altHi = float32p(float32(altitudeUpper.GetValue()))
+	}
+
+	switch {
+	case vol3.GetOutlineCircle() != nil && vol3.GetOutlinePolygon() != nil:
+		return nil, stacktrace.NewError("Both circle and polygon specified in outline geometry")
+	case vol3.GetOutlinePolygon() != nil:
+		return &Volume3D{
+			Footprint:  GeoPolygonFromRIDV22aProto(vol3.GetOutlinePolygon()),
+			AltitudeLo: altLo,
+			AltitudeHi: altHi,
+		}, nil
+	case vol3.GetOutlineCircle() != nil:
+		return &Volume3D{
+			Footprint:  GeoCircleFromRIDV22aProto(vol3.GetOutlineCircle()),
+			AltitudeLo: altLo,
+			AltitudeHi: altHi,
+		}, nil
+	}
+
+	return nil, stacktrace.NewError("Missing required outline geometry")
+}
+
+// GeoCircleFromRIDV22aProto converts proto to model object
+func GeoCircleFromRIDV22aProto(c *ridv22apb.Circle) *GeoCircle {
+	return &GeoCircle{
+		Center:      *LatLngPointFromRIDV22aProto(c.GetCenter()),
+		RadiusMeter: unitToMeterMultiplicativeFactors[unit(c.GetRadius().GetUnits())] * c.GetRadius().GetValue(),
+	}
+}
+
+// GeoPolygonFromRIDV22aProto converts proto to model object
+func GeoPolygonFromRIDV22aProto(p *ridv22apb.Polygon) *GeoPolygon {
+	result := &GeoPolygon{}
+	for _, ltlng := range p.GetVertices() {
+		result.Vertices = append(result.Vertices, LatLngPointFromRIDV22aProto(ltlng))
+	}
+
+	return result
+}
+
+// LatLngPointFromRIDV22aProto converts proto to model object
+func LatLngPointFromRIDV22aProto(p *ridv22apb.LatLngPoint) *LatLngPoint {
+	return &LatLngPoint{
+		Lat: p.GetLat(),
+		Lng: p.GetLng(),
+	}
+}
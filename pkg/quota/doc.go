@@ -0,0 +1,2 @@
+// Package quota limits the number of resources each owner may hold.
+package quota
@@ -0,0 +1,93 @@
+package quota
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/stacktrace"
+)
+
+// Resource is a type of resource created and owned by USSs.
+type Resource string
+
+const (
+	// ISAs are remote ID Identification Service Areas.
+	ISAs Resource = "isas"
+	// Subscriptions are remote ID Subscriptions.
+	Subscriptions Resource = "subscriptions"
+	// Operations are strategic conflict detection Operations, or operational
+	// intents.
+	Operations Resource = "operations"
+)
+
+// Resources lists every Resource a quota may be set for.
+var Resources = []Resource{ISAs, Subscriptions, Operations}
+
+// Quotas limits the number of active resources of each type a single owner
+// may hold. Resources without a quota are not limited.
+type Quotas map[Resource]int
+
+// Limits returns true if q limits the number of resource an owner may hold.
+func (q Quotas) Limits(resource Resource) bool {
+	_, ok := q[resource]
+	return ok
+}
+
+// Check returns an Exhausted error naming the quota hit if owner, currently
+// holding count resources, may not create another one.
+func (q Quotas) Check(resource Resource, owner dssmodels.Owner, count int) error {
+	limit, ok := q[resource]
+	if !ok || count < limit {
+		return nil
+	}
+	return stacktrace.Propagate(
+		stacktrace.NewErrorWithCode(dsserr.Exhausted, "Quota of %d %s per owner reached", limit, resource),
+		"%s already holds %d %s", owner, count, resource)
+}
+
+// String returns q in the format accepted by Parse.
+func (q Quotas) String() string {
+	var pairs []string
+	for resource, limit := range q {
+		pairs = append(pairs, string(resource)+"="+strconv.Itoa(limit))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Parse parses Quotas from comma-separated resource=limit pairs, such as
+// "isas=100,operations=50".
+func Parse(s string) (Quotas, error) {
+	q := Quotas{}
+	if s == "" {
+		return q, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return nil, stacktrace.NewError("Quota %q is not of the form resource=limit", pair)
+		}
+		resource := Resource(strings.TrimSpace(kv[0]))
+		if !isResource(resource) {
+			return nil, stacktrace.NewError("Unknown resource %q; expected one of %v", resource, Resources)
+		}
+		limit, err := strconv.Atoi(strings.TrimSpace(kv[1]))
+		if err != nil || limit < 0 {
+			return nil, stacktrace.NewError("Quota of %s must be a non-negative integer, not %q", resource, kv[1])
+		}
+		q[resource] = limit
+	}
+	return q, nil
+}
+
+func isResource(resource Resource) bool {
+	for _, r := range Resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
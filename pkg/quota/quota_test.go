@@ -0,0 +1,25 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuotas(t *testing.T) {
+	q, err := Parse("isas=100, operations=0")
+	require.NoError(t, err)
+	require.Equal(t, Quotas{ISAs: 100, Operations: 0}, q)
+	require.True(t, q.Limits(Operations))
+	require.False(t, q.Limits(Subscriptions))
+	require.Equal(t, "isas=100,operations=0", q.String())
+
+	q, err = Parse("")
+	require.NoError(t, err)
+	require.Empty(t, q)
+
+	for _, s := range []string{"isas", "constraints=1", "isas=-1", "isas=many"} {
+		_, err := Parse(s)
+		require.Error(t, err, s)
+	}
+}
@@ -0,0 +1,2 @@
+// Package ratelimit bounds the rate at which the DSS accepts requests.
+package ratelimit
@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+)
+
+// DefaultClock is used by TokenBuckets without a Clock.
+var DefaultClock = clockwork.NewRealClock()
+
+// TokenBucket admits up to Rate events per second on average, and bursts of
+// up to Burst events. A TokenBucket starts full.
+type TokenBucket struct {
+	Rate  float64
+	Burst int
+	Clock clockwork.Clock
+
+	mu      sync.Mutex
+	tokens  float64
+	last    time.Time
+	started bool
+}
+
+func (b *TokenBucket) now() time.Time {
+	if b.Clock == nil {
+		return DefaultClock.Now()
+	}
+	return b.Clock.Now()
+}
+
+// Allow consumes a token and returns true if one is available, or returns
+// false without waiting otherwise.
+func (b *TokenBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.started {
+		b.tokens = float64(b.Burst)
+		b.started = true
+	} else if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(float64(b.Burst), b.tokens+elapsed.Seconds()*b.Rate)
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Interceptor returns a grpc.UnaryServerInterceptor rejecting requests with
+// Exhausted once b runs out of tokens. Operations for which exempt returns
+// true are always served, and do not consume tokens.
+func Interceptor(b *TokenBucket, exempt func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if exempt(info.FullMethod) || b.Allow() {
+			return handler(ctx, req)
+		}
+		return nil, stacktrace.NewErrorWithCode(dsserr.Exhausted, "DSS request rate limit exceeded; retry later")
+	}
+}
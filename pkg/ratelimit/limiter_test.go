@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestInterceptorRejectsBeyondGlobalLimitButServesExempt(t *testing.T) {
+	var (
+		clock  = clockwork.NewFakeClock()
+		bucket = &TokenBucket{Rate: 2, Burst: 3, Clock: clock}
+		ic     = Interceptor(bucket, func(method string) bool {
+			return method == "/svc/Health"
+		})
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+		call = func(method string) error {
+			_, err := ic(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return err
+		}
+	)
+
+	// Saturate the limit with a burst.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, call("/svc/Search"))
+	}
+	err := call("/svc/Search")
+	require.Error(t, err)
+	require.Equal(t, dsserr.Exhausted, stacktrace.GetCode(err))
+
+	// Health checks bypass the limit while it is saturated.
+	for i := 0; i < 10; i++ {
+		require.NoError(t, call("/svc/Health"))
+	}
+	require.Error(t, call("/svc/Search"))
+
+	// Tokens are replenished at the configured rate.
+	clock.Advance(500 * time.Millisecond)
+	require.NoError(t, call("/svc/Search"))
+	require.Error(t, call("/svc/Search"))
+
+	// The bucket never holds more than its burst.
+	clock.Advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, call("/svc/Search"))
+	}
+	require.Error(t, call("/svc/Search"))
+}
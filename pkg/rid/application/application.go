@@ -2,12 +2,18 @@ package application
 
 import (
 	"github.com/dpjacques/clockwork"
+	"github.com/interuss/dss/pkg/quota"
 	"github.com/interuss/dss/pkg/rid/store"
 	"go.uber.org/zap"
 )
 
-// DefaultClock allows stubbing out the clock for a test clock.
-var DefaultClock = clockwork.NewRealClock()
+var (
+	// DefaultClock allows stubbing out the clock for a test clock.
+	DefaultClock = clockwork.NewRealClock()
+	// Quotas limits the number of active ISAs and Subscriptions each owner may
+	// hold; the number of Subscriptions in an area is limited regardless.
+	Quotas quota.Quotas
+)
 
 // app contains all of the per-entity Applications.
 type app struct {
@@ -17,19 +23,39 @@ type app struct {
 	store.Store
 	clock  clockwork.Clock
 	logger *zap.Logger
+	// isas caches the ISAs read by GetISA; nil if disabled.
+	isas   *isaCache
+	quotas quota.Quotas
+	// notifications is nil unless notification indices are incremented
+	// after ISAs are written; see DecoupleNotifications.
+	notifications        *pendingNotifications
+	notificationAttempts int
 }
 
 type App interface {
 	ISAApp
 	SubscriptionApp
+	GCApp
 }
 
 // NewFromTransactor is a convenience function for creating an App
 // with the given store.
 func NewFromTransactor(store store.Store, logger *zap.Logger) App {
-	return &app{
+	a := &app{
 		Store:  store,
 		clock:  DefaultClock,
 		logger: logger,
+		quotas: Quotas,
+	}
+	if DecoupleNotifications {
+		a.notifications = &pendingNotifications{max: MaxPendingNotificationCells}
+		a.notificationAttempts = NotificationAttempts
+		if a.notificationAttempts < 1 {
+			a.notificationAttempts = 1
+		}
+	}
+	if ISACacheSize > 0 {
+		a.isas = newISACache(ISACacheSize, ISACacheTTL, localClock(a.clock))
 	}
+	return a
 }
@@ -40,10 +40,18 @@ func (s *mockRepo) Transact(ctx context.Context, f func(repo repos.Repository) e
 	return f(s)
 }
 
+func (s *mockRepo) Read(ctx context.Context, f func(repo repos.Repository) error) error {
+	return f(s)
+}
+
 func (s *mockRepo) Close() error {
 	return nil
 }
 
+func (s *mockRepo) RefreshStatistics(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
 func setUpStore(ctx context.Context, t *testing.T, logger *zap.Logger) (store.Store, func()) {
 	DefaultClock = fakeClock
 
@@ -0,0 +1,124 @@
+package application
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+)
+
+var (
+	// ISACacheSize bounds the number of ISAs GetISA keeps in memory after
+	// reading them from the store. The cache is disabled if ISACacheSize is 0.
+	ISACacheSize = 0
+	// ISACacheTTL bounds how long GetISA may serve an ISA from memory. Writes
+	// through other DSS instances may remain unseen for up to ISACacheTTL.
+	ISACacheTTL = 5 * time.Second
+)
+
+type isaCacheEntry struct {
+	isa     *ridmodels.IdentificationServiceArea
+	expires time.Time
+}
+
+// isaCache is a size-bounded cache of ISAs read from the store, evicting the
+// least recently added ISA once full.
+type isaCache struct {
+	size  int
+	ttl   time.Duration
+	clock clockwork.Clock
+
+	mu      sync.Mutex
+	entries map[dssmodels.ID]*list.Element
+	order   *list.List
+	// generation is incremented by every invalidation, so that ISAs read
+	// before a concurrent write are not added afterwards.
+	generation uint64
+}
+
+// localClock returns the clock against which time elapsing in memory, such as
+// the TTL of cached ISAs, is measured: the local clock underlying clock if it
+// reports another time, such as the database's with cockroach.DBClock, whose
+// readings may step whenever it is resynchronized.
+func localClock(clock clockwork.Clock) clockwork.Clock {
+	if c, ok := clock.(interface{ Local() clockwork.Clock }); ok {
+		return c.Local()
+	}
+	return clock
+}
+
+func newISACache(size int, ttl time.Duration, clock clockwork.Clock) *isaCache {
+	return &isaCache{
+		size:    size,
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[dssmodels.ID]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// get returns a copy of the unexpired ISA cached for id, if any, along with
+// the generation to pass to put if the ISA must be read from the store.
+func (c *isaCache) get(id dssmodels.ID) (*ridmodels.IdentificationServiceArea, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[id]
+	if !ok {
+		return nil, c.generation
+	}
+	entry := elem.Value.(*isaCacheEntry)
+	if !c.clock.Now().Before(entry.expires) {
+		c.remove(elem)
+		return nil, c.generation
+	}
+	isa := *entry.isa
+	return &isa, c.generation
+}
+
+// put caches a copy of isa, unless the cache was invalidated since generation
+// was returned by get.
+func (c *isaCache) put(isa *ridmodels.IdentificationServiceArea, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if generation != c.generation {
+		return
+	}
+	if elem, ok := c.entries[isa.ID]; ok {
+		c.remove(elem)
+	}
+	for c.order.Len() >= c.size {
+		c.remove(c.order.Back())
+	}
+	stored := *isa
+	c.entries[isa.ID] = c.order.PushFront(&isaCacheEntry{
+		isa:     &stored,
+		expires: c.clock.Now().Add(c.ttl),
+	})
+}
+
+// invalidate drops the ISA cached for id, if any.
+func (c *isaCache) invalidate(id dssmodels.ID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	if elem, ok := c.entries[id]; ok {
+		c.remove(elem)
+	}
+}
+
+// purge drops every cached ISA.
+func (c *isaCache) purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.entries = make(map[dssmodels.ID]*list.Element)
+	c.order.Init()
+}
+
+func (c *isaCache) remove(elem *list.Element) {
+	delete(c.entries, elem.Value.(*isaCacheEntry).isa.ID)
+	c.order.Remove(elem)
+}
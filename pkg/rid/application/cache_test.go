@@ -0,0 +1,153 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// countingRepo counts the ISAs read from the in-memory store.
+type countingRepo struct {
+	*mockRepo
+	gets int
+}
+
+func (r *countingRepo) GetISA(ctx context.Context, id dssmodels.ID) (*ridmodels.IdentificationServiceArea, error) {
+	r.gets++
+	return r.mockRepo.GetISA(ctx, id)
+}
+
+func (r *countingRepo) Interact(ctx context.Context) (repos.Repository, error) {
+	return r, nil
+}
+
+func (r *countingRepo) Transact(ctx context.Context, f func(repo repos.Repository) error) error {
+	return f(r)
+}
+
+func (r *countingRepo) Read(ctx context.Context, f func(repo repos.Repository) error) error {
+	return f(r)
+}
+
+func TestGetISAServedFromCacheUntilWritten(t *testing.T) {
+	defer func(size int, ttl time.Duration) { ISACacheSize, ISACacheTTL = size, ttl }(ISACacheSize, ISACacheTTL)
+	ISACacheSize, ISACacheTTL = 10, time.Minute
+
+	var (
+		ctx = context.Background()
+		l   = zap.L()
+	)
+	store, cleanup := setUpStore(ctx, t, l)
+	defer cleanup()
+	mock, ok := store.(*mockRepo)
+	if !ok {
+		t.Skip("the cache is tested against the in-memory store")
+	}
+	repo := &countingRepo{mockRepo: mock}
+	app := NewFromTransactor(repo, l).(*app)
+
+	isa, _, err := app.InsertISA(ctx, &ridmodels.IdentificationServiceArea{
+		ID:        dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		Owner:     "me",
+		URL:       "https://no/place/like/home",
+		StartTime: &startTime,
+		EndTime:   &endTime,
+		Cells:     s2.CellUnion{s2.CellID(17106221850767130624)},
+	})
+	require.NoError(t, err)
+	repo.gets = 0
+
+	// The second get is served from the cache.
+	got, err := app.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.Equal(t, isa.URL, got.URL)
+	got, err = app.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.Equal(t, isa.URL, got.URL)
+	require.Equal(t, 1, repo.gets)
+
+	// Updating the ISA invalidates its cached copy.
+	update := *isa
+	update.URL = "https://no/place/like/work"
+	_, _, err = app.UpdateISA(ctx, &update)
+	require.NoError(t, err)
+	repo.gets = 0
+	got, err = app.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.Equal(t, update.URL, got.URL)
+	require.Equal(t, 1, repo.gets)
+
+	// Cached ISAs expire after the TTL.
+	fakeClock.Advance(ISACacheTTL)
+	_, err = app.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, repo.gets)
+}
+
+func TestISACacheEvictsOldestBeyondSize(t *testing.T) {
+	var (
+		cache = newISACache(2, time.Minute, fakeClock)
+		isa   = func(id string) *ridmodels.IdentificationServiceArea {
+			return &ridmodels.IdentificationServiceArea{ID: dssmodels.ID(id)}
+		}
+	)
+	for _, id := range []string{"a", "b", "c"} {
+		_, generation := cache.get(dssmodels.ID(id))
+		cache.put(isa(id), generation)
+	}
+	got, _ := cache.get("a")
+	require.Nil(t, got)
+	got, _ = cache.get("c")
+	require.NotNil(t, got)
+
+	// ISAs read before a concurrent write are not cached.
+	_, generation := cache.get("d")
+	cache.invalidate("d")
+	cache.put(isa("d"), generation)
+	got, _ = cache.get("d")
+	require.Nil(t, got)
+}
+
+// steppingClock reports the time of a local clock offset by a duration that
+// may change at any moment, like cockroach.DBClock resynchronizing with the
+// database.
+type steppingClock struct {
+	clockwork.FakeClock
+	offset time.Duration
+}
+
+func (c *steppingClock) Now() time.Time {
+	return c.FakeClock.Now().Add(c.offset)
+}
+
+func (c *steppingClock) Local() clockwork.Clock {
+	return c.FakeClock
+}
+
+func TestISACacheTTLImmuneToClockSteps(t *testing.T) {
+	var (
+		clock = &steppingClock{FakeClock: clockwork.NewFakeClock()}
+		cache = newISACache(2, time.Minute, localClock(clock))
+		isa   = &ridmodels.IdentificationServiceArea{ID: dssmodels.ID("a")}
+	)
+	_, generation := cache.get(isa.ID)
+	cache.put(isa, generation)
+
+	// Steps of the reported time neither expire cached ISAs early nor extend
+	// their lifetime.
+	clock.offset = time.Hour
+	got, _ := cache.get(isa.ID)
+	require.NotNil(t, got)
+	clock.offset = -time.Hour
+	clock.Advance(time.Minute)
+	got, _ = cache.get(isa.ID)
+	require.Nil(t, got)
+}
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/stacktrace"
+)
+
+// GCGracePeriod is how long ISAs and Subscriptions are kept after they end
+// before CollectGarbage deletes them.
+var GCGracePeriod time.Duration
+
+// GCApp provides the interface to garbage collection of expired remote ID
+// entities.
+type GCApp interface {
+	// CollectGarbage deletes the ISAs and Subscriptions that ended more than
+	// GCGracePeriod ago and returns how many of each were deleted.
+	CollectGarbage(ctx context.Context) (isas int, subscriptions int, err error)
+
+	// RefreshStatistics refreshes the statistics of the tables storing remote
+	// ID entities and returns their names.
+	RefreshStatistics(ctx context.Context) ([]string, error)
+}
+
+// CollectGarbage deletes entities by their end time alone, so that any
+// number of DSS instances may collect garbage concurrently.
+func (a *app) CollectGarbage(ctx context.Context) (int, int, error) {
+	expiredBefore := a.clock.Now().Add(-GCGracePeriod)
+
+	repo, err := a.Store.Interact(ctx)
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "Unable to interact with store")
+	}
+
+	isas, err := repo.DeleteExpiredISAs(ctx, expiredBefore)
+	if a.isas != nil {
+		a.isas.purge()
+	}
+	if err != nil {
+		return 0, 0, stacktrace.Propagate(err, "Error deleting expired ISAs")
+	}
+	subs, err := repo.DeleteExpiredSubscriptions(ctx, expiredBefore)
+	if err != nil {
+		return isas, 0, stacktrace.Propagate(err, "Error deleting expired Subscriptions")
+	}
+	return isas, subs, nil
+}
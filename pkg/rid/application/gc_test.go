@@ -0,0 +1,128 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/google/uuid"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var (
+	_ GCApp = &app{}
+)
+
+func TestCollectGarbageRemovesOnlyExpiredEntities(t *testing.T) {
+	ctx := context.Background()
+	l := zap.L()
+	store, cleanup := setUpStore(ctx, t, l)
+	defer cleanup()
+	app := NewFromTransactor(store, l).(*app)
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	var (
+		cells   = s2.CellUnion{s2.CellID(17106221850767130624)}
+		expired = fakeClock.Now().Add(-time.Second)
+		isaIDs  []dssmodels.ID
+		subIDs  []dssmodels.ID
+	)
+	for _, end := range []*time.Time{&expired, &endTime} {
+		isa, err := repo.InsertISA(ctx, &ridmodels.IdentificationServiceArea{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     "me",
+			URL:       "https://no/place/like/home",
+			Cells:     cells,
+			StartTime: &startTime,
+			EndTime:   end,
+		})
+		require.NoError(t, err)
+		isaIDs = append(isaIDs, isa.ID)
+
+		sub, err := repo.InsertSubscription(ctx, &ridmodels.Subscription{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     "me",
+			URL:       "https://no/place/like/home",
+			Cells:     cells,
+			StartTime: &startTime,
+			EndTime:   end,
+		})
+		require.NoError(t, err)
+		subIDs = append(subIDs, sub.ID)
+	}
+
+	isas, subs, err := app.CollectGarbage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, isas)
+	require.Equal(t, 1, subs)
+
+	isa, err := repo.GetISA(ctx, isaIDs[0])
+	require.NoError(t, err)
+	require.Nil(t, isa)
+	isa, err = repo.GetISA(ctx, isaIDs[1])
+	require.NoError(t, err)
+	require.NotNil(t, isa)
+
+	sub, err := repo.GetSubscription(ctx, subIDs[0])
+	require.NoError(t, err)
+	require.Nil(t, sub)
+	sub, err = repo.GetSubscription(ctx, subIDs[1])
+	require.NoError(t, err)
+	require.NotNil(t, sub)
+
+	// Nothing is left to collect.
+	isas, subs, err = app.CollectGarbage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, isas)
+	require.Equal(t, 0, subs)
+}
+
+func TestCollectGarbageKeepsEntitiesWithinGracePeriod(t *testing.T) {
+	defer func(grace time.Duration) { GCGracePeriod = grace }(GCGracePeriod)
+	GCGracePeriod = time.Hour
+
+	ctx := context.Background()
+	l := zap.L()
+	store, cleanup := setUpStore(ctx, t, l)
+	defer cleanup()
+	app := NewFromTransactor(store, l).(*app)
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	var (
+		recent = fakeClock.Now().Add(-time.Minute)
+		old    = fakeClock.Now().Add(-2 * time.Hour)
+		start  = old.Add(-time.Hour)
+		ids    []dssmodels.ID
+	)
+	for _, end := range []*time.Time{&recent, &old} {
+		isa, err := repo.InsertISA(ctx, &ridmodels.IdentificationServiceArea{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     "me",
+			URL:       "https://no/place/like/home",
+			Cells:     s2.CellUnion{s2.CellID(17106221850767130624)},
+			StartTime: &start,
+			EndTime:   end,
+		})
+		require.NoError(t, err)
+		ids = append(ids, isa.ID)
+	}
+
+	isas, _, err := app.CollectGarbage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, isas)
+
+	isa, err := repo.GetISA(ctx, ids[0])
+	require.NoError(t, err)
+	require.NotNil(t, isa)
+	isa, err = repo.GetISA(ctx, ids[1])
+	require.NoError(t, err)
+	require.Nil(t, isa)
+}
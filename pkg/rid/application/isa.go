@@ -8,6 +8,7 @@ import (
 	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/dss/pkg/geo"
 	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/quota"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	"github.com/interuss/dss/pkg/rid/repos"
 	"github.com/interuss/stacktrace"
@@ -30,38 +31,118 @@ type ISAApp interface {
 	UpdateISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error)
 
 	// SearchISAs returns all subscriptions ownded by "owner" in "cells".
-	SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error)
+	// If "limit" is set, a page of them after "afterID" is returned; see
+	// repos.ISA.SearchISAs. Partial results may accompany an error caused by
+	// repos.ErrIncompleteSearch.
+	SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// AnyISAInCells returns true if any ISA that has not ended is in "cells".
+	AnyISAInCells(ctx context.Context, cells s2.CellUnion) (bool, error)
+
+	// ListISAsModifiedSince returns a page of the ISAs written after "since";
+	// see repos.ISA.ListISAsModifiedSince.
+	ListISAsModifiedSince(ctx context.Context, since time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// ListISAs returns a page of every ISA ordered by ID, after "afterID";
+	// see repos.ISA.ListISAs.
+	ListISAs(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// CountISAsByInterval returns the number of ISAs active during each
+	// "interval" between "start" and "end"; see repos.ISA.CountISAsByInterval.
+	CountISAsByInterval(ctx context.Context, start, end time.Time, interval time.Duration) ([]int, error)
 }
 
 func (a *app) GetISA(ctx context.Context, id dssmodels.ID) (*ridmodels.IdentificationServiceArea, error) {
-	repo, err := a.Store.Interact(ctx)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Unable to interact with store")
+	var generation uint64
+	if a.isas != nil {
+		var cached *ridmodels.IdentificationServiceArea
+		if cached, generation = a.isas.get(id); cached != nil {
+			return cached, nil
+		}
+	}
+
+	var isa *ridmodels.IdentificationServiceArea
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		isa, err = repo.GetISA(ctx, id)
+		return err
+	})
+	if err == nil && isa != nil && a.isas != nil {
+		a.isas.put(isa, generation)
+	}
+	return isa, err
+}
+
+// invalidateISA drops any cached copy of the ISA identified by id after it
+// may have been written.
+func (a *app) invalidateISA(id dssmodels.ID) {
+	if a.isas != nil {
+		a.isas.invalidate(id)
 	}
-	return repo.GetISA(ctx, id)
 }
 
 // SearchISAs for ISA within the volume bounds.
-func (a *app) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+func (a *app) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
 	now := a.clock.Now()
 	if earliest == nil || earliest.Before(now) {
 		earliest = &now
 	}
 
+	var isas []*ridmodels.IdentificationServiceArea
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		isas, err = repo.SearchISAs(ctx, cells, earliest, latest, afterID, limit)
+		return err
+	})
+	return isas, err
+}
+
+// AnyISAInCells reports whether any active ISA is in "cells".
+func (a *app) AnyISAInCells(ctx context.Context, cells s2.CellUnion) (bool, error) {
+	var covered bool
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		covered, err = repo.AnyISAInCells(ctx, cells, a.clock.Now())
+		return err
+	})
+	return covered, err
+}
+
+// ListISAsModifiedSince lists a page of the ISAs written after "since".
+func (a *app) ListISAsModifiedSince(ctx context.Context, since time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var isas []*ridmodels.IdentificationServiceArea
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		isas, err = repo.ListISAsModifiedSince(ctx, since, afterID, limit)
+		return err
+	})
+	return isas, err
+}
+
+// ListISAs lists a page of every ISA, ordered by ID.
+func (a *app) ListISAs(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var isas []*ridmodels.IdentificationServiceArea
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		isas, err = repo.ListISAs(ctx, afterID, limit)
+		return err
+	})
+	return isas, err
+}
+
+// CountISAsByInterval counts the ISAs active during each "interval" between
+// "start" and "end".
+func (a *app) CountISAsByInterval(ctx context.Context, start, end time.Time, interval time.Duration) ([]int, error) {
 	repo, err := a.Store.Interact(ctx)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Unable to interact with store")
 	}
-
-	return repo.SearchISAs(ctx, cells, earliest, latest)
+	return repo.CountISAsByInterval(ctx, start, end, interval)
 }
 
 // DeleteISA the given ISA
 func (a *app) DeleteISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Owner, version *dssmodels.Version) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error) {
 	var (
-		ret  *ridmodels.IdentificationServiceArea
-		subs []*ridmodels.Subscription
+		ret   *ridmodels.IdentificationServiceArea
+		subs  []*ridmodels.Subscription
+		cells s2.CellUnion
 	)
+	defer a.invalidateISA(id)
 	// The following will automatically retry TXN retry errors.
 	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
 		old, err := repo.GetISA(ctx, id)
@@ -83,12 +164,18 @@ func (a *app) DeleteISA(ctx context.Context, id dssmodels.ID, owner dssmodels.Ow
 			return stacktrace.Propagate(err, "Error deleting ISA")
 		}
 
-		subs, err = repo.UpdateNotificationIdxsInCells(ctx, old.Cells)
-		if err != nil {
-			return stacktrace.Propagate(err, "Error updating notification indices")
+		cells = old.Cells
+		if a.notifications == nil {
+			subs, err = repo.UpdateNotificationIdxsInCells(ctx, old.Cells)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error updating notification indices")
+			}
 		}
 		return nil
 	})
+	if err == nil && a.notifications != nil {
+		subs = a.notifySubscriptions(ctx, cells)
+	}
 	return ret, subs, err // No need to Propagate this error as this stack layer does not add useful information
 }
 
@@ -103,6 +190,7 @@ func (a *app) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServic
 		ret  *ridmodels.IdentificationServiceArea
 		subs []*ridmodels.Subscription
 	)
+	defer a.invalidateISA(isa.ID)
 	// The following will automatically retry TXN retry errors.
 	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
 		// ensure it doesn't exist yet
@@ -114,12 +202,24 @@ func (a *app) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServic
 			return stacktrace.NewErrorWithCode(dsserr.AlreadyExists, "ISA %s already exists", isa.ID)
 		}
 
+		if a.quotas.Limits(quota.ISAs) {
+			count, err := repo.CountISAsByOwner(ctx, isa.Owner, a.clock.Now())
+			if err != nil {
+				return stacktrace.Propagate(err, "Error counting ISAs of owner")
+			}
+			if err := a.quotas.Check(quota.ISAs, isa.Owner, count); err != nil {
+				return err // No need to Propagate this error as this stack layer does not add useful information
+			}
+		}
+
 		// UpdateNotificationIdxsInCells is done in a Txn along with insert since
 		// they are both modifying the db. Insert a susbcription alone does
 		// not do this, so that does not need to use a txn (in subscription.go).
-		subs, err = repo.UpdateNotificationIdxsInCells(ctx, isa.Cells)
-		if err != nil {
-			return stacktrace.Propagate(err, "Error updating notification indices")
+		if a.notifications == nil {
+			subs, err = repo.UpdateNotificationIdxsInCells(ctx, isa.Cells)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error updating notification indices")
+			}
 		}
 		ret, err = repo.InsertISA(ctx, isa)
 		if err != nil {
@@ -127,6 +227,9 @@ func (a *app) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServic
 		}
 		return nil
 	})
+	if err == nil && a.notifications != nil {
+		subs = a.notifySubscriptions(ctx, isa.Cells)
+	}
 	return ret, subs, err // No need to Propagate this error as this stack layer does not add useful information
 }
 
@@ -134,9 +237,11 @@ func (a *app) InsertISA(ctx context.Context, isa *ridmodels.IdentificationServic
 func (a *app) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error) {
 	// Update the notification index for both cells removed and added.
 	var (
-		ret  *ridmodels.IdentificationServiceArea
-		subs []*ridmodels.Subscription
+		ret   *ridmodels.IdentificationServiceArea
+		subs  []*ridmodels.Subscription
+		cells s2.CellUnion
 	)
+	defer a.invalidateISA(isa.ID)
 	// The following will automatically retry TXN retry errors.
 	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
 		var err error
@@ -166,17 +271,22 @@ func (a *app) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationServic
 
 		// TODO steeling, we should change this to a Custom type, to obfuscate
 		// some of these metrics and prevent us from doing the wrong thing.
-		cells := s2.CellUnionFromUnion(old.Cells, isa.Cells)
+		cells = s2.CellUnionFromUnion(old.Cells, isa.Cells)
 		geo.Levelify(&cells)
 		// UpdateNotificationIdxsInCells is done in a Txn along with insert since
 		// they are both modifying the db. Insert a susbcription alone does
 		// not do this, so that does not need to use a txn (in subscription.go).
-		subs, err = repo.UpdateNotificationIdxsInCells(ctx, cells)
-		if err != nil {
-			return stacktrace.Propagate(err, "Error updating notification indices")
+		if a.notifications == nil {
+			subs, err = repo.UpdateNotificationIdxsInCells(ctx, cells)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error updating notification indices")
+			}
 		}
 		return nil
 	})
+	if err == nil && a.notifications != nil {
+		subs = a.notifySubscriptions(ctx, cells)
+	}
 
 	return ret, subs, err // No need to Propagate this error as this stack layer does not add useful information
 }
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -10,8 +11,11 @@ import (
 	"github.com/google/uuid"
 	dsserr "github.com/interuss/dss/pkg/errors"
 	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/quota"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/dss/pkg/rid/repos"
 	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
@@ -73,7 +77,7 @@ func (store *isaStore) GetVersion(ctx context.Context) (*semver.Version, error)
 }
 
 // Implements repos.ISA.SearchISA
-func (store *isaStore) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+func (store *isaStore) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
 	var isas []*ridmodels.IdentificationServiceArea
 
 	for _, isa := range store.isas {
@@ -84,6 +88,95 @@ func (store *isaStore) SearchISAs(ctx context.Context, cells s2.CellUnion, earli
 	return isas, nil
 }
 
+// Implements repos.ISA.AnyISAInCells
+func (store *isaStore) AnyISAInCells(ctx context.Context, cells s2.CellUnion, activeAt time.Time) (bool, error) {
+	for _, isa := range store.isas {
+		if isa.Cells.Intersects(cells) && !isa.EndTime.Before(activeAt) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Implements repos.ISA.ListISAsModifiedSince
+func (store *isaStore) ListISAsModifiedSince(ctx context.Context, since time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var isas []*ridmodels.IdentificationServiceArea
+	for _, isa := range store.isas {
+		updated := isa.Version.ToTimestamp()
+		if updated.After(since) || (updated.Equal(since) && !afterID.Empty() && isa.ID > afterID) {
+			isas = append(isas, isa)
+		}
+	}
+	sort.Slice(isas, func(i, j int) bool {
+		ti, tj := isas[i].Version.ToTimestamp(), isas[j].Version.ToTimestamp()
+		if ti.Equal(*tj) {
+			return isas[i].ID < isas[j].ID
+		}
+		return ti.Before(*tj)
+	})
+	if len(isas) > limit {
+		isas = isas[:limit]
+	}
+	return isas, nil
+}
+
+// Implements repos.ISA.ListISAs
+func (store *isaStore) ListISAs(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	var isas []*ridmodels.IdentificationServiceArea
+	for _, isa := range store.isas {
+		if isa.ID > afterID {
+			isas = append(isas, isa)
+		}
+	}
+	sort.Slice(isas, func(i, j int) bool { return isas[i].ID < isas[j].ID })
+	if len(isas) > limit {
+		isas = isas[:limit]
+	}
+	return isas, nil
+}
+
+// Implements repos.ISA.CountISAsByInterval
+func (store *isaStore) CountISAsByInterval(ctx context.Context, start, end time.Time, interval time.Duration) ([]int, error) {
+	var counts []int
+	for bucket := start; bucket.Before(end); bucket = bucket.Add(interval) {
+		bucketEnd := bucket.Add(interval)
+		if bucketEnd.After(end) {
+			bucketEnd = end
+		}
+		count := 0
+		for _, isa := range store.isas {
+			if isa.StartTime.Before(bucketEnd) && isa.EndTime.After(bucket) {
+				count++
+			}
+		}
+		counts = append(counts, count)
+	}
+	return counts, nil
+}
+
+// Implements repos.ISA.DeleteExpiredISAs
+func (store *isaStore) DeleteExpiredISAs(ctx context.Context, expiredBefore time.Time) (int, error) {
+	deleted := 0
+	for id, isa := range store.isas {
+		if isa.EndTime != nil && isa.EndTime.Before(expiredBefore) {
+			delete(store.isas, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// Implements repos.ISA.CountISAsByOwner
+func (store *isaStore) CountISAsByOwner(ctx context.Context, owner dssmodels.Owner, activeAt time.Time) (int, error) {
+	count := 0
+	for _, isa := range store.isas {
+		if isa.Owner == owner && (isa.EndTime == nil || !isa.EndTime.Before(activeAt)) {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func TestISAUpdateIdxCells(t *testing.T) {
 	ctx := context.Background()
 	app, cleanup := setUpISAApp(ctx, t)
@@ -137,7 +230,7 @@ func TestISAUpdateIdxCells(t *testing.T) {
 		require.Equal(t, 1, sub.NotificationIndex)
 	}
 
-	isas, err := app.SearchISAs(ctx, isa.Cells, &startTime, nil)
+	isas, err := app.SearchISAs(ctx, isa.Cells, &startTime, nil, "", 0)
 	require.NoError(t, err)
 	require.NotNil(t, isas)
 	require.Len(t, isas, 1)
@@ -217,6 +310,113 @@ func TestInsertISA(t *testing.T) {
 	}
 }
 
+func TestInsertISAStartingBeyondMaxFutureWindow(t *testing.T) {
+	ctx := context.Background()
+	app, cleanup := setUpISAApp(ctx, t)
+	defer cleanup()
+
+	ridmodels.MaxFutureWindow = 24 * time.Hour
+	defer func() { ridmodels.MaxFutureWindow = 0 }()
+
+	for _, r := range []struct {
+		name    string
+		start   time.Time
+		wantErr stacktrace.ErrorCode
+	}{
+		{
+			name:  "within-window",
+			start: fakeClock.Now().Add(23 * time.Hour),
+		},
+		{
+			name:    "beyond-window",
+			start:   fakeClock.Now().Add(25 * time.Hour),
+			wantErr: dsserr.BadRequest,
+		},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			end := r.start.Add(time.Hour)
+			_, _, err := app.InsertISA(ctx, &ridmodels.IdentificationServiceArea{
+				ID:        dssmodels.ID(uuid.New().String()),
+				Owner:     dssmodels.Owner(uuid.New().String()),
+				Cells:     s2.CellUnion{12494535935418957824},
+				StartTime: &r.start,
+				EndTime:   &end,
+			})
+			if r.wantErr == stacktrace.ErrorCode(0) {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, r.wantErr, stacktrace.GetCode(err))
+			}
+		})
+	}
+}
+
+func TestISAQuotaLeavesSubscriptionsAvailable(t *testing.T) {
+	Quotas = quota.Quotas{quota.ISAs: 1, quota.Subscriptions: 2}
+	defer func() { Quotas = nil }()
+
+	ctx := context.Background()
+	app, cleanup := setUpISAApp(ctx, t)
+	defer cleanup()
+
+	owner := dssmodels.Owner(uuid.New().String())
+	newISA := func() *ridmodels.IdentificationServiceArea {
+		return &ridmodels.IdentificationServiceArea{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     owner,
+			Cells:     s2.CellUnion{12494535935418957824},
+			StartTime: &startTime,
+			EndTime:   &endTime,
+		}
+	}
+
+	_, _, err := app.InsertISA(ctx, newISA())
+	require.NoError(t, err)
+
+	// The owner holds as many ISAs as its quota allows.
+	_, _, err = app.InsertISA(ctx, newISA())
+	require.Equal(t, dsserr.Exhausted, stacktrace.GetCode(err))
+	require.Contains(t, stacktrace.RootCause(err).Error(), "Quota of 1 isas")
+
+	// Other owners are not limited by it.
+	other := newISA()
+	other.Owner = dssmodels.Owner(uuid.New().String())
+	_, _, err = app.InsertISA(ctx, other)
+	require.NoError(t, err)
+
+	// Subscriptions have their own quota, which the ISAs do not count towards.
+	for i := 0; i < 2; i++ {
+		_, err = app.InsertSubscription(ctx, &ridmodels.Subscription{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     owner,
+			URL:       "https://no/place/like/home",
+			Cells:     s2.CellUnion{12494535935418957824},
+			StartTime: &startTime,
+			EndTime:   &endTime,
+		})
+		require.NoError(t, err)
+	}
+	_, err = app.InsertSubscription(ctx, &ridmodels.Subscription{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     owner,
+		URL:       "https://no/place/like/home",
+		Cells:     s2.CellUnion{12494535832339742720},
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	require.Equal(t, dsserr.Exhausted, stacktrace.GetCode(err))
+	require.Contains(t, stacktrace.RootCause(err).Error(), "Quota of 2 subscriptions")
+
+	// Once an ISA ends, another one may be created.
+	fakeClock.Advance(2 * time.Hour)
+	defer fakeClock.Advance(-2 * time.Hour)
+	later := fakeClock.Now().Add(time.Hour)
+	isa := newISA()
+	isa.StartTime, isa.EndTime = nil, &later
+	_, _, err = app.InsertISA(ctx, isa)
+	require.NoError(t, err)
+}
+
 func TestUpdateISA(t *testing.T) {
 	ctx := context.Background()
 	app, cleanup := setUpISAApp(ctx, t)
@@ -370,3 +570,163 @@ func TestAppDeleteISAs(t *testing.T) {
 		require.Equal(t, 44, subscriptionsOut[i].NotificationIndex)
 	}
 }
+
+// flakyNotificationsRepo fails to update notification indices until failures
+// attempts have been made.
+type flakyNotificationsRepo struct {
+	*mockRepo
+	failures int
+	attempts int
+}
+
+func (s *flakyNotificationsRepo) Interact(ctx context.Context) (repos.Repository, error) {
+	return s, nil
+}
+
+func (s *flakyNotificationsRepo) Transact(ctx context.Context, f func(repo repos.Repository) error) error {
+	return f(s)
+}
+
+func (s *flakyNotificationsRepo) Read(ctx context.Context, f func(repo repos.Repository) error) error {
+	return f(s)
+}
+
+func (s *flakyNotificationsRepo) UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
+	s.attempts++
+	if s.attempts <= s.failures {
+		return nil, stacktrace.NewError("Transaction aborted")
+	}
+	return s.mockRepo.UpdateNotificationIdxsInCells(ctx, cells)
+}
+
+func TestDecoupledNotificationsDoNotBlockISAWrites(t *testing.T) {
+	DecoupleNotifications = true
+	defer func() { DecoupleNotifications = false }()
+	DefaultClock = fakeClock
+
+	ctx := context.Background()
+	repo := &flakyNotificationsRepo{
+		mockRepo: &mockRepo{
+			isaStore:          &isaStore{isas: make(map[dssmodels.ID]*ridmodels.IdentificationServiceArea)},
+			subscriptionStore: &subscriptionStore{subs: make(map[dssmodels.ID]*ridmodels.Subscription)},
+		},
+	}
+	app := NewFromTransactor(repo, zap.L()).(*app)
+
+	cells := s2.CellUnion{12494535935418957824}
+	_, err := app.InsertSubscription(ctx, &ridmodels.Subscription{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     "owner",
+		URL:       "https://no/place/like/home",
+		Cells:     cells,
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	require.NoError(t, err)
+	newISA := func() *ridmodels.IdentificationServiceArea {
+		return &ridmodels.IdentificationServiceArea{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     "owner",
+			Cells:     cells,
+			StartTime: &startTime,
+			EndTime:   &endTime,
+		}
+	}
+
+	// The ISA is committed, and its subscriber notified, although the first
+	// attempt at updating notification indices failed.
+	repo.failures = 1
+	isa, subs, err := app.InsertISA(ctx, newISA())
+	require.NoError(t, err)
+	require.Equal(t, 2, repo.attempts)
+	stored, err := repo.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.Len(t, subs, 1)
+	require.Equal(t, 1, subs[0].NotificationIndex)
+
+	// Once every attempt fails, the subscriber is notified at its current
+	// index, which is incremented along with the next ISA write.
+	repo.attempts, repo.failures = 0, NotificationAttempts
+	isa, subs, err = app.InsertISA(ctx, newISA())
+	require.NoError(t, err)
+	require.Equal(t, NotificationAttempts, repo.attempts)
+	stored, err = repo.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.Len(t, subs, 1)
+	require.Equal(t, 1, subs[0].NotificationIndex)
+
+	_, subs, err = app.InsertISA(ctx, newISA())
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, 3, subs[0].NotificationIndex)
+}
+
+func TestPendingNotificationsAreBounded(t *testing.T) {
+	var (
+		p     = &pendingNotifications{max: 2}
+		cells = s2.CellUnion{
+			s2.CellIDFromLatLng(s2.LatLngFromDegrees(37.4, -122.1)).Parent(13),
+			s2.CellIDFromLatLng(s2.LatLngFromDegrees(47.4, 8.5)).Parent(13),
+			s2.CellIDFromLatLng(s2.LatLngFromDegrees(-33.9, 151.2)).Parent(13),
+		}
+	)
+	cells.Normalize()
+
+	require.True(t, p.add(cells[:1]))
+	require.True(t, p.add(cells[:2]))
+	// Cells beyond the bound are dropped, keeping those already pending.
+	require.False(t, p.add(cells[2:]))
+	require.Equal(t, cells[:2], p.take())
+	require.Empty(t, p.take())
+}
+
+func TestDroppedNotificationsAreCounted(t *testing.T) {
+	DecoupleNotifications, MaxPendingNotificationCells = true, 0
+	defer func() { DecoupleNotifications, MaxPendingNotificationCells = false, 10000 }()
+	DefaultClock = fakeClock
+
+	ctx := context.Background()
+	repo := &flakyNotificationsRepo{
+		mockRepo: &mockRepo{
+			isaStore:          &isaStore{isas: make(map[dssmodels.ID]*ridmodels.IdentificationServiceArea)},
+			subscriptionStore: &subscriptionStore{subs: make(map[dssmodels.ID]*ridmodels.Subscription)},
+		},
+		failures: NotificationAttempts,
+	}
+	app := NewFromTransactor(repo, zap.L()).(*app)
+
+	dropped := testutil.ToFloat64(droppedNotificationCells)
+	_, _, err := app.InsertISA(ctx, &ridmodels.IdentificationServiceArea{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     "owner",
+		Cells:     s2.CellUnion{12494535935418957824},
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	require.NoError(t, err)
+	require.Equal(t, dropped+1, testutil.ToFloat64(droppedNotificationCells))
+	require.Empty(t, app.notifications.take())
+}
+
+func TestInsertISATwiceAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	app, cleanup := setUpISAApp(ctx, t)
+	defer cleanup()
+
+	isa := &ridmodels.IdentificationServiceArea{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     "owner",
+		Cells:     s2.CellUnion{12494535935418957824},
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	}
+	_, _, err := app.InsertISA(ctx, isa)
+	require.NoError(t, err)
+
+	duplicate := *isa
+	_, _, err = app.InsertISA(ctx, &duplicate)
+	require.Equal(t, dsserr.AlreadyExists, stacktrace.GetCode(err))
+	require.Contains(t, stacktrace.RootCause(err).Error(), isa.ID.String())
+}
@@ -0,0 +1,155 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/geo/s2"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+var (
+	// DecoupleNotifications defines whether writes of ISAs increment the
+	// notification indices of the Subscriptions they affect in a transaction
+	// of their own, committed after the ISA is written. If they do, failing to
+	// increment the indices no longer fails the write, at the cost of
+	// consistency:
+	//   - The ISA is visible to searches before the indices are incremented.
+	//   - If incrementing the indices fails even after NotificationAttempts,
+	//     the write returns the Subscriptions to notify with their current
+	//     indices, and the indices are incremented along with those of the
+	//     next ISA written. Subscribers may then see an index not change
+	//     across a notification, and change without one.
+	//   - The cells whose indices are left to increment are only kept in the
+	//     memory of the instance that wrote the ISA, and only up to
+	//     MaxPendingNotificationCells of them: their indices are never
+	//     incremented if that instance restarts before its next ISA write, or
+	//     if the bound is reached. Other instances do not reconcile them.
+	//     Cells dropped at the bound are logged, and counted by the
+	//     dss_rid_dropped_notification_cells_total metric.
+	DecoupleNotifications = false
+	// NotificationAttempts is how many times incrementing notification
+	// indices is attempted after writing an ISA, if DecoupleNotifications.
+	NotificationAttempts = 3
+	// MaxPendingNotificationCells bounds the cells whose notification indices
+	// are left to increment, if DecoupleNotifications; failed increments in
+	// further cells are not reconciled.
+	MaxPendingNotificationCells = 10000
+
+	droppedNotificationCells = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "dss",
+		Subsystem: "rid",
+		Name:      "dropped_notification_cells_total",
+		Help:      "Number of cells whose notification indices were left to increment, then dropped with too many pending already.",
+	})
+)
+
+// RegisterMetrics registers the metrics of the notifications of ISA writes
+// with registerer, unless they were registered already.
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	err := registerer.Register(droppedNotificationCells)
+	if registered, ok := err.(prometheus.AlreadyRegisteredError); ok && registered.ExistingCollector == droppedNotificationCells {
+		return nil
+	}
+	return stacktrace.Propagate(err, "Error registering dropped notification cell counter")
+}
+
+// pendingNotifications accumulates the cells whose Subscriptions' notification
+// indices could not be incremented after an ISA was written, up to max cells.
+type pendingNotifications struct {
+	max int
+
+	mu    sync.Mutex
+	cells s2.CellUnion
+}
+
+// add adds cells to those pending, unless that would exceed p.max, in which
+// case it returns false.
+func (p *pendingNotifications) add(cells s2.CellUnion) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	union := s2.CellUnionFromUnion(p.cells, cells)
+	if len(union) > p.max {
+		return false
+	}
+	p.cells = union
+	return true
+}
+
+func (p *pendingNotifications) take() s2.CellUnion {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	cells := p.cells
+	p.cells = nil
+	return cells
+}
+
+// notifySubscriptions increments the notification indices of the
+// Subscriptions in cells, after an ISA written there was committed, and returns
+// those Subscriptions. Indices left to increment by earlier writes are
+// reconciled first.
+func (a *app) notifySubscriptions(ctx context.Context, cells s2.CellUnion) []*ridmodels.Subscription {
+	if pending := a.notifications.take(); len(pending) > 0 {
+		if _, err := a.incrementNotificationIdxs(ctx, pending); err != nil {
+			a.logger.Warn("failed to reconcile notification indices", zap.Int("cells", len(pending)), zap.Error(err))
+			if !a.notifications.add(pending) {
+				a.dropNotifications("too many notification indices left to reconcile, dropping them", pending, err)
+			}
+		}
+	}
+
+	subs, err := a.incrementNotificationIdxs(ctx, cells)
+	if err == nil {
+		return subs
+	}
+	if a.notifications.add(cells) {
+		a.logger.Warn("failed to update notification indices, deferring to the next ISA write", zap.Error(err))
+	} else {
+		a.dropNotifications("failed to update notification indices, with too many left to reconcile to defer them", cells, err)
+	}
+
+	// Subscribers are still notified, with the indices they currently have.
+	repo, err := a.Store.Interact(ctx)
+	if err == nil {
+		subs, err = repo.SearchSubscriptions(ctx, cells)
+	}
+	if err != nil {
+		a.logger.Error("failed to find Subscriptions to notify", zap.Error(err))
+		return nil
+	}
+	return subs
+}
+
+// dropNotifications gives up on incrementing the notification indices of the
+// Subscriptions in cells, which failed with err.
+func (a *app) dropNotifications(msg string, cells s2.CellUnion, err error) {
+	droppedNotificationCells.Add(float64(len(cells)))
+	a.logger.Error(msg, zap.Int("cells", len(cells)), zap.Error(err))
+}
+
+// incrementNotificationIdxs increments the notification indices of the
+// Subscriptions in cells, attempting up to a.notificationAttempts times.
+func (a *app) incrementNotificationIdxs(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
+	var (
+		subs []*ridmodels.Subscription
+		err  error
+	)
+	for attempt := 0; attempt < a.notificationAttempts; attempt++ {
+		err = a.Store.Transact(ctx, func(repo repos.Repository) error {
+			var err error
+			subs, err = repo.UpdateNotificationIdxsInCells(ctx, cells)
+			return err
+		})
+		if err == nil || ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error updating notification indices")
+	}
+	return subs, nil
+}
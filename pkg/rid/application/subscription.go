@@ -2,10 +2,12 @@ package application
 
 import (
 	"context"
+	"sort"
 
 	"github.com/golang/geo/s2"
 	dsserr "github.com/interuss/dss/pkg/errors"
 	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/quota"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	"github.com/interuss/dss/pkg/rid/repos"
 	"github.com/interuss/stacktrace"
@@ -34,24 +36,62 @@ type SubscriptionApp interface {
 	// UpdateSubscription
 	UpdateSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error)
 
-	// SearchSubscriptionsByOwner returns all IdentificationServiceAreas ownded by "owner" in "cells".
-	SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error)
+	// UpsertSubscription inserts "s", or extends the Subscription of the same
+	// owner covering the same area if there is one. Returns the Subscription
+	// written and true if it was inserted.
+	UpsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error)
+
+	// SearchSubscriptionsByOwner returns all IdentificationServiceAreas ownded by "owner" in "cells",
+	// or a page of them after "afterID" if "limit" is set; see repos.ISA.SearchISAs.
+	SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error)
+
+	// ListSubscriptions returns a page of every Subscription ordered by ID,
+	// after "afterID"; see repos.Subscription.ListSubscriptions.
+	ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error)
+
+	// GetNotificationIndices returns the current notification index of the
+	// Subscription identified by "id" and the index of its next notification;
+	// see repos.Subscription.GetNotificationIndices.
+	GetNotificationIndices(ctx context.Context, id dssmodels.ID) (int, int, error)
 }
 
 func (a *app) GetSubscription(ctx context.Context, id dssmodels.ID) (*ridmodels.Subscription, error) {
-	repo, err := a.Store.Interact(ctx)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Unable to interact with store")
-	}
-	return repo.GetSubscription(ctx, id)
+	var sub *ridmodels.Subscription
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		sub, err = repo.GetSubscription(ctx, id)
+		return err
+	})
+	return sub, err
 }
 
-func (a *app) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error) {
+// GetNotificationIndices reads the notification indices of a Subscription
+// from the latest writes, since they are meant to be compared with those of
+// notifications.
+func (a *app) GetNotificationIndices(ctx context.Context, id dssmodels.ID) (int, int, error) {
 	repo, err := a.Store.Interact(ctx)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Unable to interact with store")
+		return 0, 0, stacktrace.Propagate(err, "Unable to interact with store")
 	}
-	return repo.SearchSubscriptionsByOwner(ctx, cells, owner)
+	return repo.GetNotificationIndices(ctx, id)
+}
+
+func (a *app) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	var subs []*ridmodels.Subscription
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		subs, err = repo.SearchSubscriptionsByOwner(ctx, cells, owner, afterID, limit)
+		return err
+	})
+	return subs, err
+}
+
+// ListSubscriptions lists a page of every Subscription, ordered by ID.
+func (a *app) ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	var subs []*ridmodels.Subscription
+	err := a.Store.Read(ctx, func(repo repos.Repository) (err error) {
+		subs, err = repo.ListSubscriptions(ctx, afterID, limit)
+		return err
+	})
+	return subs, err
 }
 
 func (a *app) InsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error) {
@@ -71,8 +111,122 @@ func (a *app) InsertSubscription(ctx context.Context, s *ridmodels.Subscription)
 			return stacktrace.NewErrorWithCode(dsserr.AlreadyExists, "Subscription %s already exists", s.ID)
 		}
 
-		// Check the user hasn't created too many subscriptions in this area.
-		count, err := repo.MaxSubscriptionCountInCellsByOwner(ctx, s.Cells, s.Owner)
+		if err := a.checkSubscriptionLimits(ctx, repo, s, nil); err != nil {
+			return err // No need to Propagate this error as this stack layer does not add useful information
+		}
+
+		sub, err = repo.InsertSubscription(ctx, s)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error inserting Subscription into repo")
+		}
+
+		return nil
+	})
+	return sub, err
+}
+
+// UpsertSubscription inserts "s", unless its owner already holds a
+// Subscription covering exactly the same cells. That Subscription is instead
+// extended to end at the later of its and "s"'s end times, and notified at the
+// URL of "s"; it keeps its ID and start time. Matching and writing happen in a
+// single transaction, so that concurrent upserts for the same area do not
+// create duplicates.
+func (a *app) UpsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error) {
+	var (
+		sub     *ridmodels.Subscription
+		created bool
+	)
+	err := a.Store.Transact(ctx, func(repo repos.Repository) error {
+		subs, err := repo.SearchSubscriptionsByOwner(ctx, s.Cells, s.Owner, "", 0)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error searching Subscriptions of owner")
+		}
+		var old *ridmodels.Subscription
+		for _, candidate := range subs {
+			if !sameCells(candidate.Cells, s.Cells) {
+				continue
+			}
+			if old == nil || candidate.EndTime.After(*old.EndTime) {
+				old = candidate
+			}
+		}
+
+		if old == nil {
+			if err := s.AdjustTimeRange(a.clock.Now(), nil); err != nil {
+				return stacktrace.Propagate(err, "Unable to adjust time range")
+			}
+			existing, err := repo.GetSubscription(ctx, s.ID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error getting Subscription from repo")
+			}
+			if existing != nil {
+				return stacktrace.NewErrorWithCode(dsserr.AlreadyExists, "Subscription %s already exists", s.ID)
+			}
+			if err := a.checkSubscriptionLimits(ctx, repo, s, nil); err != nil {
+				return err // No need to Propagate this error as this stack layer does not add useful information
+			}
+			sub, err = repo.InsertSubscription(ctx, s)
+			if err != nil {
+				return stacktrace.Propagate(err, "Error inserting Subscription into repo")
+			}
+			created = true
+			return nil
+		}
+
+		// Extending a Subscription adds none to the area, so limits on the
+		// number of Subscriptions do not apply.
+		extended := *old
+		extended.StartTime = nil
+		extended.EndTime = old.EndTime
+		if s.EndTime != nil && s.EndTime.After(*old.EndTime) {
+			extended.EndTime = s.EndTime
+		}
+		if s.URL != "" {
+			extended.URL = s.URL
+		}
+		extended.Writer = s.Writer
+		if err := extended.AdjustTimeRange(a.clock.Now(), old); err != nil {
+			return stacktrace.Propagate(err, "Error adjusting time range")
+		}
+		sub, err = repo.UpdateSubscription(ctx, &extended)
+		if err != nil {
+			return stacktrace.Propagate(err, "Error updating Subscription in repo")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return sub, created, nil
+}
+
+// sameCells returns true if "a" and "b" hold the same cells, in any order.
+func sameCells(a, b s2.CellUnion) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sorted := func(cells s2.CellUnion) s2.CellUnion {
+		cells = append(s2.CellUnion(nil), cells...)
+		sort.Slice(cells, func(i, j int) bool { return cells[i] < cells[j] })
+		return cells
+	}
+	return sorted(a).Equal(sorted(b))
+}
+
+// checkSubscriptionLimits returns an Exhausted error if the owner of "s" may
+// not hold another Subscription in its area, or another Subscription at all.
+// When "s" updates "old", "old" is not counted against either limit: only the
+// cells "s" adds to it must have room for another Subscription, and the owner
+// holds no more Subscriptions than before.
+func (a *app) checkSubscriptionLimits(ctx context.Context, repo repos.Repository, s *ridmodels.Subscription, old *ridmodels.Subscription) error {
+	cells := s.Cells
+	if old != nil {
+		cells = addedCells(old.Cells, s.Cells)
+	}
+
+	// Check the user hasn't created too many subscriptions in this area.
+	if len(cells) > 0 {
+		count, err := repo.MaxSubscriptionCountInCellsByOwner(ctx, cells, s.Owner)
 		if err != nil {
 			a.logger.Error("Error fetching max subscription count", zap.Error(err))
 			return stacktrace.Propagate(err,
@@ -83,15 +237,33 @@ func (a *app) InsertSubscription(ctx context.Context, s *ridmodels.Subscription)
 				stacktrace.NewErrorWithCode(dsserr.Exhausted, "Too many existing subscriptions in this area already"),
 				"%s had %d subscriptions in the area", s.Owner, count)
 		}
+	}
 
-		sub, err = repo.InsertSubscription(ctx, s)
+	if old == nil && a.quotas.Limits(quota.Subscriptions) {
+		count, err := repo.CountSubscriptionsByOwner(ctx, s.Owner, a.clock.Now())
 		if err != nil {
-			return stacktrace.Propagate(err, "Error inserting Subscription into repo")
+			return stacktrace.Propagate(err, "Error counting Subscriptions of owner")
 		}
+		if err := a.quotas.Check(quota.Subscriptions, s.Owner, count); err != nil {
+			return err // No need to Propagate this error as this stack layer does not add useful information
+		}
+	}
+	return nil
+}
 
-		return nil
-	})
-	return sub, err
+// addedCells returns the cells of "to" that are not in "from".
+func addedCells(from, to s2.CellUnion) s2.CellUnion {
+	existing := make(map[s2.CellID]bool, len(from))
+	for _, cell := range from {
+		existing[cell] = true
+	}
+	var added s2.CellUnion
+	for _, cell := range to {
+		if !existing[cell] {
+			added = append(added, cell)
+		}
+	}
+	return added
 }
 
 // InsertSubscription implements the App InsertSubscription method
@@ -121,17 +293,8 @@ func (a *app) UpdateSubscription(ctx context.Context, s *ridmodels.Subscription)
 			return stacktrace.Propagate(err, "Error adjusting time range")
 		}
 
-		// Check the user hasn't created too many subscriptions in this area.
-		count, err := repo.MaxSubscriptionCountInCellsByOwner(ctx, s.Cells, s.Owner)
-		if err != nil {
-			a.logger.Error("Error fetching max subscription count", zap.Error(err))
-			return stacktrace.Propagate(err,
-				"Failed to fetch subscription count, rejecting request")
-		}
-		if count >= maxSubscriptionsPerArea {
-			return stacktrace.Propagate(
-				stacktrace.NewErrorWithCode(dsserr.Exhausted, "Too many existing subscriptions in this area already"),
-				"%s had %d subscriptions in the area", s.Owner, count)
+		if err := a.checkSubscriptionLimits(ctx, repo, s, old); err != nil {
+			return err // No need to Propagate this error as this stack layer does not add useful information
 		}
 		sub, err = repo.UpdateSubscription(ctx, s)
 		if err != nil {
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	dsserr "github.com/interuss/dss/pkg/errors"
 	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/quota"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	"github.com/interuss/stacktrace"
 	"github.com/stretchr/testify/require"
@@ -97,7 +99,7 @@ func (store *subscriptionStore) UpdateSubscription(ctx context.Context, s *ridmo
 	return &returnedCopy, nil
 }
 
-func (store *subscriptionStore) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error) {
+func (store *subscriptionStore) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
 	var subs []*ridmodels.Subscription
 
 	res, _ := store.SearchSubscriptions(ctx, cells)
@@ -117,9 +119,17 @@ func (store *subscriptionStore) UpdateNotificationIdxsInCells(ctx context.Contex
 	return subs, nil
 }
 
+func (store *subscriptionStore) GetNotificationIndices(ctx context.Context, id dssmodels.ID) (int, int, error) {
+	sub, ok := store.subs[id]
+	if !ok {
+		return 0, 0, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", id)
+	}
+	return sub.NotificationIndex, sub.NotificationIndex + 1, nil
+}
+
 func (store *subscriptionStore) MaxSubscriptionCountInCellsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) (int, error) {
 	max := 0
-	subs, _ := store.SearchSubscriptionsByOwner(ctx, cells, owner)
+	subs, _ := store.SearchSubscriptionsByOwner(ctx, cells, owner, "", 0)
 
 	cellMap := make(map[s2.CellID]int)
 	for _, s := range subs {
@@ -158,6 +168,41 @@ func (store *subscriptionStore) SearchSubscriptions(ctx context.Context, cells s
 	return subs, nil
 }
 
+func (store *subscriptionStore) ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	var subs []*ridmodels.Subscription
+	for _, s := range store.subs {
+		if s.ID > afterID {
+			subs = append(subs, s)
+		}
+	}
+	sort.Slice(subs, func(i, j int) bool { return subs[i].ID < subs[j].ID })
+	if len(subs) > limit {
+		subs = subs[:limit]
+	}
+	return subs, nil
+}
+
+func (store *subscriptionStore) DeleteExpiredSubscriptions(ctx context.Context, expiredBefore time.Time) (int, error) {
+	deleted := 0
+	for id, s := range store.subs {
+		if s.EndTime != nil && s.EndTime.Before(expiredBefore) {
+			delete(store.subs, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (store *subscriptionStore) CountSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, activeAt time.Time) (int, error) {
+	count := 0
+	for _, s := range store.subs {
+		if s.Owner == owner && (s.EndTime == nil || !s.EndTime.Before(activeAt)) {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func TestBadOwner(t *testing.T) {
 	ctx := context.Background()
 	app, cleanup := setUpSubApp(ctx, t)
@@ -207,7 +252,7 @@ func TestSubscriptionUpdateCells(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, sub)
 
-	subs, err := app.SearchSubscriptionsByOwner(ctx, sub.Cells, owner)
+	subs, err := app.SearchSubscriptionsByOwner(ctx, sub.Cells, owner, "", 0)
 	require.NoError(t, err)
 	require.NotNil(t, subs)
 	require.Len(t, subs, 1)
@@ -295,6 +340,24 @@ func TestInsertSubscriptionsWithTimes(t *testing.T) {
 	}
 }
 
+func TestInsertSubscriptionStartingBeyondMaxFutureWindow(t *testing.T) {
+	ctx := context.Background()
+	app, cleanup := setUpSubApp(ctx, t)
+	defer cleanup()
+
+	ridmodels.MaxFutureWindow = 24 * time.Hour
+	defer func() { ridmodels.MaxFutureWindow = 0 }()
+
+	start := fakeClock.Now().Add(25 * time.Hour)
+	_, err := app.InsertSubscription(ctx, &ridmodels.Subscription{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     dssmodels.Owner(uuid.New().String()),
+		Cells:     s2.CellUnion{s2.CellID(17106221850767130624)},
+		StartTime: &start,
+	})
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+}
+
 func TestUpdateSubscriptionsWithTimes(t *testing.T) {
 	ctx := context.Background()
 	app, cleanup := setUpSubApp(ctx, t)
@@ -447,3 +510,137 @@ func TestInsertTooManySubscription(t *testing.T) {
 	require.Equal(t, stacktrace.GetCode(err), dsserr.Exhausted)
 	require.Nil(t, ret)
 }
+
+func TestUpdateSubscriptionAtLimits(t *testing.T) {
+	Quotas = quota.Quotas{quota.Subscriptions: maxSubscriptionsPerArea + 1}
+	defer func() { Quotas = nil }()
+
+	var (
+		ctx          = context.Background()
+		app, cleanup = setUpSubApp(ctx, t)
+		owner        = dssmodels.Owner(uuid.New().String())
+		cells        = s2.CellUnion{12494535901059219456, 12494535866699481088}
+		elsewhere    = s2.CellUnion{12494535832339742720}
+	)
+	defer cleanup()
+	insert := func(cells s2.CellUnion) (*ridmodels.Subscription, error) {
+		return app.InsertSubscription(ctx, &ridmodels.Subscription{
+			ID:        dssmodels.ID(uuid.New().String()),
+			Owner:     owner,
+			URL:       "https://no/place/like/home",
+			StartTime: &startTime,
+			EndTime:   &endTime,
+			Cells:     cells,
+		})
+	}
+
+	// The owner holds as many Subscriptions as the area allows, and as many
+	// in total as its quota allows.
+	var subs []*ridmodels.Subscription
+	for i := 0; i < maxSubscriptionsPerArea; i++ {
+		sub, err := insert(cells)
+		require.NoError(t, err)
+		subs = append(subs, sub)
+	}
+	outside, err := insert(elsewhere)
+	require.NoError(t, err)
+	_, err = insert(elsewhere)
+	require.Equal(t, dsserr.Exhausted, stacktrace.GetCode(err))
+
+	// Each of them may still be updated, including shrunk and grown back.
+	updated := *subs[0]
+	updated.URL = "https://no/place/like/work"
+	updated.Cells = cells[:1]
+	sub, err := app.UpdateSubscription(ctx, &updated)
+	require.NoError(t, err)
+	require.Equal(t, "https://no/place/like/work", sub.URL)
+
+	updated = *sub
+	updated.Cells = cells
+	_, err = app.UpdateSubscription(ctx, &updated)
+	require.NoError(t, err)
+
+	updated = *outside
+	updated.URL = "https://no/place/like/work"
+	outside, err = app.UpdateSubscription(ctx, &updated)
+	require.NoError(t, err)
+
+	// A Subscription may not grow into cells already holding the maximum.
+	updated = *outside
+	updated.Cells = append(cells[:1:1], elsewhere...)
+	_, err = app.UpdateSubscription(ctx, &updated)
+	require.Equal(t, dsserr.Exhausted, stacktrace.GetCode(err))
+}
+
+func TestUpsertSubscriptionExtendsRatherThanDuplicates(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		app, cleanup = setUpSubApp(ctx, t)
+		owner        = dssmodels.Owner("bob")
+		cells        = s2.CellUnion{12494535901059219456, 12494535866699481088}
+		start        = fakeClock.Now().Add(time.Minute)
+		end          = start.Add(time.Hour)
+		later        = end.Add(time.Hour)
+	)
+	defer cleanup()
+
+	sub, created, err := app.UpsertSubscription(ctx, &ridmodels.Subscription{
+		ID:        dssmodels.ID(uuid.New().String()),
+		Owner:     owner,
+		URL:       "https://no/place/like/home",
+		StartTime: &start,
+		EndTime:   &end,
+		Cells:     cells,
+	})
+	require.NoError(t, err)
+	require.True(t, created)
+
+	// Upserting over the same area, with its cells in another order, extends
+	// the existing Subscription.
+	extended, created, err := app.UpsertSubscription(ctx, &ridmodels.Subscription{
+		ID:      dssmodels.ID(uuid.New().String()),
+		Owner:   owner,
+		URL:     "https://no/place/like/work",
+		EndTime: &later,
+		Cells:   s2.CellUnion{cells[1], cells[0]},
+	})
+	require.NoError(t, err)
+	require.False(t, created)
+	require.Equal(t, sub.ID, extended.ID)
+	require.Equal(t, "https://no/place/like/work", extended.URL)
+	require.True(t, extended.StartTime.Equal(start))
+	require.True(t, extended.EndTime.Equal(later))
+
+	// Upserting an earlier end time does not shorten it.
+	extended, created, err = app.UpsertSubscription(ctx, &ridmodels.Subscription{
+		ID:      dssmodels.ID(uuid.New().String()),
+		Owner:   owner,
+		EndTime: &end,
+		Cells:   cells,
+	})
+	require.NoError(t, err)
+	require.False(t, created)
+	require.True(t, extended.EndTime.Equal(later))
+
+	subs, err := app.SearchSubscriptionsByOwner(ctx, cells, owner, "", 0)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+
+	// Another area, or another owner, gets a Subscription of its own.
+	_, created, err = app.UpsertSubscription(ctx, &ridmodels.Subscription{
+		ID:      dssmodels.ID(uuid.New().String()),
+		Owner:   owner,
+		EndTime: &end,
+		Cells:   cells[:1],
+	})
+	require.NoError(t, err)
+	require.True(t, created)
+	_, created, err = app.UpsertSubscription(ctx, &ridmodels.Subscription{
+		ID:      dssmodels.ID(uuid.New().String()),
+		Owner:   dssmodels.Owner("alice"),
+		EndTime: &end,
+		Cells:   cells,
+	})
+	require.NoError(t, err)
+	require.True(t, created)
+}
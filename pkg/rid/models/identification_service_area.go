@@ -101,6 +101,9 @@ func (i *IdentificationServiceArea) SetExtents(extents *ridpb.Volume4D) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "Error calculating covering from polygon")
 	}
+	if err := geo.ValidateStoredCells(i.Cells); err != nil {
+		return stacktrace.Propagate(err, "Error validating covering of polygon")
+	}
 	return nil
 }
 
@@ -120,6 +123,9 @@ func (i *IdentificationServiceArea) AdjustTimeRange(now time.Time, old *Identifi
 		if now.Sub(*i.StartTime) > maxClockSkew {
 			return stacktrace.NewErrorWithCode(dsserr.BadRequest, "IdentificationServiceArea time_start must not be in the past")
 		}
+		if MaxFutureWindow > 0 && i.StartTime.Sub(now) > MaxFutureWindow {
+			return stacktrace.NewErrorWithCode(dsserr.BadRequest, "IdentificationServiceArea time_start must not be more than %s in the future", MaxFutureWindow)
+		}
 	}
 
 	// If EndTime was omitted default to the existing ISA's EndTime.
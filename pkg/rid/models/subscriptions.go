@@ -5,6 +5,7 @@ import (
 
 	"github.com/interuss/dss/pkg/api/v1/ridpb"
 	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	"google.golang.org/protobuf/proto"
 
@@ -21,6 +22,11 @@ var (
 	// maxClockSkew is the largest allowed interval between the StartTime of a new
 	// subscription and the server's idea of the current time.
 	maxClockSkew = time.Minute * 5
+
+	// MaxFutureWindow is the furthest in the future that an
+	// IdentificationServiceArea or Subscription may start; 0 does not limit
+	// it.
+	MaxFutureWindow time.Duration
 )
 
 // Subscription represents a USS subscription over a given 4D volume.
@@ -131,6 +137,9 @@ func (s *Subscription) SetExtents(extents *ridpb.Volume4D) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "Error calculating covering from polygon")
 	}
+	if err := geo.ValidateStoredCells(s.Cells); err != nil {
+		return stacktrace.Propagate(err, "Error validating covering of polygon")
+	}
 	return nil
 }
 
@@ -150,6 +159,9 @@ func (s *Subscription) AdjustTimeRange(now time.Time, old *Subscription) error {
 		if now.Sub(*s.StartTime) > maxClockSkew {
 			return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription time_start must not be in the past")
 		}
+		if MaxFutureWindow > 0 && s.StartTime.Sub(now) > MaxFutureWindow {
+			return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Subscription time_start must not be more than %s in the future", MaxFutureWindow)
+		}
 	}
 
 	// If EndTime was omitted default to the existing subscription's EndTime.
@@ -0,0 +1,156 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/interuss/dss/pkg/api/v1/ridv22apb"
+	"github.com/interuss/dss/pkg/geo"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/stacktrace"
+)
+
+// F3411-22a designates USS endpoints by the base URL of the USS, while the
+// earlier version designates them by their full URL, which is what the store
+// keeps. The URLs of entities written through F3411-22a are therefore stored
+// as the endpoints the earlier version expects, so that both versions may
+// serve the same entities during a migration.
+//
+// The mapping back to base URLs is lossy: a URL written through the earlier
+// version is served through F3411-22a without its endpoint path, but a URL
+// that does not end in that path is served unchanged, as if it were the base
+// URL of the USS.
+const (
+	// v22aFlightsPath is the path, relative to the base URL of a USS, of the
+	// flights endpoint of an ISA.
+	v22aFlightsPath = "/uss/flights"
+	// v22aISANotificationsPath is the path, relative to the base URL of a USS,
+	// of the endpoint notified of changes to ISAs.
+	v22aISANotificationsPath = "/uss/identification_service_areas"
+)
+
+// SetV22aUSSBaseURL sets the URL of the IdentificationServiceArea to the
+// flights endpoint of the USS at baseURL.
+func (i *IdentificationServiceArea) SetV22aUSSBaseURL(baseURL string) {
+	i.URL = strings.TrimSuffix(baseURL, "/") + v22aFlightsPath
+}
+
+// ToV22aProto converts an IdentificationServiceArea struct to an F3411-22a
+// IdentificationServiceArea proto for API consumption.
+func (i *IdentificationServiceArea) ToV22aProto() (*ridv22apb.IdentificationServiceArea, error) {
+	result := &ridv22apb.IdentificationServiceArea{
+		Id:         i.ID.String(),
+		Owner:      i.Owner.String(),
+		UssBaseUrl: strings.TrimSuffix(i.URL, v22aFlightsPath),
+		Version:    i.Version.String(),
+	}
+
+	var err error
+	if result.TimeStart, err = v22aTimeToProto(i.StartTime); err != nil {
+		return nil, stacktrace.Propagate(err, "Error converting start time to proto")
+	}
+	if result.TimeEnd, err = v22aTimeToProto(i.EndTime); err != nil {
+		return nil, stacktrace.Propagate(err, "Error converting end time to proto")
+	}
+	return result, nil
+}
+
+// SetV22aExtents performs some data validation and sets the 4D volume on the
+// IdentificationServiceArea.
+func (i *IdentificationServiceArea) SetV22aExtents(extents *ridv22apb.Volume4D) error {
+	vol4, cells, err := v22aExtents(extents)
+	if err != nil {
+		return err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	i.StartTime = vol4.StartTime
+	i.EndTime = vol4.EndTime
+	i.AltitudeLo = vol4.SpatialVolume.AltitudeLo
+	i.AltitudeHi = vol4.SpatialVolume.AltitudeHi
+	i.Cells = cells
+	return nil
+}
+
+// SetV22aUSSBaseURL sets the URL of the Subscription to the ISA notification
+// endpoint of the USS at baseURL.
+func (s *Subscription) SetV22aUSSBaseURL(baseURL string) {
+	s.URL = strings.TrimSuffix(baseURL, "/") + v22aISANotificationsPath
+}
+
+// ToV22aNotifyProto converts a subscription to an F3411-22a
+// SubscriberToNotify proto for API consumption.
+func (s *Subscription) ToV22aNotifyProto() *ridv22apb.SubscriberToNotify {
+	return &ridv22apb.SubscriberToNotify{
+		Url: strings.TrimSuffix(s.URL, v22aISANotificationsPath),
+		Subscriptions: []*ridv22apb.SubscriptionState{
+			{
+				NotificationIndex: int32(s.NotificationIndex),
+				SubscriptionId:    s.ID.String(),
+			},
+		},
+	}
+}
+
+// ToV22aProto converts a subscription struct to an F3411-22a Subscription
+// proto for API consumption.
+func (s *Subscription) ToV22aProto() (*ridv22apb.Subscription, error) {
+	result := &ridv22apb.Subscription{
+		Id:                s.ID.String(),
+		Owner:             s.Owner.String(),
+		UssBaseUrl:        strings.TrimSuffix(s.URL, v22aISANotificationsPath),
+		NotificationIndex: int32(s.NotificationIndex),
+		Version:           s.Version.String(),
+	}
+
+	var err error
+	if result.TimeStart, err = v22aTimeToProto(s.StartTime); err != nil {
+		return nil, stacktrace.Propagate(err, "Error converting start time to proto")
+	}
+	if result.TimeEnd, err = v22aTimeToProto(s.EndTime); err != nil {
+		return nil, stacktrace.Propagate(err, "Error converting end time to proto")
+	}
+	return result, nil
+}
+
+// SetV22aExtents performs some data validation and sets the 4D volume on the
+// Subscription.
+func (s *Subscription) SetV22aExtents(extents *ridv22apb.Volume4D) error {
+	vol4, cells, err := v22aExtents(extents)
+	if err != nil {
+		return err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	s.StartTime = vol4.StartTime
+	s.EndTime = vol4.EndTime
+	s.AltitudeLo = vol4.SpatialVolume.AltitudeLo
+	s.AltitudeHi = vol4.SpatialVolume.AltitudeHi
+	s.Cells = cells
+	return nil
+}
+
+// v22aExtents returns the 4D volume of extents, and the cells covering it.
+func v22aExtents(extents *ridv22apb.Volume4D) (*dssmodels.Volume4D, s2.CellUnion, error) {
+	vol4, err := dssmodels.Volume4DFromRIDV22aProto(extents)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Error converting extents from proto")
+	}
+	cells, err := vol4.CalculateSpatialCovering()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Error calculating covering of volume")
+	}
+	if err := geo.ValidateStoredCells(cells); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Error validating covering of volume")
+	}
+	return vol4, cells, nil
+}
+
+func v22aTimeToProto(t *time.Time) (*ridv22apb.Time, error) {
+	if t == nil {
+		return nil, nil
+	}
+	ts, err := ptypes.TimestampProto(*t)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return &ridv22apb.Time{Value: ts, Format: dssmodels.TimeFormatRFC3339}, nil
+}
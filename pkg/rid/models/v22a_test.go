@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestV22aISAURLMapping(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		url        string
+		ussBaseURL string
+	}{
+		{"flights endpoint", "https://uss.example.com/uss/flights", "https://uss.example.com"},
+		{"flights endpoint under a path", "https://uss.example.com/rid/uss/flights", "https://uss.example.com/rid"},
+		// Earlier-version URLs not ending in the flights path cannot be
+		// mapped back to a base URL, and are served unchanged.
+		{"other endpoint", "https://uss.example.com/flights", "https://uss.example.com/flights"},
+		{"trailing slash", "https://uss.example.com/uss/flights/", "https://uss.example.com/uss/flights/"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := (&IdentificationServiceArea{URL: tc.url}).ToV22aProto()
+			require.NoError(t, err)
+			require.Equal(t, tc.ussBaseURL, p.UssBaseUrl)
+		})
+	}
+
+	isa := &IdentificationServiceArea{}
+	isa.SetV22aUSSBaseURL("https://uss.example.com/")
+	require.Equal(t, "https://uss.example.com/uss/flights", isa.URL)
+}
+
+func TestV22aSubscriptionURLMapping(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		url        string
+		ussBaseURL string
+	}{
+		{"notifications endpoint", "https://uss.example.com/uss/identification_service_areas", "https://uss.example.com"},
+		// Earlier-version URLs not ending in the notifications path cannot be
+		// mapped back to a base URL, and are served unchanged.
+		{"other endpoint", "https://uss.example.com/isa_callback", "https://uss.example.com/isa_callback"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Subscription{URL: tc.url}
+			p, err := s.ToV22aProto()
+			require.NoError(t, err)
+			require.Equal(t, tc.ussBaseURL, p.UssBaseUrl)
+			require.Equal(t, tc.ussBaseURL, s.ToV22aNotifyProto().Url)
+		})
+	}
+
+	sub := &Subscription{}
+	sub.SetV22aUSSBaseURL("https://uss.example.com")
+	require.Equal(t, "https://uss.example.com/uss/identification_service_areas", sub.URL)
+}
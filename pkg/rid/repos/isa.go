@@ -2,6 +2,7 @@ package repos
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/golang/geo/s2"
@@ -9,6 +10,13 @@ import (
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 )
 
+var (
+	// ErrIncompleteSearch is the root cause of the error returned by a search
+	// whose context expired before all matching entities could be read. The
+	// entities read before the interruption are returned alongside the error.
+	ErrIncompleteSearch = errors.New("Search interrupted before all results were read")
+)
+
 // ISA is an interface to a storage layer for the ISA entity
 type ISA interface {
 	// Returns nil, nil if not found
@@ -16,7 +24,8 @@ type ISA interface {
 
 	// DeleteISA deletes the IdentificationServiceArea identified by "id" and owned by "owner".
 	// Returns the delete IdentificationServiceArea and all Subscriptions affected by the delete.
-	// Returns nil, nil if ID, version not found
+	// Returns nil, nil if ID not found, and a VersionMismatch error if the
+	// stored version differs from isa.Version.
 	DeleteISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error)
 
 	// InsertISA inserts or updates an ISA.
@@ -26,6 +35,41 @@ type ISA interface {
 	// Returns nil, nil if ID, version not found
 	UpdateISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error)
 
-	// SearchISAs returns all subscriptions ownded by "owner" in "cells".
-	SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error)
+	// SearchISAs returns all ISAs in "cells", or anywhere if "cells" is empty.
+	// If "limit" is set, only up to "limit" ISAs with an ID greater than
+	// "afterID" are returned, ordered by ID, so that the last ISA of one page
+	// can be used as the cursor for the next regardless of ISAs created since.
+	// If interrupted by ctx expiring, the ISAs read so far are returned along
+	// with an error caused by ErrIncompleteSearch.
+	SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// AnyISAInCells returns true if any ISA in "cells" has not ended by
+	// "activeAt", reading at most one ISA to find out.
+	AnyISAInCells(ctx context.Context, cells s2.CellUnion, activeAt time.Time) (bool, error)
+
+	// ListISAsModifiedSince returns up to "limit" ISAs last written after
+	// "since", ordered by their version and then by ID. If "afterID" is set,
+	// ISAs written exactly at "since" with an ID greater than "afterID" are
+	// also returned, so that the last ISA of one page can be used as the
+	// cursor for the next. Deleted ISAs are not reported.
+	ListISAsModifiedSince(ctx context.Context, since time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// ListISAs returns up to "limit" ISAs with an ID greater than "afterID",
+	// or from the first ISA if "afterID" is empty, ordered by ID. Paging with
+	// the ID of the last ISA of each page visits every ISA exactly once,
+	// except for those written or deleted while paging.
+	ListISAs(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error)
+
+	// DeleteExpiredISAs deletes every ISA that ended before "expiredBefore"
+	// and returns the number deleted.
+	DeleteExpiredISAs(ctx context.Context, expiredBefore time.Time) (int, error)
+
+	// CountISAsByInterval divides the time from "start" to "end" into
+	// consecutive buckets "interval" long, the last of which ends at "end",
+	// and returns the number of ISAs active at any point of each bucket.
+	CountISAsByInterval(ctx context.Context, start, end time.Time, interval time.Duration) ([]int, error)
+
+	// CountISAsByOwner returns the number of ISAs owned by "owner" that have
+	// not ended by "activeAt".
+	CountISAsByOwner(ctx context.Context, owner dssmodels.Owner, activeAt time.Time) (int, error)
 }
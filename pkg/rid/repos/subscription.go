@@ -2,6 +2,7 @@ package repos
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/geo/s2"
 	dssmodels "github.com/interuss/dss/pkg/models"
@@ -15,7 +16,8 @@ type Subscription interface {
 
 	// DeleteSubscription deletes the IdentificationServiceArea identified by "id" and owned by "owner".
 	// Returns the delete IdentificationServiceArea and all Subscriptions affected by the delete.
-	// Returns nil, nil if ID, version not found
+	// Returns nil, nil if ID not found, and a VersionMismatch error if the
+	// stored version differs from sub.Version.
 	DeleteSubscription(ctx context.Context, sub *ridmodels.Subscription) (*ridmodels.Subscription, error)
 
 	// InsertSubscription inserts or updates an ISA.
@@ -28,13 +30,32 @@ type Subscription interface {
 	// SearchSubscriptions returns all subscriptions ownded by in "cells".
 	SearchSubscriptions(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error)
 
-	// SearchSubscriptionsByOwner returns all subscriptions ownded by "owner" in "cells".
-	SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error)
+	// SearchSubscriptionsByOwner returns all subscriptions ownded by "owner" in "cells",
+	// or anywhere if "cells" is empty, paged as by ISA.SearchISAs.
+	SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error)
 
 	// UpdateNotificationIdxsInCells incremement the notification for each sub in the given cells.
 	UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error)
 
+	// GetNotificationIndices returns the current notification index of the
+	// Subscription identified by "id", and the index its next notification
+	// will carry, without changing either. Returns an error with code NotFound
+	// if the Subscription does not exist.
+	GetNotificationIndices(ctx context.Context, id dssmodels.ID) (current int, next int, err error)
+
 	// MaxSubscriptionCountInCellsByOwner finds, out of a set of cells, the cell with the most subscriptions
 	// belonging to the given owner, and returns that number.
 	MaxSubscriptionCountInCellsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) (int, error)
+
+	// ListSubscriptions returns up to "limit" Subscriptions with an ID greater
+	// than "afterID", ordered by ID; see ISA.ListISAs.
+	ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error)
+
+	// DeleteExpiredSubscriptions deletes every Subscription that ended before
+	// "expiredBefore" and returns the number deleted.
+	DeleteExpiredSubscriptions(ctx context.Context, expiredBefore time.Time) (int, error)
+
+	// CountSubscriptionsByOwner returns the number of Subscriptions owned by
+	// "owner" that have not ended by "activeAt".
+	CountSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, activeAt time.Time) (int, error)
 }
@@ -8,10 +8,12 @@ import (
 	"github.com/interuss/dss/pkg/api/v1/ridpb"
 	"github.com/interuss/dss/pkg/auth"
 	dsserr "github.com/interuss/dss/pkg/errors"
-	"github.com/interuss/dss/pkg/geo"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/dss/pkg/rid/repos"
 	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // GetIdentificationServiceArea returns a single ISA for a given ID.
@@ -19,19 +21,9 @@ func (s *Server) GetIdentificationServiceArea(
 	ctx context.Context, req *ridpb.GetIdentificationServiceAreaRequest) (
 	*ridpb.GetIdentificationServiceAreaResponse, error) {
 
-	id, err := dssmodels.IDFromString(req.Id)
+	isa, err := s.getISA(ctx, req.GetId())
 	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
-	isa, err := s.App.GetISA(ctx, id)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not get ISA from application layer")
-	}
-	if isa == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "ISA %s not found", req.GetId())
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 	p, err := isa.ToProto()
 	if err != nil {
@@ -48,42 +40,15 @@ func (s *Server) CreateIdentificationServiceArea(
 	*ridpb.PutIdentificationServiceAreaResponse, error) {
 
 	params := req.GetParams()
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
-
-	owner, ok := auth.OwnerFromContext(ctx)
-	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
-	}
 	if params == nil {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
 	}
-	// TODO: put the validation logic in the models layer
-	if params.FlightsUrl == "" {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required flightsURL")
-	}
-	if params.Extents == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
-	}
-	id, err := dssmodels.IDFromString(req.Id)
-	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
-	}
-
-	isa := &ridmodels.IdentificationServiceArea{
-		ID:     id,
-		URL:    params.GetFlightsUrl(),
-		Owner:  owner,
-		Writer: s.Locality,
-	}
-
-	if err := isa.SetExtents(params.Extents); err != nil {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
-	}
 
-	insertedISA, subscribers, err := s.App.InsertISA(ctx, isa)
+	insertedISA, subscribers, err := s.putISA(ctx, req.GetId(), nil, func(isa *ridmodels.IdentificationServiceArea) error {
+		return setISAParams(isa, params.GetFlightsUrl(), params.GetExtents())
+	})
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not insert ISA")
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 
 	pbISA, err := insertedISA.ToProto()
@@ -91,14 +56,9 @@ func (s *Server) CreateIdentificationServiceArea(
 		return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
 	}
 
-	pbSubscribers := []*ridpb.SubscriberToNotify{}
-	for _, subscriber := range subscribers {
-		pbSubscribers = append(pbSubscribers, subscriber.ToNotifyProto())
-	}
-
 	return &ridpb.PutIdentificationServiceAreaResponse{
 		ServiceArea: pbISA,
-		Subscribers: pbSubscribers,
+		Subscribers: subscribersToNotify(subscribers),
 	}, nil
 }
 
@@ -113,43 +73,15 @@ func (s *Server) UpdateIdentificationServiceArea(
 	if err != nil {
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
 	}
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
-
-	owner, ok := auth.OwnerFromContext(ctx)
-	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
-	}
-	// TODO: put the validation logic in the models layer
 	if params == nil {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
 	}
-	if params.FlightsUrl == "" {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required flightsURL")
-	}
-	if params.Extents == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
-	}
-	id, err := dssmodels.IDFromString(req.Id)
-	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
-	}
-
-	isa := &ridmodels.IdentificationServiceArea{
-		ID:      dssmodels.ID(id),
-		URL:     params.FlightsUrl,
-		Owner:   owner,
-		Version: version,
-		Writer:  s.Locality,
-	}
-
-	if err := isa.SetExtents(params.Extents); err != nil {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
-	}
 
-	insertedISA, subscribers, err := s.App.UpdateISA(ctx, isa)
+	insertedISA, subscribers, err := s.putISA(ctx, req.GetId(), version, func(isa *ridmodels.IdentificationServiceArea) error {
+		return setISAParams(isa, params.GetFlightsUrl(), params.GetExtents())
+	})
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not update ISA")
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 
 	pbISA, err := insertedISA.ToProto()
@@ -157,14 +89,9 @@ func (s *Server) UpdateIdentificationServiceArea(
 		return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
 	}
 
-	pbSubscribers := []*ridpb.SubscriberToNotify{}
-	for _, subscriber := range subscribers {
-		pbSubscribers = append(pbSubscribers, subscriber.ToNotifyProto())
-	}
-
 	return &ridpb.PutIdentificationServiceAreaResponse{
 		ServiceArea: pbISA,
-		Subscribers: pbSubscribers,
+		Subscribers: subscribersToNotify(subscribers),
 	}, nil
 }
 
@@ -173,37 +100,19 @@ func (s *Server) DeleteIdentificationServiceArea(
 	ctx context.Context, req *ridpb.DeleteIdentificationServiceAreaRequest) (
 	*ridpb.DeleteIdentificationServiceAreaResponse, error) {
 
-	owner, ok := auth.OwnerFromContext(ctx)
-	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
-	}
-	version, err := dssmodels.VersionFromString(req.GetVersion())
+	isa, subscribers, err := s.deleteISA(ctx, req.GetId(), req.GetVersion())
 	if err != nil {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
-	}
-	id, err := dssmodels.IDFromString(req.Id)
-	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
-	}
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
-	isa, subscribers, err := s.App.DeleteISA(ctx, id, owner, version)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not delete ISA")
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 
 	p, err := isa.ToProto()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
 	}
-	sp := make([]*ridpb.SubscriberToNotify, len(subscribers))
-	for i := range subscribers {
-		sp[i] = subscribers[i].ToNotifyProto()
-	}
 
 	return &ridpb.DeleteIdentificationServiceAreaResponse{
 		ServiceArea: p,
-		Subscribers: sp,
+		Subscribers: subscribersToNotify(subscribers),
 	}, nil
 }
 
@@ -212,11 +121,6 @@ func (s *Server) SearchIdentificationServiceAreas(
 	ctx context.Context, req *ridpb.SearchIdentificationServiceAreasRequest) (
 	*ridpb.SearchIdentificationServiceAreasResponse, error) {
 
-	cu, err := geo.AreaToCellIDs(req.GetArea())
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Invalid area")
-	}
-
 	var (
 		earliest *time.Time
 		latest   *time.Time
@@ -238,23 +142,177 @@ func (s *Server) SearchIdentificationServiceAreas(
 		}
 	}
 
+	isas, err := s.searchISAs(ctx, req.GetArea(), earliest, latest)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	areas, err := serviceAreas(isas)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	return &ridpb.SearchIdentificationServiceAreasResponse{
+		ServiceAreas: areas,
+	}, nil
+}
+
+// The helpers below implement the ISA endpoints of every version of the API,
+// whose handlers only convert between their own protos and the models.
+
+// getISA returns the ISA with ID id.
+func (s *Server) getISA(ctx context.Context, id string) (*ridmodels.IdentificationServiceArea, error) {
+	isaID, err := dssmodels.IDFromString(id)
+	if err != nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	isa, err := s.App.GetISA(ctx, isaID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not get ISA from application layer")
+	}
+	if isa == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "ISA %s not found", id)
+	}
+	return isa, nil
+}
+
+// putISA creates the ISA with ID id, or updates it from version if version is
+// set, once set has validated the params of the request and set them on the
+// ISA. It returns the ISA as stored along with the subscribers to notify.
+func (s *Server) putISA(ctx context.Context, id string, version *dssmodels.Version, set func(*ridmodels.IdentificationServiceArea) error) (
+	*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error) {
+
 	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
 	defer cancel()
-	isas, err := s.App.SearchISAs(ctx, cu, earliest, latest)
+
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	}
+	isaID, err := dssmodels.IDFromString(id)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Unable to search ISAs")
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
 	}
 
+	isa := &ridmodels.IdentificationServiceArea{
+		ID:      isaID,
+		Owner:   owner,
+		Version: version,
+		Writer:  s.Locality,
+	}
+	if err := set(isa); err != nil {
+		return nil, nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	if version == nil {
+		insertedISA, subscribers, err := s.App.InsertISA(ctx, isa)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Could not insert ISA")
+		}
+		return insertedISA, subscribers, nil
+	}
+	updatedISA, subscribers, err := s.App.UpdateISA(ctx, isa)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Could not update ISA")
+	}
+	return updatedISA, subscribers, nil
+}
+
+// deleteISA deletes the ISA with ID id at version, and returns it along with
+// the subscribers to notify.
+func (s *Server) deleteISA(ctx context.Context, id string, version string) (
+	*ridmodels.IdentificationServiceArea, []*ridmodels.Subscription, error) {
+
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	}
+	v, err := dssmodels.VersionFromString(version)
+	if err != nil {
+		return nil, nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
+	}
+	isaID, err := dssmodels.IDFromString(id)
+	if err != nil {
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	isa, subscribers, err := s.App.DeleteISA(ctx, isaID, owner, v)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Could not delete ISA")
+	}
+	return isa, subscribers, nil
+}
+
+// searchISAs returns the page of ISAs in area, within the optional earliest
+// and latest times, requested by the pagination headers of the request.
+func (s *Server) searchISAs(ctx context.Context, area string, earliest, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+	cu, err := s.searchCells(ctx, area)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	afterID, limit, err := s.searchPage(ctx)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	isas, err := s.App.SearchISAs(ctx, cu, earliest, latest, afterID, limit)
+	if err != nil {
+		if !s.AllowPartialSearchResults || stacktrace.RootCause(err) != repos.ErrIncompleteSearch {
+			return nil, stacktrace.Propagate(err, "Unable to search ISAs")
+		}
+		if err := grpc.SetHeader(ctx, metadata.Pairs(PartialResultsHeader, "true")); err != nil {
+			return nil, stacktrace.Propagate(err, "Unable to flag partial results")
+		}
+	}
+
+	if len(isas) > 0 {
+		if err := setNextPageToken(ctx, isas[len(isas)-1].ID, len(isas), limit); err != nil {
+			return nil, err // No need to Propagate this error as this stack layer does not add useful information
+		}
+	}
+	return isas, nil
+}
+
+// setISAParams validates the flights URL and extents of a request creating or
+// updating an ISA, and sets them on isa.
+func setISAParams(isa *ridmodels.IdentificationServiceArea, flightsURL string, extents *ridpb.Volume4D) error {
+	// TODO: put the validation logic in the models layer
+	if flightsURL == "" {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required flightsURL")
+	}
+	if extents == nil {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
+	}
+	isa.URL = flightsURL
+	if err := isa.SetExtents(extents); err != nil {
+		return stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
+	}
+	return nil
+}
+
+func serviceAreas(isas []*ridmodels.IdentificationServiceArea) ([]*ridpb.IdentificationServiceArea, error) {
 	areas := make([]*ridpb.IdentificationServiceArea, len(isas))
-	for i := range isas {
-		a, err := isas[i].ToProto()
+	for i, isa := range isas {
+		a, err := isa.ToProto()
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
 		}
 		areas[i] = a
 	}
+	return areas, nil
+}
 
-	return &ridpb.SearchIdentificationServiceAreasResponse{
-		ServiceAreas: areas,
-	}, nil
+func subscribersToNotify(subscribers []*ridmodels.Subscription) []*ridpb.SubscriberToNotify {
+	result := []*ridpb.SubscriberToNotify{}
+	for _, subscriber := range subscribers {
+		result = append(result, subscriber.ToNotifyProto())
+	}
+	return result
 }
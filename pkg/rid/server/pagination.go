@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// PageSizeHeader is the request header setting the maximum number of
+	// results of a search response, up to the server's MaxPageSize.
+	PageSizeHeader = "x-dss-page-size"
+	// PageTokenHeader is the request header requesting the page of search
+	// results following the one whose response set NextPageTokenHeader to it.
+	PageTokenHeader = "x-dss-page-token"
+	// NextPageTokenHeader is the response header set when a search response
+	// was truncated to its page size, to the token of the following page.
+	NextPageTokenHeader = "x-dss-next-page-token"
+)
+
+// searchPage returns the ID after which results of the search requested in
+// ctx start, and the number of results to return, or 0 for every result.
+//
+// Pagination is requested through request headers, rather than fields of the
+// search requests, so that the remote ID API remains the standard one. The
+// http-gateway passes x-dss- headers through to and from HTTP clients.
+func (s *Server) searchPage(ctx context.Context) (dssmodels.ID, int, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	limit := s.PageSize
+	if vs := md.Get(PageSizeHeader); len(vs) > 0 {
+		size, err := strconv.Atoi(vs[0])
+		switch {
+		case err != nil || size <= 0:
+			return "", 0, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid page size %q", vs[0])
+		case s.MaxPageSize > 0 && size > s.MaxPageSize:
+			return "", 0, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+				"Page size must be between 1 and %d", s.MaxPageSize)
+		}
+		limit = size
+	}
+	// Searches are bounded by the maximum page size even if the client did
+	// not request a page.
+	if limit == 0 || (s.MaxPageSize > 0 && limit > s.MaxPageSize) {
+		limit = s.MaxPageSize
+	}
+
+	vs := md.Get(PageTokenHeader)
+	if len(vs) == 0 || vs[0] == "" {
+		return "", limit, nil
+	}
+	afterID, err := decodePageToken(vs[0])
+	if err != nil {
+		return "", 0, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid page token")
+	}
+	return afterID, limit, nil
+}
+
+// setNextPageToken sets the NextPageTokenHeader of the response to a search
+// that returned "returned" results, the last of which has ID "lastID", if
+// the results were truncated to "limit".
+func setNextPageToken(ctx context.Context, lastID dssmodels.ID, returned, limit int) error {
+	if limit == 0 || returned < limit {
+		return nil
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(NextPageTokenHeader, encodePageToken(lastID))); err != nil {
+		return stacktrace.Propagate(err, "Unable to set next page token")
+	}
+	return nil
+}
+
+// encodePageToken returns an opaque token identifying the position after the
+// entity with ID afterID. IDs are the ordering key of search results, so the
+// position remains valid whatever is written between pages.
+func encodePageToken(afterID dssmodels.ID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(afterID.String()))
+}
+
+func decodePageToken(token string) (dssmodels.ID, error) {
+	cursor, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Error decoding page token")
+	}
+	return dssmodels.IDFromString(string(cursor))
+}
@@ -1,10 +1,21 @@
 package server
 
 import (
+	"context"
 	"time"
 
+	"github.com/golang/geo/s2"
 	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
 	"github.com/interuss/dss/pkg/rid/application"
+	"github.com/interuss/stacktrace"
+)
+
+const (
+	// PartialResultsHeader is the response header set when a search response
+	// contains only the results found before the search timed out.
+	PartialResultsHeader = "x-dss-partial-results"
 )
 
 var (
@@ -30,6 +41,55 @@ type Server struct {
 	App      application.App
 	Timeout  time.Duration
 	Locality string
+	// AllowPartialSearchResults enables responding with the ISAs found so far
+	// when a search times out, flagged with the PartialResultsHeader response
+	// header, rather than failing the request.
+	AllowPartialSearchResults bool
+	// GlobalSearchScope, if set, allows clients whose access token claims it
+	// to search without an area, covering the whole world. Searches without
+	// an area are rejected otherwise.
+	GlobalSearchScope auth.Scope
+	// PageSize is the number of results of searches whose request does not
+	// set the PageSizeHeader, beyond which their response carries a
+	// NextPageTokenHeader; 0 returns every result.
+	PageSize int
+	// MaxPageSize is the largest page size a search may request; 0 means no
+	// limit.
+	MaxPageSize int
+	// MaxAreaKm2, if positive, is the largest area a search may cover, beyond
+	// which it is rejected before querying the store.
+	MaxAreaKm2 float64
+}
+
+// searchCells returns the cells covering "area", or no cells for a search of
+// the whole world if "area" is empty and the client may search globally.
+func (s *Server) searchCells(ctx context.Context, area string) (s2.CellUnion, error) {
+	if area == "" {
+		if s.GlobalSearchScope == "" {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing area")
+		}
+		scopes, _ := auth.ScopesFromContext(ctx)
+		if _, ok := scopes[s.GlobalSearchScope]; !ok {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+				"Missing area; searching without an area requires %s", s.GlobalSearchScope)
+		}
+		return nil, nil
+	}
+	if s.MaxAreaKm2 > 0 {
+		km2, err := geo.AreaKm2(area)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Invalid area")
+		}
+		if km2 > s.MaxAreaKm2 {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest,
+				"Area of %.1fkm² exceeds the maximum of %.1fkm² searched at once", km2, s.MaxAreaKm2)
+		}
+	}
+	cu, err := geo.AreaToCellIDs(area)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid area")
+	}
+	return cu, nil
 }
 
 // AuthScopes returns a map of endpoint to required Oauth scope.
@@ -12,6 +12,7 @@ import (
 	"github.com/interuss/dss/pkg/geo/testdata"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/dss/pkg/rid/repos"
 
 	"github.com/golang/geo/s2"
 	"github.com/golang/protobuf/ptypes"
@@ -20,6 +21,8 @@ import (
 	"github.com/interuss/stacktrace"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 var timeout = time.Second * 10
@@ -58,6 +61,20 @@ func (ma *mockApp) UpdateSubscription(ctx context.Context, s *ridmodels.Subscrip
 	return args.Get(0).(*ridmodels.Subscription), args.Error(1)
 }
 
+func (ma *mockApp) UpsertSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	args := ma.Called(ctx, s)
+	return args.Get(0).(*ridmodels.Subscription), args.Bool(1), args.Error(2)
+}
+
+func (ma *mockApp) GetNotificationIndices(ctx context.Context, id dssmodels.ID) (int, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	args := ma.Called(ctx, id)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
 func (ma *mockApp) GetSubscription(ctx context.Context, id dssmodels.ID) (*ridmodels.Subscription, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -72,10 +89,10 @@ func (ma *mockApp) DeleteSubscription(ctx context.Context, id dssmodels.ID, owne
 	return args.Get(0).(*ridmodels.Subscription), args.Error(1)
 }
 
-func (ma *mockApp) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error) {
+func (ma *mockApp) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	args := ma.Called(ctx, cells, owner)
+	args := ma.Called(ctx, cells, owner, afterID, limit)
 	return args.Get(0).([]*ridmodels.Subscription), args.Error(1)
 }
 
@@ -103,13 +120,48 @@ func (ma *mockApp) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationS
 	return args.Get(0).(*ridmodels.IdentificationServiceArea), args.Get(1).([]*ridmodels.Subscription), args.Error(2)
 }
 
-func (ma *mockApp) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+func (ma *mockApp) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	args := ma.Called(ctx, cells, earliest, latest)
+	args := ma.Called(ctx, cells, earliest, latest, afterID, limit)
 	return args.Get(0).([]*ridmodels.IdentificationServiceArea), args.Error(1)
 }
 
+func (ma *mockApp) AnyISAInCells(ctx context.Context, cells s2.CellUnion) (bool, error) {
+	args := ma.Called(ctx, cells)
+	return args.Bool(0), args.Error(1)
+}
+
+func (ma *mockApp) CollectGarbage(ctx context.Context) (int, int, error) {
+	args := ma.Called(ctx)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+func (ma *mockApp) RefreshStatistics(ctx context.Context) ([]string, error) {
+	args := ma.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (ma *mockApp) ListISAsModifiedSince(ctx context.Context, since time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	args := ma.Called(ctx, since, afterID, limit)
+	return args.Get(0).([]*ridmodels.IdentificationServiceArea), args.Error(1)
+}
+
+func (ma *mockApp) ListISAs(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	args := ma.Called(ctx, afterID, limit)
+	return args.Get(0).([]*ridmodels.IdentificationServiceArea), args.Error(1)
+}
+
+func (ma *mockApp) ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	args := ma.Called(ctx, afterID, limit)
+	return args.Get(0).([]*ridmodels.Subscription), args.Error(1)
+}
+
+func (ma *mockApp) CountISAsByInterval(ctx context.Context, start, end time.Time, interval time.Duration) ([]int, error) {
+	args := ma.Called(ctx, start, end, interval)
+	return args.Get(0).([]int), args.Error(1)
+}
+
 func TestDeleteSubscription(t *testing.T) {
 	ctx := auth.ContextWithOwner(context.Background(), "foo")
 	version, _ := dssmodels.VersionFromString("bar")
@@ -234,7 +286,7 @@ func TestCreateSubscription(t *testing.T) {
 		t.Run(r.name, func(t *testing.T) {
 			ma := &mockApp{}
 			if r.wantErr == stacktrace.ErrorCode(0) {
-				ma.On("SearchISAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+				ma.On("SearchISAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, dssmodels.ID(""), 0).Return(
 					[]*ridmodels.IdentificationServiceArea(nil), nil)
 				ma.On("InsertSubscription", mock.Anything, r.wantSubscription).Return(
 					r.wantSubscription, nil,
@@ -282,7 +334,7 @@ func TestCreateSubscriptionResponseIncludesISAs(t *testing.T) {
 
 	ma := &mockApp{}
 
-	ma.On("SearchISAs", mock.Anything, cells, mock.Anything, mock.Anything).Return(isas, nil)
+	ma.On("SearchISAs", mock.Anything, cells, mock.Anything, mock.Anything, dssmodels.ID(""), 0).Return(isas, nil)
 	ma.On("InsertSubscription", mock.Anything, sub).Return(sub, nil)
 	s := &Server{
 		App: ma,
@@ -392,7 +444,7 @@ func TestSearchSubscriptions(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	ma.On("SearchSubscriptionsByOwner", mock.Anything, mock.Anything, owner).Return(
+	ma.On("SearchSubscriptionsByOwner", mock.Anything, mock.Anything, owner, dssmodels.ID(""), 0).Return(
 		[]*ridmodels.Subscription{
 			{
 				ID:                dssmodels.ID(uuid.New().String()),
@@ -645,7 +697,7 @@ func TestSearchIdentificationServiceAreas(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
-	ma.On("SearchISAs", mock.Anything, mock.Anything, (*time.Time)(nil), (*time.Time)(nil)).Return(
+	ma.On("SearchISAs", mock.Anything, mock.Anything, (*time.Time)(nil), (*time.Time)(nil), dssmodels.ID(""), 0).Return(
 		[]*ridmodels.IdentificationServiceArea{
 			{
 				ID:    dssmodels.ID(uuid.New().String()),
@@ -664,6 +716,248 @@ func TestSearchIdentificationServiceAreas(t *testing.T) {
 	require.True(t, ma.AssertExpectations(t))
 }
 
+// headerRecordingStream captures the headers set by a handler.
+type headerRecordingStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *headerRecordingStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestSearchIdentificationServiceAreasPartialResults(t *testing.T) {
+	isas := []*ridmodels.IdentificationServiceArea{
+		{
+			ID:    dssmodels.ID(uuid.New().String()),
+			Owner: dssmodels.Owner("me-myself-and-i"),
+			URL:   "https://no/place/like/home",
+		},
+	}
+	interrupted := stacktrace.Propagate(repos.ErrIncompleteSearch, "Timed out")
+
+	for _, r := range []struct {
+		name         string
+		allowPartial bool
+	}{
+		{name: "partial-results-rejected-by-default"},
+		{name: "partial-results-returned-and-flagged-when-allowed", allowPartial: true},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			var (
+				ma     = &mockApp{}
+				stream = &headerRecordingStream{}
+				ctx    = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+				s      = &Server{
+					App:                       ma,
+					Timeout:                   timeout,
+					AllowPartialSearchResults: r.allowPartial,
+				}
+			)
+			ma.On("SearchISAs", mock.Anything, mock.Anything, (*time.Time)(nil), (*time.Time)(nil), dssmodels.ID(""), 0).Return(isas, interrupted)
+
+			resp, err := s.SearchIdentificationServiceAreas(ctx, &ridpb.SearchIdentificationServiceAreasRequest{
+				Area: testdata.Loop,
+			})
+			if !r.allowPartial {
+				require.Error(t, err)
+				require.Nil(t, resp)
+				require.Empty(t, stream.header.Get(PartialResultsHeader))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, resp.ServiceAreas, 1)
+			require.Equal(t, []string{"true"}, stream.header.Get(PartialResultsHeader))
+			require.True(t, ma.AssertExpectations(t))
+		})
+	}
+}
+
+func TestSearchPagination(t *testing.T) {
+	var (
+		owner = dssmodels.Owner("foo")
+		first = dssmodels.ID(uuid.New().String())
+		last  = dssmodels.ID(uuid.New().String())
+		isas  = []*ridmodels.IdentificationServiceArea{{ID: first, Owner: owner}, {ID: last, Owner: owner}}
+		subs  = []*ridmodels.Subscription{{ID: first, Owner: owner}, {ID: last, Owner: owner}}
+	)
+	requestPage := func(stream grpc.ServerTransportStream, kv ...string) context.Context {
+		ctx := grpc.NewContextWithServerTransportStream(auth.ContextWithOwner(context.Background(), owner), stream)
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+	}
+
+	t.Run("truncated-results-carry-next-page-token", func(t *testing.T) {
+		var (
+			ma     = &mockApp{}
+			stream = &headerRecordingStream{}
+			s      = &Server{App: ma, Timeout: timeout, PageSize: 10, MaxPageSize: 100}
+			ctx    = requestPage(stream, PageSizeHeader, "2", PageTokenHeader, encodePageToken(first))
+		)
+		ma.On("SearchISAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, first, 2).Return(isas, nil)
+
+		resp, err := s.SearchIdentificationServiceAreas(ctx, &ridpb.SearchIdentificationServiceAreasRequest{Area: testdata.Loop})
+		require.NoError(t, err)
+		require.Len(t, resp.ServiceAreas, 2)
+		require.Equal(t, []string{encodePageToken(last)}, stream.header.Get(NextPageTokenHeader))
+		require.True(t, ma.AssertExpectations(t))
+	})
+
+	t.Run("default-page-size-applies-without-header", func(t *testing.T) {
+		var (
+			ma     = &mockApp{}
+			stream = &headerRecordingStream{}
+			s      = &Server{App: ma, Timeout: timeout, PageSize: 10}
+			ctx    = requestPage(stream)
+		)
+		ma.On("SearchSubscriptionsByOwner", mock.Anything, mock.Anything, owner, dssmodels.ID(""), 10).Return(subs, nil)
+
+		resp, err := s.SearchSubscriptions(ctx, &ridpb.SearchSubscriptionsRequest{Area: testdata.Loop})
+		require.NoError(t, err)
+		require.Len(t, resp.Subscriptions, 2)
+		require.Empty(t, stream.header.Get(NextPageTokenHeader))
+		require.True(t, ma.AssertExpectations(t))
+	})
+
+	for _, r := range []struct {
+		name string
+		kv   []string
+	}{
+		{name: "page-size-beyond-maximum", kv: []string{PageSizeHeader, "101"}},
+		{name: "page-size-not-positive", kv: []string{PageSizeHeader, "0"}},
+		{name: "page-token-not-an-id", kv: []string{PageTokenHeader, encodePageToken("not-an-id")}},
+		{name: "page-token-not-base64", kv: []string{PageTokenHeader, "!"}},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			var (
+				ma  = &mockApp{}
+				s   = &Server{App: ma, Timeout: timeout, MaxPageSize: 100}
+				ctx = requestPage(&headerRecordingStream{}, r.kv...)
+			)
+
+			_, err := s.SearchIdentificationServiceAreas(ctx, &ridpb.SearchIdentificationServiceAreasRequest{Area: testdata.Loop})
+			require.Error(t, err)
+			require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+			_, err = s.SearchSubscriptions(ctx, &ridpb.SearchSubscriptionsRequest{Area: testdata.Loop})
+			require.Error(t, err)
+			require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+			require.True(t, ma.AssertExpectations(t))
+		})
+	}
+}
+
+func TestSearchRejectsAreasBeyondMaximum(t *testing.T) {
+	var (
+		owner = dssmodels.Owner("foo")
+		ctx   = auth.ContextWithOwner(context.Background(), owner)
+		// About 1.1km by 1.1km, crossing the antimeridian.
+		small = "0.005,179.995,0.005,-179.995,-0.005,-179.995,-0.005,179.995"
+	)
+
+	for _, r := range []struct {
+		name       string
+		maxAreaKm2 float64
+		rejected   bool
+	}{
+		{name: "unbounded-by-default"},
+		{name: "within-maximum", maxAreaKm2: 2},
+		{name: "beyond-maximum", maxAreaKm2: 1, rejected: true},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			var (
+				ma = &mockApp{}
+				s  = &Server{App: ma, Timeout: timeout, MaxAreaKm2: r.maxAreaKm2}
+			)
+			if !r.rejected {
+				ma.On("SearchISAs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, dssmodels.ID(""), 0).Return(
+					[]*ridmodels.IdentificationServiceArea(nil), error(nil))
+				ma.On("SearchSubscriptionsByOwner", mock.Anything, mock.Anything, owner, dssmodels.ID(""), 0).Return(
+					[]*ridmodels.Subscription(nil), error(nil))
+			}
+
+			_, isaErr := s.SearchIdentificationServiceAreas(ctx, &ridpb.SearchIdentificationServiceAreasRequest{Area: small})
+			_, subErr := s.SearchSubscriptions(ctx, &ridpb.SearchSubscriptionsRequest{Area: small})
+			if r.rejected {
+				require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(isaErr))
+				require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(subErr))
+			} else {
+				require.NoError(t, isaErr)
+				require.NoError(t, subErr)
+			}
+			require.True(t, ma.AssertExpectations(t))
+		})
+	}
+}
+
+func TestSearchWithoutAreaRequiresGlobalSearchScope(t *testing.T) {
+	var (
+		owner  = dssmodels.Owner("foo")
+		ctx    = auth.ContextWithOwner(context.Background(), owner)
+		reader = auth.ContextWithScopes(ctx, auth.ScopeSet{"dss.read.identification_service_areas": struct{}{}})
+		admin  = auth.ContextWithScopes(ctx, auth.ScopeSet{"dss.admin": struct{}{}})
+	)
+
+	for _, r := range []struct {
+		name  string
+		scope auth.Scope
+		ctx   context.Context
+	}{
+		{name: "rejected-by-default", ctx: admin},
+		{name: "rejected-without-scope", scope: "dss.admin", ctx: reader},
+	} {
+		t.Run(r.name, func(t *testing.T) {
+			var (
+				ma = &mockApp{}
+				s  = &Server{App: ma, Timeout: timeout, GlobalSearchScope: r.scope}
+			)
+
+			_, err := s.SearchIdentificationServiceAreas(r.ctx, &ridpb.SearchIdentificationServiceAreasRequest{})
+			require.Error(t, err)
+			require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+			_, err = s.SearchSubscriptions(r.ctx, &ridpb.SearchSubscriptionsRequest{})
+			require.Error(t, err)
+			require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+			require.True(t, ma.AssertExpectations(t))
+		})
+	}
+
+	t.Run("searches-everywhere-with-scope", func(t *testing.T) {
+		var (
+			ma = &mockApp{}
+			s  = &Server{App: ma, Timeout: timeout, GlobalSearchScope: "dss.admin"}
+		)
+		ma.On("SearchISAs", mock.Anything, s2.CellUnion(nil), (*time.Time)(nil), (*time.Time)(nil), dssmodels.ID(""), 0).Return(
+			[]*ridmodels.IdentificationServiceArea{
+				{
+					ID:    dssmodels.ID(uuid.New().String()),
+					Owner: dssmodels.Owner("me-myself-and-i"),
+					URL:   "https://no/place/like/home",
+				},
+			}, error(nil),
+		)
+		ma.On("SearchSubscriptionsByOwner", mock.Anything, s2.CellUnion(nil), owner, dssmodels.ID(""), 0).Return(
+			[]*ridmodels.Subscription{
+				{
+					ID:    dssmodels.ID(uuid.New().String()),
+					Owner: owner,
+					URL:   "https://no/place/like/home",
+				},
+			}, error(nil),
+		)
+
+		isas, err := s.SearchIdentificationServiceAreas(admin, &ridpb.SearchIdentificationServiceAreasRequest{})
+		require.NoError(t, err)
+		require.Len(t, isas.ServiceAreas, 1)
+
+		subscriptions, err := s.SearchSubscriptions(admin, &ridpb.SearchSubscriptionsRequest{})
+		require.NoError(t, err)
+		require.Len(t, subscriptions.Subscriptions, 1)
+		require.True(t, ma.AssertExpectations(t))
+	})
+}
+
 func TestDefaultRegionCovererProducesResults(t *testing.T) {
 	cover, err := geo.AreaToCellIDs(testdata.Loop)
 	require.NoError(t, err)
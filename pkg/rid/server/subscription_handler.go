@@ -6,7 +6,6 @@ import (
 	"github.com/interuss/dss/pkg/api/v1/ridpb"
 	"github.com/interuss/dss/pkg/auth"
 	dsserr "github.com/interuss/dss/pkg/errors"
-	"github.com/interuss/dss/pkg/geo"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	"github.com/interuss/stacktrace"
@@ -17,25 +16,9 @@ func (s *Server) DeleteSubscription(
 	ctx context.Context, req *ridpb.DeleteSubscriptionRequest) (
 	*ridpb.DeleteSubscriptionResponse, error) {
 
-	// TODO: simply verify the owner was set in an upper level.
-	owner, ok := auth.OwnerFromContext(ctx)
-	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
-	}
-	version, err := dssmodels.VersionFromString(req.GetVersion())
+	subscription, err := s.deleteSubscription(ctx, req.GetId(), req.GetVersion())
 	if err != nil {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
-	}
-	id, err := dssmodels.IDFromString(req.Id)
-	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
-	}
-	//TODO: put the context with timeout into an interceptor so it's always set.
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
-	subscription, err := s.App.DeleteSubscription(ctx, id, owner, version)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not delete Subscription")
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 	p, err := subscription.ToProto()
 	if err != nil {
@@ -51,21 +34,9 @@ func (s *Server) SearchSubscriptions(
 	ctx context.Context, req *ridpb.SearchSubscriptionsRequest) (
 	*ridpb.SearchSubscriptionsResponse, error) {
 
-	owner, ok := auth.OwnerFromContext(ctx)
-	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
-	}
-
-	cu, err := geo.AreaToCellIDs(req.GetArea())
+	subscriptions, err := s.searchSubscriptions(ctx, req.GetArea())
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Invalid area")
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
-	subscriptions, err := s.App.SearchSubscriptionsByOwner(ctx, cu, owner)
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not search Subscriptions")
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 	sp := make([]*ridpb.Subscription, len(subscriptions))
 	for i := range subscriptions {
@@ -85,19 +56,9 @@ func (s *Server) GetSubscription(
 	ctx context.Context, req *ridpb.GetSubscriptionRequest) (
 	*ridpb.GetSubscriptionResponse, error) {
 
-	id, err := dssmodels.IDFromString(req.Id)
-	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
-	subscription, err := s.App.GetSubscription(ctx, id)
+	subscription, err := s.getSubscription(ctx, req.GetId())
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not get Subscription")
-	}
-	if subscription == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", req.GetId())
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 	p, err := subscription.ToProto()
 	if err != nil {
@@ -114,141 +75,203 @@ func (s *Server) CreateSubscription(
 	*ridpb.PutSubscriptionResponse, error) {
 
 	params := req.GetParams()
-	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
-	defer cancel()
+	if params == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
+	}
 
-	owner, ok := auth.OwnerFromContext(ctx)
-	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	sub, isas, err := s.putSubscription(ctx, req.GetId(), nil, func(sub *ridmodels.Subscription) error {
+		return setSubscriptionParams(sub, params.GetCallbacks(), params.GetExtents())
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return putSubscriptionResponse(sub, isas)
+}
+
+// UpdateSubscription updates a single subscription.
+func (s *Server) UpdateSubscription(
+	ctx context.Context, req *ridpb.UpdateSubscriptionRequest) (
+	*ridpb.PutSubscriptionResponse, error) {
+
+	params := req.GetParams()
+
+	version, err := dssmodels.VersionFromString(req.GetVersion())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
 	}
 	if params == nil {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
 	}
-	if params.Callbacks == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required callbacks")
+
+	sub, isas, err := s.putSubscription(ctx, req.GetId(), version, func(sub *ridmodels.Subscription) error {
+		return setSubscriptionParams(sub, params.GetCallbacks(), params.GetExtents())
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
-	if params.Extents == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
+	return putSubscriptionResponse(sub, isas)
+}
+
+// The helpers below implement the subscription endpoints of every version of
+// the API, whose handlers only convert between their own protos and the
+// models.
+
+// deleteSubscription deletes the subscription with ID id at version.
+func (s *Server) deleteSubscription(ctx context.Context, id string, version string) (*ridmodels.Subscription, error) {
+	// TODO: simply verify the owner was set in an upper level.
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
 	}
-	id, err := dssmodels.IDFromString(req.Id)
+	v, err := dssmodels.VersionFromString(version)
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
+	}
+	subID, err := dssmodels.IDFromString(id)
 	if err != nil {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
 	}
-
-	sub := &ridmodels.Subscription{
-		ID:     id,
-		Owner:  owner,
-		URL:    params.Callbacks.IdentificationServiceAreaUrl,
-		Writer: s.Locality,
+	//TODO: put the context with timeout into an interceptor so it's always set.
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	subscription, err := s.App.DeleteSubscription(ctx, subID, owner, v)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not delete Subscription")
 	}
+	return subscription, nil
+}
 
-	if err := sub.SetExtents(params.Extents); err != nil {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
+// searchSubscriptions returns the page of the client's subscriptions in area
+// requested by the pagination headers of the request.
+func (s *Server) searchSubscriptions(ctx context.Context, area string) ([]*ridmodels.Subscription, error) {
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
 	}
 
-	insertedSub, err := s.App.InsertSubscription(ctx, sub)
+	cu, err := s.searchCells(ctx, area)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not insert Subscription")
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 
-	p, err := insertedSub.ToProto()
+	afterID, limit, err := s.searchPage(ctx)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not convert Subscription to proto")
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 
-	// Find ISAs that were in this subscription's area.
-	isas, err := s.App.SearchISAs(ctx, sub.Cells, nil, nil)
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	subscriptions, err := s.App.SearchSubscriptionsByOwner(ctx, cu, owner, afterID, limit)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not search ISAs")
+		return nil, stacktrace.Propagate(err, "Could not search Subscriptions")
 	}
-
-	// Convert the ISAs to protos.
-	isaProtos := make([]*ridpb.IdentificationServiceArea, len(isas))
-	for i, isa := range isas {
-		isaProtos[i], err = isa.ToProto()
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
+	if len(subscriptions) > 0 {
+		if err := setNextPageToken(ctx, subscriptions[len(subscriptions)-1].ID, len(subscriptions), limit); err != nil {
+			return nil, err // No need to Propagate this error as this stack layer does not add useful information
 		}
 	}
-
-	return &ridpb.PutSubscriptionResponse{
-		Subscription: p,
-		ServiceAreas: isaProtos,
-	}, nil
+	return subscriptions, nil
 }
 
-// UpdateSubscription updates a single subscription.
-func (s *Server) UpdateSubscription(
-	ctx context.Context, req *ridpb.UpdateSubscriptionRequest) (
-	*ridpb.PutSubscriptionResponse, error) {
-
-	params := req.GetParams()
-
-	version, err := dssmodels.VersionFromString(req.GetVersion())
+// getSubscription returns the subscription with ID id.
+func (s *Server) getSubscription(ctx context.Context, id string) (*ridmodels.Subscription, error) {
+	subID, err := dssmodels.IDFromString(id)
 	if err != nil {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
 	}
-	id, err := dssmodels.IDFromString(req.Id)
+
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+	subscription, err := s.App.GetSubscription(ctx, subID)
 	if err != nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
+		return nil, stacktrace.Propagate(err, "Could not get Subscription")
 	}
+	if subscription == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", id)
+	}
+	return subscription, nil
+}
+
+// putSubscription creates the subscription with ID id, or updates it from
+// version if version is set, once set has validated the params of the request
+// and set them on the subscription. It returns the subscription as stored
+// along with the ISAs in its area.
+func (s *Server) putSubscription(ctx context.Context, id string, version *dssmodels.Version, set func(*ridmodels.Subscription) error) (
+	*ridmodels.Subscription, []*ridmodels.IdentificationServiceArea, error) {
 
 	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
 	defer cancel()
 
 	owner, ok := auth.OwnerFromContext(ctx)
 	if !ok {
-		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
-	}
-	if params == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
 	}
-	if params.Callbacks == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required callbacks")
-	}
-	if params.Extents == nil {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
+	subID, err := dssmodels.IDFromString(id)
+	if err != nil {
+		return nil, nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid ID format")
 	}
 
 	sub := &ridmodels.Subscription{
-		ID:      id,
+		ID:      subID,
 		Owner:   owner,
-		URL:     params.Callbacks.IdentificationServiceAreaUrl,
 		Version: version,
 		Writer:  s.Locality,
 	}
+	if err := set(sub); err != nil {
+		return nil, nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
 
-	if err := sub.SetExtents(params.Extents); err != nil {
-		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
+	var insertedSub *ridmodels.Subscription
+	if version == nil {
+		insertedSub, err = s.App.InsertSubscription(ctx, sub)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Could not insert Subscription")
+		}
+	} else {
+		insertedSub, err = s.App.UpdateSubscription(ctx, sub)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "Could not update Subscription")
+		}
 	}
 
-	insertedSub, err := s.App.UpdateSubscription(ctx, sub)
+	// Find ISAs that were in this subscription's area.
+	isas, err := s.App.SearchISAs(ctx, sub.Cells, nil, nil, "", 0)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not update Subscription")
+		return nil, nil, stacktrace.Propagate(err, "Could not search ISAs")
 	}
+	return insertedSub, isas, nil
+}
 
-	p, err := insertedSub.ToProto()
-	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not convert Subscription to proto")
+// setSubscriptionParams validates the callbacks and extents of a request
+// creating or updating a subscription, and sets them on sub.
+func setSubscriptionParams(sub *ridmodels.Subscription, callbacks *ridpb.SubscriptionCallbacks, extents *ridpb.Volume4D) error {
+	if callbacks == nil {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required callbacks")
+	}
+	if extents == nil {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
 	}
+	sub.URL = callbacks.IdentificationServiceAreaUrl
+	if err := sub.SetExtents(extents); err != nil {
+		return stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
+	}
+	return nil
+}
 
-	// Find ISAs that were in this subscription's area.
-	isas, err := s.App.SearchISAs(ctx, sub.Cells, nil, nil)
+// putSubscriptionResponse returns the response to a request creating or
+// updating sub, along with the ISAs in its area.
+func putSubscriptionResponse(sub *ridmodels.Subscription, isas []*ridmodels.IdentificationServiceArea) (*ridpb.PutSubscriptionResponse, error) {
+	p, err := sub.ToProto()
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "Could not search ISAs")
+		return nil, stacktrace.Propagate(err, "Could not convert Subscription to proto")
 	}
-
-	// Convert the ISAs to protos.
-	isaProtos := make([]*ridpb.IdentificationServiceArea, len(isas))
-	for i, isa := range isas {
-		isaProtos[i], err = isa.ToProto()
-		if err != nil {
-			return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
-		}
+	areas, err := serviceAreas(isas)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
 
 	return &ridpb.PutSubscriptionResponse{
 		Subscription: p,
-		ServiceAreas: isaProtos,
+		ServiceAreas: areas,
 	}, nil
 }
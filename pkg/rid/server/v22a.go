@@ -0,0 +1,40 @@
+package server
+
+import (
+	"github.com/interuss/dss/pkg/auth"
+)
+
+var (
+	// ScopesV22a bundles up auth scopes for the F3411-22a remote-id server.
+	ScopesV22a = struct {
+		ServiceProvider auth.Scope
+		DisplayProvider auth.Scope
+	}{
+		ServiceProvider: "rid.service_provider",
+		DisplayProvider: "rid.display_provider",
+	}
+)
+
+// V22aServer implements ridv22apb.DiscoveryAndSynchronizationService, the
+// F3411-22a version of the remote ID API, with the App and configuration of
+// the embedded Server. Both versions share the same store, so that entities
+// written through either are served by both.
+type V22aServer struct {
+	*Server
+}
+
+// AuthScopes returns a map of endpoint to required Oauth scope.
+func (s *V22aServer) AuthScopes() map[auth.Operation]auth.KeyClaimedScopesValidator {
+	return map[auth.Operation]auth.KeyClaimedScopesValidator{
+		"/ridv22apb.DiscoveryAndSynchronizationService/CreateIdentificationServiceArea":  auth.RequireAllScopes(ScopesV22a.ServiceProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/DeleteIdentificationServiceArea":  auth.RequireAllScopes(ScopesV22a.ServiceProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/GetIdentificationServiceArea":     auth.RequireAnyScope(ScopesV22a.DisplayProvider, ScopesV22a.ServiceProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/SearchIdentificationServiceAreas": auth.RequireAllScopes(ScopesV22a.DisplayProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/UpdateIdentificationServiceArea":  auth.RequireAllScopes(ScopesV22a.ServiceProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/CreateSubscription":               auth.RequireAllScopes(ScopesV22a.DisplayProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/DeleteSubscription":               auth.RequireAllScopes(ScopesV22a.DisplayProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/GetSubscription":                  auth.RequireAllScopes(ScopesV22a.DisplayProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/SearchSubscriptions":              auth.RequireAllScopes(ScopesV22a.DisplayProvider),
+		"/ridv22apb.DiscoveryAndSynchronizationService/UpdateSubscription":               auth.RequireAllScopes(ScopesV22a.DisplayProvider),
+	}
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/ridv22apb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+)
+
+// GetIdentificationServiceArea returns a single ISA for a given ID.
+func (s *V22aServer) GetIdentificationServiceArea(
+	ctx context.Context, req *ridv22apb.GetIdentificationServiceAreaRequest) (
+	*ridv22apb.GetIdentificationServiceAreaResponse, error) {
+
+	isa, err := s.getISA(ctx, req.GetId())
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	p, err := isa.ToV22aProto()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
+	}
+	return &ridv22apb.GetIdentificationServiceAreaResponse{
+		ServiceArea: p,
+	}, nil
+}
+
+// CreateIdentificationServiceArea creates an ISA
+func (s *V22aServer) CreateIdentificationServiceArea(
+	ctx context.Context, req *ridv22apb.CreateIdentificationServiceAreaRequest) (
+	*ridv22apb.PutIdentificationServiceAreaResponse, error) {
+
+	params := req.GetParams()
+	if params == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
+	}
+
+	insertedISA, subscribers, err := s.putISA(ctx, req.GetId(), nil, func(isa *ridmodels.IdentificationServiceArea) error {
+		return setV22aISAParams(isa, params.GetUssBaseUrl(), params.GetExtents())
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	pbISA, err := insertedISA.ToV22aProto()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
+	}
+
+	return &ridv22apb.PutIdentificationServiceAreaResponse{
+		ServiceArea: pbISA,
+		Subscribers: v22aSubscribersToNotify(subscribers),
+	}, nil
+}
+
+// UpdateIdentificationServiceArea updates an existing ISA.
+func (s *V22aServer) UpdateIdentificationServiceArea(
+	ctx context.Context, req *ridv22apb.UpdateIdentificationServiceAreaRequest) (
+	*ridv22apb.PutIdentificationServiceAreaResponse, error) {
+
+	params := req.GetParams()
+
+	version, err := dssmodels.VersionFromString(req.GetVersion())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
+	}
+	if params == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
+	}
+
+	updatedISA, subscribers, err := s.putISA(ctx, req.GetId(), version, func(isa *ridmodels.IdentificationServiceArea) error {
+		return setV22aISAParams(isa, params.GetUssBaseUrl(), params.GetExtents())
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	pbISA, err := updatedISA.ToV22aProto()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
+	}
+
+	return &ridv22apb.PutIdentificationServiceAreaResponse{
+		ServiceArea: pbISA,
+		Subscribers: v22aSubscribersToNotify(subscribers),
+	}, nil
+}
+
+// DeleteIdentificationServiceArea deletes an existing ISA.
+func (s *V22aServer) DeleteIdentificationServiceArea(
+	ctx context.Context, req *ridv22apb.DeleteIdentificationServiceAreaRequest) (
+	*ridv22apb.DeleteIdentificationServiceAreaResponse, error) {
+
+	isa, subscribers, err := s.deleteISA(ctx, req.GetId(), req.GetVersion())
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	p, err := isa.ToV22aProto()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
+	}
+
+	return &ridv22apb.DeleteIdentificationServiceAreaResponse{
+		ServiceArea: p,
+		Subscribers: v22aSubscribersToNotify(subscribers),
+	}, nil
+}
+
+// SearchIdentificationServiceAreas queries for all ISAs in the bounds.
+func (s *V22aServer) SearchIdentificationServiceAreas(
+	ctx context.Context, req *ridv22apb.SearchIdentificationServiceAreasRequest) (
+	*ridv22apb.SearchIdentificationServiceAreasResponse, error) {
+
+	earliest, err := v22aSearchTime(req.GetEarliestTime())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid earliest_time")
+	}
+	latest, err := v22aSearchTime(req.GetLatestTime())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid latest_time")
+	}
+
+	isas, err := s.searchISAs(ctx, req.GetArea(), earliest, latest)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	areas, err := v22aServiceAreas(isas)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	return &ridv22apb.SearchIdentificationServiceAreasResponse{
+		ServiceAreas: areas,
+	}, nil
+}
+
+// setV22aISAParams validates the USS base URL and extents of a request
+// creating or updating an ISA, and sets them on isa.
+func setV22aISAParams(isa *ridmodels.IdentificationServiceArea, ussBaseURL string, extents *ridv22apb.Volume4D) error {
+	if ussBaseURL == "" {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required uss_base_url")
+	}
+	if extents == nil {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
+	}
+	isa.SetV22aUSSBaseURL(ussBaseURL)
+	if err := isa.SetV22aExtents(extents); err != nil {
+		return stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
+	}
+	return nil
+}
+
+// v22aSearchTime returns the time of an optional RFC 3339 search parameter.
+func v22aSearchTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error parsing RFC 3339 time")
+	}
+	return &t, nil
+}
+
+func v22aServiceAreas(isas []*ridmodels.IdentificationServiceArea) ([]*ridv22apb.IdentificationServiceArea, error) {
+	areas := make([]*ridv22apb.IdentificationServiceArea, len(isas))
+	for i, isa := range isas {
+		a, err := isa.ToV22aProto()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Could not convert ISA to proto")
+		}
+		areas[i] = a
+	}
+	return areas, nil
+}
+
+func v22aSubscribersToNotify(subscribers []*ridmodels.Subscription) []*ridv22apb.SubscriberToNotify {
+	result := []*ridv22apb.SubscriberToNotify{}
+	for _, subscriber := range subscribers {
+		result = append(result, subscriber.ToV22aNotifyProto())
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+
+	"github.com/interuss/dss/pkg/api/v1/ridv22apb"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	"github.com/interuss/stacktrace"
+)
+
+// DeleteSubscription deletes an existing subscription.
+func (s *V22aServer) DeleteSubscription(
+	ctx context.Context, req *ridv22apb.DeleteSubscriptionRequest) (
+	*ridv22apb.DeleteSubscriptionResponse, error) {
+
+	subscription, err := s.deleteSubscription(ctx, req.GetId(), req.GetVersion())
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	p, err := subscription.ToV22aProto()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Unable to convert Subscription to proto")
+	}
+	return &ridv22apb.DeleteSubscriptionResponse{
+		Subscription: p,
+	}, nil
+}
+
+// SearchSubscriptions queries for existing subscriptions in the given bounds.
+func (s *V22aServer) SearchSubscriptions(
+	ctx context.Context, req *ridv22apb.SearchSubscriptionsRequest) (
+	*ridv22apb.SearchSubscriptionsResponse, error) {
+
+	subscriptions, err := s.searchSubscriptions(ctx, req.GetArea())
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	sp := make([]*ridv22apb.Subscription, len(subscriptions))
+	for i := range subscriptions {
+		sp[i], err = subscriptions[i].ToV22aProto()
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Could not convert Subscription to proto")
+		}
+	}
+
+	return &ridv22apb.SearchSubscriptionsResponse{
+		Subscriptions: sp,
+	}, nil
+}
+
+// GetSubscription gets a single subscription based on ID.
+func (s *V22aServer) GetSubscription(
+	ctx context.Context, req *ridv22apb.GetSubscriptionRequest) (
+	*ridv22apb.GetSubscriptionResponse, error) {
+
+	subscription, err := s.getSubscription(ctx, req.GetId())
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	p, err := subscription.ToV22aProto()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not convert Subscription to proto")
+	}
+	return &ridv22apb.GetSubscriptionResponse{
+		Subscription: p,
+	}, nil
+}
+
+// CreateSubscription creates a single subscription.
+func (s *V22aServer) CreateSubscription(
+	ctx context.Context, req *ridv22apb.CreateSubscriptionRequest) (
+	*ridv22apb.PutSubscriptionResponse, error) {
+
+	params := req.GetParams()
+	if params == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
+	}
+
+	sub, isas, err := s.putSubscription(ctx, req.GetId(), nil, func(sub *ridmodels.Subscription) error {
+		return setV22aSubscriptionParams(sub, params.GetUssBaseUrl(), params.GetExtents())
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return v22aPutSubscriptionResponse(sub, isas)
+}
+
+// UpdateSubscription updates a single subscription.
+func (s *V22aServer) UpdateSubscription(
+	ctx context.Context, req *ridv22apb.UpdateSubscriptionRequest) (
+	*ridv22apb.PutSubscriptionResponse, error) {
+
+	params := req.GetParams()
+
+	version, err := dssmodels.VersionFromString(req.GetVersion())
+	if err != nil {
+		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid version")
+	}
+	if params == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
+	}
+
+	sub, isas, err := s.putSubscription(ctx, req.GetId(), version, func(sub *ridmodels.Subscription) error {
+		return setV22aSubscriptionParams(sub, params.GetUssBaseUrl(), params.GetExtents())
+	})
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+	return v22aPutSubscriptionResponse(sub, isas)
+}
+
+// setV22aSubscriptionParams validates the USS base URL and extents of a
+// request creating or updating a subscription, and sets them on sub.
+func setV22aSubscriptionParams(sub *ridmodels.Subscription, ussBaseURL string, extents *ridv22apb.Volume4D) error {
+	if ussBaseURL == "" {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required uss_base_url")
+	}
+	if extents == nil {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing required extents")
+	}
+	sub.SetV22aUSSBaseURL(ussBaseURL)
+	if err := sub.SetV22aExtents(extents); err != nil {
+		return stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Invalid extents")
+	}
+	return nil
+}
+
+// v22aPutSubscriptionResponse returns the response to a request creating or
+// updating sub, along with the ISAs in its area.
+func v22aPutSubscriptionResponse(sub *ridmodels.Subscription, isas []*ridmodels.IdentificationServiceArea) (*ridv22apb.PutSubscriptionResponse, error) {
+	p, err := sub.ToV22aProto()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Could not convert Subscription to proto")
+	}
+	areas, err := v22aServiceAreas(isas)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	return &ridv22apb.PutSubscriptionResponse{
+		Subscription: p,
+		ServiceAreas: areas,
+	}, nil
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/interuss/dss/pkg/api/v1/ridv22apb"
+	"github.com/interuss/dss/pkg/auth"
+	"github.com/interuss/dss/pkg/geo/testdata"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func v22aLoopVolume4D() *ridv22apb.Volume4D {
+	polygon := &ridv22apb.Polygon{}
+	for _, v := range testdata.LoopPolygon.GetVertices() {
+		polygon.Vertices = append(polygon.Vertices, &ridv22apb.LatLngPoint{Lat: v.Lat, Lng: v.Lng})
+	}
+	return &ridv22apb.Volume4D{
+		Volume: &ridv22apb.Volume3D{
+			OutlinePolygon: polygon,
+			AltitudeLower:  &ridv22apb.Altitude{Value: 10, Units: dssmodels.UnitsM, Reference: dssmodels.ReferenceW84},
+			AltitudeUpper:  &ridv22apb.Altitude{Value: 100, Units: dssmodels.UnitsM, Reference: dssmodels.ReferenceW84},
+		},
+		TimeStart: &ridv22apb.Time{Value: testdata.LoopVolume4D.GetTimeStart(), Format: dssmodels.TimeFormatRFC3339},
+		TimeEnd:   &ridv22apb.Time{Value: testdata.LoopVolume4D.GetTimeEnd(), Format: dssmodels.TimeFormatRFC3339},
+	}
+}
+
+func TestV22aCreateISAStoresEndpointURLs(t *testing.T) {
+	ctx := auth.ContextWithOwner(context.Background(), "foo")
+	id := dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+
+	ma := &mockApp{}
+	// The ISA is stored with the flights URL a v1 client would have given,
+	// and v1 subscribers are notified at their base URL.
+	ma.On("InsertISA", mock.Anything, mock.MatchedBy(func(isa *ridmodels.IdentificationServiceArea) bool {
+		return isa.ID == id && isa.URL == "https://example.com/rid/uss/flights" && len(isa.Cells) > 0
+	})).Return(&ridmodels.IdentificationServiceArea{
+		ID:    id,
+		Owner: "foo",
+		URL:   "https://example.com/rid/uss/flights",
+	}, []*ridmodels.Subscription{
+		{
+			ID:                "8265221b-9528-4d45-900d-59a148e13850",
+			URL:               "https://display.example.com/uss/identification_service_areas",
+			NotificationIndex: 3,
+		},
+	}, nil)
+	s := &V22aServer{Server: &Server{App: ma, Timeout: timeout}}
+
+	resp, err := s.CreateIdentificationServiceArea(ctx, &ridv22apb.CreateIdentificationServiceAreaRequest{
+		Id: id.String(),
+		Params: &ridv22apb.CreateIdentificationServiceAreaParameters{
+			UssBaseUrl: "https://example.com/rid/",
+			Extents:    v22aLoopVolume4D(),
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, ma.AssertExpectations(t))
+	require.Equal(t, "https://example.com/rid", resp.ServiceArea.UssBaseUrl)
+	require.Equal(t, []*ridv22apb.SubscriberToNotify{
+		{
+			Url: "https://display.example.com",
+			Subscriptions: []*ridv22apb.SubscriptionState{
+				{SubscriptionId: "8265221b-9528-4d45-900d-59a148e13850", NotificationIndex: 3},
+			},
+		},
+	}, resp.Subscribers)
+}
+
+func TestV22aCreateISARequiresUSSBaseURL(t *testing.T) {
+	ctx := auth.ContextWithOwner(context.Background(), "foo")
+	s := &V22aServer{Server: &Server{App: &mockApp{}, Timeout: timeout}}
+
+	_, err := s.CreateIdentificationServiceArea(ctx, &ridv22apb.CreateIdentificationServiceAreaRequest{
+		Id: "4348c8e5-0b1c-43cf-9114-2e67a4532765",
+		Params: &ridv22apb.CreateIdentificationServiceAreaParameters{
+			Extents: v22aLoopVolume4D(),
+		},
+	})
+	require.Error(t, err)
+}
+
+func TestV22aSearchISAsRejectsInvalidTime(t *testing.T) {
+	s := &V22aServer{Server: &Server{App: &mockApp{}, Timeout: timeout}}
+
+	_, err := s.SearchIdentificationServiceAreas(context.Background(), &ridv22apb.SearchIdentificationServiceAreasRequest{
+		Area:         testdata.Loop,
+		EarliestTime: "yesterday",
+	})
+	require.Error(t, err)
+}
@@ -78,6 +78,12 @@ func (c *isaRepo) process(ctx context.Context, query string, args ...interface{}
 		payload = append(payload, i)
 	}
 	if err := rows.Err(); err != nil {
+		if ctx.Err() != nil {
+			// The context expired while reading rows; hand back what was read
+			// so far to callers willing to accept partial results.
+			return payload, stacktrace.Propagate(repos.ErrIncompleteSearch,
+				"Read %d ISAs before interruption: %s", len(payload), err)
+		}
 		return nil, stacktrace.Propagate(err, "Error in rows query result")
 	}
 
@@ -109,6 +115,17 @@ func (c *isaRepo) GetISA(ctx context.Context, id dssmodels.ID) (*ridmodels.Ident
 	return c.processOne(ctx, query, id)
 }
 
+// uniqueViolation is the SQLSTATE of errors caused by writes violating a
+// unique constraint, such as inserting a row with an existing primary key.
+const uniqueViolation = "23505"
+
+// isUniqueViolation returns true if err was caused by a write violating a
+// unique constraint.
+func isUniqueViolation(err error) bool {
+	pqErr, ok := stacktrace.RootCause(err).(*pq.Error)
+	return ok && pqErr.Code == uniqueViolation
+}
+
 // InsertISA inserts the IdentificationServiceArea identified by "id" and owned
 // by "owner", affecting "cells" in the time interval ["starts", "ends"].
 //
@@ -128,16 +145,21 @@ func (c *isaRepo) InsertISA(ctx context.Context, isa *ridmodels.IdentificationSe
 				%s`, isaFields, isaFields)
 	)
 
-	cids := make([]int64, len(isa.Cells))
+	if err := geo.ValidateCells(isa.Cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Error validating cells")
+	}
 
+	cids := make([]int64, len(isa.Cells))
 	for i, cell := range isa.Cells {
-		if err := geo.ValidateCell(cell); err != nil {
-			return nil, stacktrace.Propagate(err, "Error validating cell")
-		}
 		cids[i] = int64(cell)
 	}
 
-	return c.processOne(ctx, insertAreasQuery, isa.ID, isa.Owner, isa.URL, pq.Int64Array(cids), isa.StartTime, isa.EndTime, isa.Writer)
+	inserted, err := c.processOne(ctx, insertAreasQuery, isa.ID, isa.Owner, isa.URL, pq.Int64Array(cids), isa.StartTime, isa.EndTime, isa.Writer)
+	if isUniqueViolation(err) {
+		// Another ISA with the same ID was inserted concurrently.
+		return nil, stacktrace.NewErrorWithCode(dsserr.AlreadyExists, "ISA %s already exists", isa.ID)
+	}
+	return inserted, err // No need to Propagate this error as this stack layer does not add useful information
 }
 
 // UpdateISA updates the IdentificationServiceArea identified by "id" and owned
@@ -158,12 +180,12 @@ func (c *isaRepo) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationSe
 				%s`, updateISAFields, isaFields)
 	)
 
-	cids := make([]int64, len(isa.Cells))
+	if err := geo.ValidateCells(isa.Cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Error validating cells")
+	}
 
+	cids := make([]int64, len(isa.Cells))
 	for i, cell := range isa.Cells {
-		if err := geo.ValidateCell(cell); err != nil {
-			return nil, stacktrace.Propagate(err, "Error validating cell")
-		}
 		cids[i] = int64(cell)
 	}
 
@@ -172,7 +194,8 @@ func (c *isaRepo) UpdateISA(ctx context.Context, isa *ridmodels.IdentificationSe
 
 // DeleteISA deletes the IdentificationServiceArea identified by "id" and owned by "owner".
 // Returns the delete IdentificationServiceArea and all Subscriptions affected by the delete.
-// Returns nil, nil if ID, version not found
+// Returns nil, nil if ID not found, and a VersionMismatch error if the stored
+// ISA is at a different version than "isa".
 func (c *isaRepo) DeleteISA(ctx context.Context, isa *ridmodels.IdentificationServiceArea) (*ridmodels.IdentificationServiceArea, error) {
 	var (
 		deleteQuery = fmt.Sprintf(`
@@ -184,17 +207,54 @@ func (c *isaRepo) DeleteISA(ctx context.Context, isa *ridmodels.IdentificationSe
 				updated_at = $2
 			RETURNING %s`, isaFields)
 	)
-	return c.processOne(ctx, deleteQuery, isa.ID, isa.Version.ToTimestamp())
+	deleted, err := c.processOne(ctx, deleteQuery, isa.ID, isa.Version.ToTimestamp())
+	if err != nil || deleted != nil {
+		return deleted, err
+	}
+
+	current, err := c.GetISA(ctx, isa.ID)
+	switch {
+	case err != nil:
+		return nil, stacktrace.Propagate(err, "Error checking for ISA %s after failed delete", isa.ID)
+	case current != nil:
+		return nil, stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+			"ISA currently at version %s but delete specified %s", current.Version, isa.Version)
+	}
+	return nil, nil
 }
 
 // SearchISAs searches IdentificationServiceArea
-// instances that intersect with "cells" and, if set, the temporal volume
-// defined by "earliest" and "latest".
-func (c *isaRepo) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time) ([]*ridmodels.IdentificationServiceArea, error) {
+// instances that intersect with "cells", or anywhere if "cells" is empty, and,
+// if set, the temporal volume defined by "earliest" and "latest". If "limit"
+// is set, up to "limit" of them with an ID greater than "afterID" are
+// returned, ordered by ID.
+func (c *isaRepo) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *time.Time, latest *time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	if earliest == nil {
+		return nil, stacktrace.NewError("Earliest start time is missing")
+	}
+
+	// TODO: make earliest and latest required (NOT NULL) and remove coalesce.
+	// Make them real values (not pointers), on the model layer.
 	var (
-		// TODO: make earliest and latest required (NOT NULL) and remove coalesce.
-		// Make them real values (not pointers), on the model layer.
-		isasInCellsQuery = fmt.Sprintf(`
+		args        = []interface{}{earliest, latest}
+		cellsFilter string
+	)
+	if len(cells) > 0 {
+		cids := make([]int64, len(cells))
+		for i, cid := range cells {
+			cids[i] = int64(cid)
+		}
+		args = append(args, pq.Int64Array(cids))
+		cellsFilter = `
+			AND
+				cells && $3`
+	}
+	page, args, err := pageFilter(args, afterID, limit)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	isasInCellsQuery := fmt.Sprintf(`
 			SELECT
 				%s
 			FROM
@@ -202,23 +262,71 @@ func (c *isaRepo) SearchISAs(ctx context.Context, cells s2.CellUnion, earliest *
 			WHERE
 				ends_at >= $1
 			AND
-				COALESCE(starts_at <= $2, true)
-			AND
-				cells && $3`, isaFields)
-	)
+				COALESCE(starts_at <= $2, true)%s%s`, isaFields, cellsFilter, page)
 
-	if len(cells) == 0 {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing cell IDs for query")
-	}
+	return c.process(ctx, isasInCellsQuery, args...)
+}
 
-	if earliest == nil {
-		return nil, stacktrace.NewError("Earliest start time is missing")
+// AnyISAInCells implements repos.ISA.AnyISAInCells.
+func (c *isaRepo) AnyISAInCells(ctx context.Context, cells s2.CellUnion, activeAt time.Time) (bool, error) {
+	return anyActiveInCells(ctx, c, "identification_service_areas", cells, activeAt)
+}
+
+// ListISAsModifiedSince returns up to "limit" IdentificationServiceAreas
+// updated after "since", or at "since" with an ID greater than "afterID",
+// ordered by their update time and ID.
+func (c *isaRepo) ListISAsModifiedSince(ctx context.Context, since time.Time, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	if limit <= 0 {
+		return nil, stacktrace.NewError("Invalid limit %d for modified ISAs", limit)
 	}
+	if afterID.Empty() {
+		var query = fmt.Sprintf(`
+			SELECT
+				%s
+			FROM
+				identification_service_areas
+			WHERE
+				updated_at > $1
+			ORDER BY
+				updated_at, id
+			LIMIT $2`, isaFields)
+		return c.process(ctx, query, since, limit)
+	}
+	var query = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			identification_service_areas
+		WHERE
+			(updated_at, id) > ($1, $2)
+		ORDER BY
+			updated_at, id
+		LIMIT $3`, isaFields)
+	return c.process(ctx, query, since, afterID, limit)
+}
 
-	cids := make([]int64, len(cells))
-	for i, cid := range cells {
-		cids[i] = int64(cid)
+// DeleteExpiredISAs deletes the IdentificationServiceAreas that ended before "expiredBefore".
+func (c *isaRepo) DeleteExpiredISAs(ctx context.Context, expiredBefore time.Time) (int, error) {
+	return deleteExpired(ctx, c, "identification_service_areas", expiredBefore)
+}
+
+// CountISAsByInterval returns the number of IdentificationServiceAreas active
+// during each "interval" between "start" and "end".
+func (c *isaRepo) CountISAsByInterval(ctx context.Context, start, end time.Time, interval time.Duration) ([]int, error) {
+	return countActiveByInterval(ctx, c, "identification_service_areas", start, end, interval)
+}
+
+// ListISAs returns up to "limit" IdentificationServiceAreas with an ID greater than "afterID",
+// ordered by ID.
+func (c *isaRepo) ListISAs(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.IdentificationServiceArea, error) {
+	query, args, err := listByIDQuery("identification_service_areas", isaFields, afterID, limit)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
+	return c.process(ctx, query, args...)
+}
 
-	return c.process(ctx, isasInCellsQuery, earliest, latest, pq.Int64Array(cids))
+// CountISAsByOwner implements repos.ISA.CountISAsByOwner.
+func (c *isaRepo) CountISAsByOwner(ctx context.Context, owner dssmodels.Owner, activeAt time.Time) (int, error) {
+	return countActiveByOwner(ctx, c, "identification_service_areas", owner, activeAt)
 }
@@ -2,14 +2,18 @@ package cockroach
 
 import (
 	"context"
+	"sort"
 	"testing"
 	"time"
 
 	"github.com/golang/geo/s2"
 	"github.com/google/uuid"
+	dsserr "github.com/interuss/dss/pkg/errors"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -131,7 +135,7 @@ func TestStoreSearchISAs(t *testing.T) {
 		t.Run(r.name, func(t *testing.T) {
 			earliest, latest := r.timestampMutator(*saOut.StartTime, *saOut.EndTime)
 
-			serviceAreas, err := repo.SearchISAs(ctx, r.cells, earliest, latest)
+			serviceAreas, err := repo.SearchISAs(ctx, r.cells, earliest, latest, "", 0)
 			require.NoError(t, err)
 			require.Len(t, serviceAreas, r.expectedLen)
 		})
@@ -180,7 +184,7 @@ func TestStoreExpiredISA(t *testing.T) {
 
 	// We should still be able to find the ISA by searching and by ID.
 	now := fakeClock.Now()
-	serviceAreas, err := repo.SearchISAs(ctx, serviceArea.Cells, &now, nil)
+	serviceAreas, err := repo.SearchISAs(ctx, serviceArea.Cells, &now, nil, "", 0)
 	require.NoError(t, err)
 	require.Len(t, serviceAreas, 1)
 
@@ -192,7 +196,7 @@ func TestStoreExpiredISA(t *testing.T) {
 	fakeClock.Advance(2 * time.Minute)
 	now = fakeClock.Now()
 
-	serviceAreas, err = repo.SearchISAs(ctx, serviceArea.Cells, &now, nil)
+	serviceAreas, err = repo.SearchISAs(ctx, serviceArea.Cells, &now, nil, "", 0)
 	require.NoError(t, err)
 	require.Len(t, serviceAreas, 0)
 
@@ -223,9 +227,27 @@ func TestStoreDeleteISAs(t *testing.T) {
 	isa, err = repo.GetISA(ctx, isa.ID)
 	require.NoError(t, err)
 
-	serviceAreaOut, err := repo.DeleteISA(ctx, isa)
+	// A delete specifying a stale version must not remove the ISA.
+	stale := *isa
+	stale.Version, err = dssmodels.VersionFromString("a3cg3tcuhk000")
+	require.NoError(t, err)
+	serviceAreaOut, err := repo.DeleteISA(ctx, &stale)
+	require.Error(t, err)
+	require.Equal(t, dsserr.VersionMismatch, stacktrace.GetCode(err))
+	require.Nil(t, serviceAreaOut)
+
+	ret, err := repo.GetISA(ctx, isa.ID)
+	require.NoError(t, err)
+	require.Equal(t, isa, ret)
+
+	serviceAreaOut, err = repo.DeleteISA(ctx, isa)
 	require.NoError(t, err)
 	require.Equal(t, isa, serviceAreaOut)
+
+	// Deleting an ISA that no longer exists is not a version conflict.
+	serviceAreaOut, err = repo.DeleteISA(ctx, isa)
+	require.NoError(t, err)
+	require.Nil(t, serviceAreaOut)
 }
 
 func TestStoreISAWithNoGeoData(t *testing.T) {
@@ -245,3 +267,242 @@ func TestStoreISAWithNoGeoData(t *testing.T) {
 	_, err = repo.InsertISA(ctx, sub)
 	require.Error(t, err)
 }
+
+func TestStoreListISAsModifiedSince(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	var inserted []*ridmodels.IdentificationServiceArea
+	for i := 0; i < 3; i++ {
+		copy := *serviceArea
+		copy.ID = dssmodels.ID(uuid.New().String())
+		isa, err := repo.InsertISA(ctx, &copy)
+		require.NoError(t, err)
+		inserted = append(inserted, isa)
+	}
+	since := *inserted[1].Version.ToTimestamp()
+
+	// Updating the first ISA moves it past the cursor.
+	updated := *inserted[0]
+	updated.URL = "https://no/place/like/home/for/flights/v2"
+	ret, err := repo.UpdateISA(ctx, &updated)
+	require.NoError(t, err)
+	require.NotNil(t, ret)
+
+	isas, err := repo.ListISAsModifiedSince(ctx, since, "", 10)
+	require.NoError(t, err)
+	require.Len(t, isas, 2)
+	require.Equal(t, inserted[2].ID, isas[0].ID)
+	require.Equal(t, ret.ID, isas[1].ID)
+	require.Equal(t, ret.URL, isas[1].URL)
+
+	// Paging one ISA at a time yields the same ISAs.
+	page, err := repo.ListISAsModifiedSince(ctx, since, "", 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, inserted[2].ID, page[0].ID)
+
+	page, err = repo.ListISAsModifiedSince(ctx, *page[0].Version.ToTimestamp(), page[0].ID, 1)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, ret.ID, page[0].ID)
+
+	page, err = repo.ListISAsModifiedSince(ctx, *page[0].Version.ToTimestamp(), page[0].ID, 1)
+	require.NoError(t, err)
+	require.Empty(t, page)
+}
+
+func TestStoreListISAs(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		copy := *serviceArea
+		copy.ID = dssmodels.ID(uuid.New().String())
+		_, err := repo.InsertISA(ctx, &copy)
+		require.NoError(t, err)
+		ids = append(ids, copy.ID.String())
+	}
+	sort.Strings(ids)
+
+	// Paging two ISAs at a time visits each ISA once, in ID order.
+	var (
+		listed  []string
+		afterID dssmodels.ID
+	)
+	for {
+		page, err := repo.ListISAs(ctx, afterID, 2)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		require.LessOrEqual(t, len(page), 2)
+		for _, isa := range page {
+			listed = append(listed, isa.ID.String())
+		}
+		afterID = page[len(page)-1].ID
+	}
+	require.Equal(t, ids, listed)
+}
+
+func TestStoreSearchISAsPaged(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	insert := func() string {
+		copy := *serviceArea
+		copy.ID = dssmodels.ID(uuid.New().String())
+		_, err := repo.InsertISA(ctx, &copy)
+		require.NoError(t, err)
+		return copy.ID.String()
+	}
+	var ids []string
+	for i := 0; i < 5; i++ {
+		ids = append(ids, insert())
+	}
+	sort.Strings(ids)
+
+	// Paging two ISAs at a time visits each ISA present throughout once, in
+	// ID order, although ISAs are inserted between pages.
+	var (
+		searched []string
+		afterID  dssmodels.ID
+		now      = fakeClock.Now()
+	)
+	for {
+		page, err := repo.SearchISAs(ctx, serviceArea.Cells, &now, nil, afterID, 2)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		require.LessOrEqual(t, len(page), 2)
+		for _, isa := range page {
+			searched = append(searched, isa.ID.String())
+		}
+		afterID = page[len(page)-1].ID
+		inserted := insert()
+		if inserted > afterID.String() {
+			ids = append(ids, inserted)
+			sort.Strings(ids)
+		}
+	}
+	require.Equal(t, ids, searched)
+}
+
+func TestStoreAnyISAInCells(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	elsewhere := s2.CellUnion{s2.CellIDFromFace(0).ChildBeginAtLevel(13)}
+	covered, err := repo.AnyISAInCells(ctx, serviceArea.Cells, fakeClock.Now())
+	require.NoError(t, err)
+	require.False(t, covered)
+
+	for i := 0; i < 2; i++ {
+		copy := *serviceArea
+		copy.ID = dssmodels.ID(uuid.New().String())
+		_, err := repo.InsertISA(ctx, &copy)
+		require.NoError(t, err)
+	}
+
+	covered, err = repo.AnyISAInCells(ctx, serviceArea.Cells, fakeClock.Now())
+	require.NoError(t, err)
+	require.True(t, covered)
+
+	covered, err = repo.AnyISAInCells(ctx, elsewhere, fakeClock.Now())
+	require.NoError(t, err)
+	require.False(t, covered)
+
+	// Ended ISAs do not cover their cells.
+	covered, err = repo.AnyISAInCells(ctx, serviceArea.Cells, serviceArea.EndTime.Add(time.Minute))
+	require.NoError(t, err)
+	require.False(t, covered)
+}
+
+func TestStoreCountISAsByInterval(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		base                 = fakeClock.Now()
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	for _, active := range [][2]time.Duration{
+		{0, 90 * time.Minute},
+		{30 * time.Minute, 45 * time.Minute},
+		{130 * time.Minute, 3 * time.Hour},
+	} {
+		var (
+			start = base.Add(active[0])
+			end   = base.Add(active[1])
+			isa   = *serviceArea
+		)
+		isa.ID = dssmodels.ID(uuid.New().String())
+		isa.StartTime, isa.EndTime = &start, &end
+		_, err := repo.InsertISA(ctx, &isa)
+		require.NoError(t, err)
+	}
+
+	// The last bucket is shortened to end with the range.
+	counts, err := repo.CountISAsByInterval(ctx, base, base.Add(150*time.Minute), time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 1, 1}, counts)
+
+	counts, err = repo.CountISAsByInterval(ctx, base.Add(-time.Hour), base.Add(2*time.Hour), 30*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 0, 2, 1, 1, 0}, counts)
+}
+
+func TestInsertISAWithExistingIDAlreadyExists(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	isa := *serviceArea
+	isa.ID = dssmodels.ID(uuid.New().String())
+	_, err = repo.InsertISA(ctx, &isa)
+	require.NoError(t, err)
+
+	_, err = repo.InsertISA(ctx, &isa)
+	require.Equal(t, dsserr.AlreadyExists, stacktrace.GetCode(err))
+	require.Contains(t, stacktrace.RootCause(err).Error(), isa.ID.String())
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	require.True(t, isUniqueViolation(stacktrace.Propagate(&pq.Error{Code: "23505"}, "Error in query")))
+	require.False(t, isUniqueViolation(stacktrace.Propagate(&pq.Error{Code: "40001"}, "Error in query")))
+	require.False(t, isUniqueViolation(nil))
+}
@@ -0,0 +1,100 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/logging"
+	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+// stallingDriver serves a fixed number of ISA rows for every query and then
+// blocks until the query's context expires, simulating a scan that times out
+// midway.
+type stallingDriver struct {
+	rows int
+}
+
+func (d *stallingDriver) Open(string) (driver.Conn, error) {
+	return &stallingConn{rows: d.rows}, nil
+}
+
+type stallingConn struct {
+	rows int
+}
+
+func (c *stallingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *stallingConn) Close() error {
+	return nil
+}
+
+func (c *stallingConn) Begin() (driver.Tx, error) {
+	return nil, driver.ErrSkip
+}
+
+func (c *stallingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &stallingRows{ctx: ctx, remaining: c.rows}, nil
+}
+
+type stallingRows struct {
+	ctx       context.Context
+	remaining int
+}
+
+func (r *stallingRows) Columns() []string {
+	return []string{"id", "owner", "url", "cells", "starts_at", "ends_at", "writer", "updated_at"}
+}
+
+func (r *stallingRows) Close() error {
+	return nil
+}
+
+func (r *stallingRows) Next(dest []driver.Value) error {
+	if r.remaining == 0 {
+		<-r.ctx.Done()
+		return r.ctx.Err()
+	}
+	r.remaining--
+	now := time.Now()
+	dest[0] = "4348c8e5-0b1c-43cf-9114-2e67a4532765"
+	dest[1] = "me"
+	dest[2] = "https://no/place/like/home"
+	dest[3] = []byte("{1}")
+	dest[4] = now
+	dest[5] = now.Add(time.Hour)
+	dest[6] = "locality"
+	dest[7] = now
+	return nil
+}
+
+func init() {
+	sql.Register("stalling", &stallingDriver{rows: 3})
+}
+
+func TestSearchISAsReturnsPartialResultsOnTimeout(t *testing.T) {
+	db, err := sql.Open("stalling", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := &isaRepo{Queryable: db, logger: logging.Logger}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	earliest := time.Now()
+	isas, err := repo.SearchISAs(ctx, s2.CellUnion{s2.CellID(1)}, &earliest, nil, "", 0)
+	require.Error(t, err)
+	require.Equal(t, repos.ErrIncompleteSearch, stacktrace.RootCause(err))
+	require.Len(t, isas, 3)
+	for _, isa := range isas {
+		require.Equal(t, "me", isa.Owner.String())
+	}
+}
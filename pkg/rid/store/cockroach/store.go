@@ -3,15 +3,20 @@ package cockroach
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
-	"github.com/cockroachdb/cockroach-go/crdb"
 	"github.com/coreos/go-semver/semver"
 	"github.com/dpjacques/clockwork"
+	"github.com/golang/geo/s2"
 	"github.com/interuss/dss/pkg/cockroach"
+	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/dss/pkg/logging"
+	dssmodels "github.com/interuss/dss/pkg/models"
 	"github.com/interuss/dss/pkg/rid/repos"
+	dssql "github.com/interuss/dss/pkg/sql"
 	"github.com/interuss/stacktrace"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -34,6 +39,11 @@ var (
 	// DatabaseName is the name of database storing remote ID data.
 	DatabaseName = "defaultdb"
 
+	// FollowerReads makes the Store returned by NewStore serve Read from the
+	// nearest replica, as of follower_read_timestamp(); see
+	// cockroach.DB.ExecuteFollowerReadTx for the staleness this implies.
+	FollowerReads = false
+
 	v310 = *semver.New("3.1.0")
 )
 
@@ -48,17 +58,19 @@ type repo struct {
 // TODO: Add the SCD interfaces here, and collapse this store with the
 // outer pkg/cockroach
 type Store struct {
-	db     *cockroach.DB
-	logger *zap.Logger
-	clock  clockwork.Clock
+	db            *cockroach.DB
+	logger        *zap.Logger
+	clock         clockwork.Clock
+	followerReads bool
 }
 
 // NewStore returns a Store instance connected to a cockroach instance via db.
 func NewStore(ctx context.Context, db *cockroach.DB, logger *zap.Logger) (*Store, error) {
 	store := &Store{
-		db:     db,
-		logger: logger,
-		clock:  DefaultClock,
+		db:            db,
+		logger:        logger,
+		clock:         DefaultClock,
+		followerReads: FollowerReads,
 	}
 
 	if err := store.CheckCurrentMajorSchemaVersion(ctx); err != nil {
@@ -94,14 +106,14 @@ func (s *Store) Interact(ctx context.Context) (repos.Repository, error) {
 	}
 
 	return &repo{
-		ISA:          NewISARepo(ctx, s.db, *storeVersion, logger),
-		Subscription: NewISASubscriptionRepo(ctx, s.db, *storeVersion, logger, s.clock),
+		ISA:          NewISARepo(ctx, dssql.WithTiming(s.db), *storeVersion, logger),
+		Subscription: NewISASubscriptionRepo(ctx, dssql.WithTiming(s.db), *storeVersion, logger, s.clock),
 	}, nil
 }
 
 // Transact supplies a new repo, that will perform all of the DB accesses
 // in a Txn, and will retry any Txn's that fail due to retry-able errors
-// (typically contention).
+// (typically contention); see cockroach.DB.ExecuteTx.
 func (s *Store) Transact(ctx context.Context, f func(repo repos.Repository) error) error {
 	logger := logging.WithValuesFromContext(ctx, s.logger)
 	// TODO: consider what tx opts we want to support.
@@ -114,16 +126,50 @@ func (s *Store) Transact(ctx context.Context, f func(repo repos.Repository) erro
 	if err != nil {
 		return stacktrace.Propagate(err, "Error determining database RID schema version")
 	}
-	return crdb.ExecuteTx(ctx, s.db.DB, nil /* nil txopts */, func(tx *sql.Tx) error {
+	return s.db.ExecuteTx(ctx, func(tx *sql.Tx) error {
 		// Is this recover still necessary?
 		defer recoverRollbackRepanic(ctx, tx)
 		return f(&repo{
-			ISA:          NewISARepo(ctx, tx, *storeVersion, logger),
-			Subscription: NewISASubscriptionRepo(ctx, tx, *storeVersion, logger, s.clock),
+			ISA:          NewISARepo(ctx, dssql.WithTiming(tx), *storeVersion, logger),
+			Subscription: NewISASubscriptionRepo(ctx, dssql.WithTiming(tx), *storeVersion, logger, s.clock),
+		})
+	})
+}
+
+// Read implements store.Reader interface. Unless s serves follower reads, f
+// interacts with s as with Interact, reading the latest writes.
+func (s *Store) Read(ctx context.Context, f func(repo repos.Repository) error) error {
+	if !s.followerReads {
+		repo, err := s.Interact(ctx)
+		if err != nil {
+			return err // No need to Propagate this error as this stack layer does not add useful information
+		}
+		return f(repo)
+	}
+
+	logger := logging.WithValuesFromContext(ctx, s.logger)
+	storeVersion, err := s.GetVersion(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "Error determining database RID schema version")
+	}
+	return s.db.ExecuteFollowerReadTx(ctx, func(tx *sql.Tx) error {
+		return f(&repo{
+			ISA:          NewISARepo(ctx, dssql.WithTiming(tx), *storeVersion, logger),
+			Subscription: NewISASubscriptionRepo(ctx, dssql.WithTiming(tx), *storeVersion, logger, s.clock),
 		})
 	})
 }
 
+// Ping verifies that the database is reachable.
+func (s *Store) Ping(ctx context.Context) error {
+	return stacktrace.Propagate(s.db.PingContext(ctx), "Error pinging remote ID database")
+}
+
+// Stats returns the statistics of the remote ID database connection pool.
+func (s *Store) Stats() sql.DBStats {
+	return s.db.Stats()
+}
+
 // Close closes the underlying DB connection.
 func (s *Store) Close() error {
 	return s.db.Close()
@@ -139,6 +185,15 @@ func recoverRollbackRepanic(ctx context.Context, tx *sql.Tx) {
 	}
 }
 
+// RefreshStatistics implements store.Store interface.
+func (s *Store) RefreshStatistics(ctx context.Context) ([]string, error) {
+	tables := []string{"identification_service_areas", "subscriptions"}
+	if err := s.db.RefreshStatistics(ctx, tables...); err != nil {
+		return nil, stacktrace.Propagate(err, "Error refreshing remote ID statistics")
+	}
+	return tables, nil
+}
+
 // CleanUp removes all database tables managed by s.
 func (s *Store) CleanUp(ctx context.Context) error {
 	const query = `
@@ -149,8 +204,177 @@ func (s *Store) CleanUp(ctx context.Context) error {
 	return err
 }
 
+// deleteExpired deletes the rows of "table" that ended before "expiredBefore"
+// and returns the number deleted.
+func deleteExpired(ctx context.Context, q dssql.Queryable, table string, expiredBefore time.Time) (int, error) {
+	result, err := q.ExecContext(ctx, fmt.Sprintf(`
+		DELETE FROM
+			%s
+		WHERE
+			ends_at < $1`, table), expiredBefore)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error deleting expired rows from %s", table)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error counting expired rows deleted from %s", table)
+	}
+	return int(deleted), nil
+}
+
+// listByIDQuery returns a query, and its arguments, selecting "fields" of up
+// to "limit" rows of "table" with an ID greater than "afterID", or from the
+// first row if "afterID" is empty, ordered by ID.
+func listByIDQuery(table, fields string, afterID dssmodels.ID, limit int) (string, []interface{}, error) {
+	if limit <= 0 {
+		return "", nil, stacktrace.NewError("Invalid limit %d for listing %s", limit, table)
+	}
+	if afterID.Empty() {
+		return fmt.Sprintf(`
+			SELECT
+				%s
+			FROM
+				%s
+			ORDER BY
+				id
+			LIMIT $1`, fields, table), []interface{}{limit}, nil
+	}
+	return fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			%s
+		WHERE
+			id > $1
+		ORDER BY
+			id
+		LIMIT $2`, fields, table), []interface{}{afterID, limit}, nil
+}
+
+// countActiveByInterval counts the rows of table active during each
+// "interval" between "start" and "end" with a single aggregation query.
+func countActiveByInterval(ctx context.Context, q dssql.Queryable, table string, start, end time.Time, interval time.Duration) ([]int, error) {
+	if interval <= 0 || !start.Before(end) {
+		return nil, stacktrace.NewError("Invalid interval %s between %s and %s", interval, start, end)
+	}
+	buckets := int((end.Sub(start) + interval - 1) / interval)
+	query := fmt.Sprintf(`
+		SELECT
+			COUNT(%[1]s.id)
+		FROM
+			generate_series(0, $4::INT8 - 1) AS bucket
+		LEFT JOIN
+			%[1]s
+		ON
+			%[1]s.starts_at < LEAST($1::TIMESTAMPTZ + (bucket + 1) * $3::INT8 * INTERVAL '1 microsecond', $2::TIMESTAMPTZ)
+		AND
+			%[1]s.ends_at > $1::TIMESTAMPTZ + bucket * $3::INT8 * INTERVAL '1 microsecond'
+		GROUP BY
+			bucket
+		ORDER BY
+			bucket`, table)
+	rows, err := q.QueryContext(ctx, query, start, end, interval.Microseconds(), buckets)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	defer rows.Close()
+
+	counts := make([]int, 0, buckets)
+	for rows.Next() {
+		var count int
+		if err := rows.Scan(&count); err != nil {
+			return nil, stacktrace.Propagate(err, "Error scanning count of %s", table)
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error in rows query result")
+	}
+	return counts, nil
+}
+
+// countActiveByOwner counts the rows of table owned by "owner" that have not
+// ended by "activeAt".
+func countActiveByOwner(ctx context.Context, q dssql.Queryable, table string, owner dssmodels.Owner, activeAt time.Time) (int, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM
+			%s
+		WHERE
+			owner = $1
+		AND
+			ends_at >= $2`, table)
+	var count int
+	err := q.QueryRowContext(ctx, query, owner, activeAt).Scan(&count)
+	return count, stacktrace.Propagate(err, "Error scanning count of %s owned by %s", table, owner)
+}
+
+// anyActiveInCells returns true if any row of "table" in "cells" has not ended
+// by "activeAt". The query stops at the first such row, rather than reading
+// every row in "cells".
+func anyActiveInCells(ctx context.Context, q dssql.Queryable, table string, cells s2.CellUnion, activeAt time.Time) (bool, error) {
+	if len(cells) == 0 {
+		return false, stacktrace.NewErrorWithCode(dsserr.BadRequest, "no location provided")
+	}
+	cids := make([]int64, len(cells))
+	for i, cell := range cells {
+		cids[i] = int64(cell)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			1
+		FROM
+			%s
+		WHERE
+			cells && $1
+		AND
+			ends_at >= $2
+		LIMIT 1`, table)
+	var one int
+	err := q.QueryRowContext(ctx, query, pq.Int64Array(cids), activeAt).Scan(&one)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, stacktrace.Propagate(err, "Error querying %s in cells", table)
+	}
+	return true, nil
+}
+
 // GetVersion returns the Version string for the Database.
 // If the DB was is not bootstrapped using the schema manager we throw and error
 func (s *Store) GetVersion(ctx context.Context) (*semver.Version, error) {
 	return s.db.GetVersion(ctx, DatabaseName)
 }
+
+// pageFilter returns the clause restricting a query, whose WHERE clause takes
+// "args", to up to "limit" rows with an ID greater than "afterID", ordered by
+// ID, along with the arguments of the restricted query. Rows are not skipped
+// if "afterID" is empty, and not limited if "limit" is 0. Searches requesting
+// no page at all are left unordered, so that they return every result without
+// the cost of sorting them.
+func pageFilter(args []interface{}, afterID dssmodels.ID, limit int) (string, []interface{}, error) {
+	if limit < 0 {
+		return "", nil, stacktrace.NewError("Invalid limit %d for a page", limit)
+	}
+	if afterID.Empty() && limit == 0 {
+		return "", args, nil
+	}
+	var filter string
+	if !afterID.Empty() {
+		args = append(args, afterID)
+		filter += fmt.Sprintf(`
+			AND
+				id > $%d`, len(args))
+	}
+	filter += `
+			ORDER BY
+				id`
+	if limit > 0 {
+		args = append(args, limit)
+		filter += fmt.Sprintf(`
+			LIMIT $%d`, len(args))
+	}
+	return filter, args, nil
+}
@@ -256,3 +256,27 @@ func TestBasicTxn(t *testing.T) {
 
 	require.Len(t, subs, 1)
 }
+
+func TestPageFilterOrdersEveryPage(t *testing.T) {
+	args := []interface{}{"cells"}
+
+	filter, filtered, err := pageFilter(args, "", 0)
+	require.NoError(t, err)
+	require.Empty(t, filter)
+	require.Equal(t, args, filtered)
+
+	// A page after an ID is ordered, even if it is not limited.
+	filter, filtered, err = pageFilter(args, "0000-after", 0)
+	require.NoError(t, err)
+	require.Contains(t, filter, "id > $2")
+	require.Contains(t, filter, "ORDER BY")
+	require.NotContains(t, filter, "LIMIT")
+	require.Equal(t, []interface{}{"cells", dssmodels.ID("0000-after")}, filtered)
+
+	filter, filtered, err = pageFilter(args, "", 10)
+	require.NoError(t, err)
+	require.NotContains(t, filter, "id >")
+	require.Contains(t, filter, "ORDER BY")
+	require.Contains(t, filter, "LIMIT $2")
+	require.Equal(t, []interface{}{"cells", 10}, filtered)
+}
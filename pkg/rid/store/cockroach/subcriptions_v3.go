@@ -3,6 +3,7 @@ package cockroach
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dpjacques/clockwork"
 	dsserr "github.com/interuss/dss/pkg/errors"
@@ -139,12 +140,12 @@ func (c *subscriptionRepoV3) UpdateSubscription(ctx context.Context, s *ridmodel
 			%s`, updateSubscriptionFieldsV3, subscriptionFieldsV3)
 	)
 
-	cids := make([]int64, len(s.Cells))
+	if err := geo.ValidateCells(s.Cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Error validating cells")
+	}
 
+	cids := make([]int64, len(s.Cells))
 	for i, cell := range s.Cells {
-		if err := geo.ValidateCell(cell); err != nil {
-			return nil, stacktrace.Propagate(err, "Error validating cell")
-		}
 		cids[i] = int64(cell)
 	}
 
@@ -172,12 +173,12 @@ func (c *subscriptionRepoV3) InsertSubscription(ctx context.Context, s *ridmodel
 			%s`, subscriptionFieldsV3, subscriptionFieldsV3)
 	)
 
-	cids := make([]int64, len(s.Cells))
+	if err := geo.ValidateCells(s.Cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Error validating cells")
+	}
 
+	cids := make([]int64, len(s.Cells))
 	for i, cell := range s.Cells {
-		if err := geo.ValidateCell(cell); err != nil {
-			return nil, stacktrace.Propagate(err, "Error validating cell")
-		}
 		cids[i] = int64(cell)
 	}
 
@@ -193,7 +194,8 @@ func (c *subscriptionRepoV3) InsertSubscription(ctx context.Context, s *ridmodel
 
 // DeleteSubscription deletes the subscription identified by ID.
 // It must be done in a txn and the version verified.
-// Returns nil, nil if ID, version not found
+// Returns nil, nil if ID not found, and a VersionMismatch error if the stored
+// Subscription is at a different version than "s".
 func (c *subscriptionRepoV3) DeleteSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error) {
 	var (
 		query = fmt.Sprintf(`
@@ -204,18 +206,31 @@ func (c *subscriptionRepoV3) DeleteSubscription(ctx context.Context, s *ridmodel
 			AND updated_at = $2
 		RETURNING %s`, subscriptionFieldsV3)
 	)
-	return c.processOne(ctx, query, s.ID, s.Version.ToTimestamp())
+	deleted, err := c.processOne(ctx, query, s.ID, s.Version.ToTimestamp())
+	if err != nil || deleted != nil {
+		return deleted, err
+	}
+
+	current, err := c.GetSubscription(ctx, s.ID)
+	switch {
+	case err != nil:
+		return nil, stacktrace.Propagate(err, "Error checking for Subscription %s after failed delete", s.ID)
+	case current != nil:
+		return nil, stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+			"Subscription currently at version %s but delete specified %s", current.Version, s.Version)
+	}
+	return nil, nil
 }
 
 // UpdateNotificationIdxsInCells incremement the notification for each sub in the given cells.
 func (c *subscriptionRepoV3) UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
 	var updateQuery = fmt.Sprintf(`
 			UPDATE subscriptions
-			SET notification_index = notification_index + 1
+			SET notification_index = %s
 			WHERE
 				cells && $1
 				AND ends_at >= $2
-			RETURNING %s`, subscriptionFieldsV3)
+			RETURNING %s`, nextNotificationIndex, subscriptionFieldsV3)
 
 	cids := make([]int64, len(cells))
 	for i, cell := range cells {
@@ -225,6 +240,12 @@ func (c *subscriptionRepoV3) UpdateNotificationIdxsInCells(ctx context.Context,
 		ctx, updateQuery, pq.Int64Array(cids), c.clock.Now())
 }
 
+// GetNotificationIndices returns the current and next notification indices
+// of the Subscription identified by "id".
+func (c *subscriptionRepoV3) GetNotificationIndices(ctx context.Context, id dssmodels.ID) (int, int, error) {
+	return getNotificationIndices(ctx, c, id)
+}
+
 // SearchSubscriptions returns all subscriptions in "cells".
 func (c *subscriptionRepoV3) SearchSubscriptions(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
 	var (
@@ -251,30 +272,57 @@ func (c *subscriptionRepoV3) SearchSubscriptions(ctx context.Context, cells s2.C
 	return c.process(ctx, query, pq.Int64Array(cids), c.clock.Now())
 }
 
-// SearchSubscriptionsByOwner returns all subscriptions in "cells".
-func (c *subscriptionRepoV3) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error) {
+// SearchSubscriptionsByOwner returns all subscriptions owned by "owner" in
+// "cells", or anywhere if "cells" is empty, paged as by SearchISAs.
+func (c *subscriptionRepoV3) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
 	var (
-		query = fmt.Sprintf(`
+		args        = []interface{}{owner, c.clock.Now()}
+		cellsFilter string
+	)
+	if len(cells) > 0 {
+		cids := make([]int64, len(cells))
+		for i, cell := range cells {
+			cids[i] = int64(cell)
+		}
+		args = append(args, pq.Int64Array(cids))
+		cellsFilter = `
+			AND
+				cells && $3`
+	}
+	page, args, err := pageFilter(args, afterID, limit)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	query := fmt.Sprintf(`
 			SELECT
 				%s
 			FROM
 				subscriptions
 			WHERE
-				cells && $1
+				subscriptions.owner = $1
 			AND
-				subscriptions.owner = $2
-			AND
-				ends_at >= $3`, subscriptionFieldsV3)
-	)
+				ends_at >= $2%s%s`, subscriptionFieldsV3, cellsFilter, page)
 
-	if len(cells) == 0 {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "no location provided")
-	}
+	return c.process(ctx, query, args...)
+}
 
-	cids := make([]int64, len(cells))
-	for i, cell := range cells {
-		cids[i] = int64(cell)
+// DeleteExpiredSubscriptions deletes the Subscriptions that ended before "expiredBefore".
+func (c *subscriptionRepoV3) DeleteExpiredSubscriptions(ctx context.Context, expiredBefore time.Time) (int, error) {
+	return deleteExpired(ctx, c, "subscriptions", expiredBefore)
+}
+
+// ListSubscriptions returns up to "limit" Subscriptions with an ID greater than "afterID",
+// ordered by ID.
+func (c *subscriptionRepoV3) ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	query, args, err := listByIDQuery("subscriptions", subscriptionFieldsV3, afterID, limit)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
+	return c.process(ctx, query, args...)
+}
 
-	return c.process(ctx, query, pq.Int64Array(cids), owner, c.clock.Now())
+// CountSubscriptionsByOwner implements repos.Subscription.CountSubscriptionsByOwner.
+func (c *subscriptionRepoV3) CountSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, activeAt time.Time) (int, error) {
+	return countActiveByOwner(ctx, c, "subscriptions", owner, activeAt)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/dpjacques/clockwork"
@@ -21,6 +22,11 @@ import (
 )
 
 const (
+	// nextNotificationIndex is the notification index of the next
+	// notification of a Subscription, as incremented by
+	// UpdateNotificationIdxsInCells and predicted by GetNotificationIndices.
+	nextNotificationIndex = "notification_index + 1"
+
 	subscriptionFields       = "id, owner, url, notification_index, cells, starts_at, ends_at, writer, updated_at"
 	updateSubscriptionFields = "id, url, notification_index, cells, starts_at, ends_at, writer, updated_at"
 )
@@ -137,6 +143,21 @@ func (c *subscriptionRepo) MaxSubscriptionCountInCellsByOwner(ctx context.Contex
 	return ret, stacktrace.Propagate(err, "Error scanning subscription count row")
 }
 
+// getNotificationIndices returns the notification index of the Subscription
+// identified by "id", and the one its next notification will carry, or an
+// error with code NotFound if there is no such Subscription.
+func getNotificationIndices(ctx context.Context, q dssql.Queryable, id dssmodels.ID) (int, int, error) {
+	var query = fmt.Sprintf(`
+		SELECT notification_index, %s FROM subscriptions
+		WHERE id = $1`, nextNotificationIndex)
+	var current, next int
+	err := q.QueryRowContext(ctx, query, id).Scan(&current, &next)
+	if err == sql.ErrNoRows {
+		return 0, 0, stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", id)
+	}
+	return current, next, stacktrace.Propagate(err, "Error scanning notification indices of Subscription %s", id)
+}
+
 // GetSubscription returns the subscription identified by "id".
 // Returns nil, nil if not found
 func (c *subscriptionRepo) GetSubscription(ctx context.Context, id dssmodels.ID) (*ridmodels.Subscription, error) {
@@ -160,12 +181,12 @@ func (c *subscriptionRepo) UpdateSubscription(ctx context.Context, s *ridmodels.
 			%s`, updateSubscriptionFields, subscriptionFields)
 	)
 
-	cids := make([]int64, len(s.Cells))
+	if err := geo.ValidateCells(s.Cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Error validating cells")
+	}
 
+	cids := make([]int64, len(s.Cells))
 	for i, cell := range s.Cells {
-		if err := geo.ValidateCell(cell); err != nil {
-			return nil, stacktrace.Propagate(err, "Error validating cell")
-		}
 		cids[i] = int64(cell)
 	}
 
@@ -194,12 +215,12 @@ func (c *subscriptionRepo) InsertSubscription(ctx context.Context, s *ridmodels.
 			%s`, subscriptionFields, subscriptionFields)
 	)
 
-	cids := make([]int64, len(s.Cells))
+	if err := geo.ValidateCells(s.Cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Error validating cells")
+	}
 
+	cids := make([]int64, len(s.Cells))
 	for i, cell := range s.Cells {
-		if err := geo.ValidateCell(cell); err != nil {
-			return nil, stacktrace.Propagate(err, "Error validating cell")
-		}
 		cids[i] = int64(cell)
 	}
 
@@ -216,7 +237,8 @@ func (c *subscriptionRepo) InsertSubscription(ctx context.Context, s *ridmodels.
 
 // DeleteSubscription deletes the subscription identified by ID.
 // It must be done in a txn and the version verified.
-// Returns nil, nil if ID, version not found
+// Returns nil, nil if ID not found, and a VersionMismatch error if the stored
+// Subscription is at a different version than "s".
 func (c *subscriptionRepo) DeleteSubscription(ctx context.Context, s *ridmodels.Subscription) (*ridmodels.Subscription, error) {
 	var (
 		query = fmt.Sprintf(`
@@ -227,18 +249,31 @@ func (c *subscriptionRepo) DeleteSubscription(ctx context.Context, s *ridmodels.
 			AND updated_at = $2
 		RETURNING %s`, subscriptionFields)
 	)
-	return c.processOne(ctx, query, s.ID, s.Version.ToTimestamp())
+	deleted, err := c.processOne(ctx, query, s.ID, s.Version.ToTimestamp())
+	if err != nil || deleted != nil {
+		return deleted, err
+	}
+
+	current, err := c.GetSubscription(ctx, s.ID)
+	switch {
+	case err != nil:
+		return nil, stacktrace.Propagate(err, "Error checking for Subscription %s after failed delete", s.ID)
+	case current != nil:
+		return nil, stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+			"Subscription currently at version %s but delete specified %s", current.Version, s.Version)
+	}
+	return nil, nil
 }
 
 // UpdateNotificationIdxsInCells incremement the notification for each sub in the given cells.
 func (c *subscriptionRepo) UpdateNotificationIdxsInCells(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
 	var updateQuery = fmt.Sprintf(`
 			UPDATE subscriptions
-			SET notification_index = notification_index + 1
+			SET notification_index = %s
 			WHERE
 				cells && $1
 				AND ends_at >= $2
-			RETURNING %s`, subscriptionFields)
+			RETURNING %s`, nextNotificationIndex, subscriptionFields)
 
 	cids := make([]int64, len(cells))
 	for i, cell := range cells {
@@ -248,6 +283,12 @@ func (c *subscriptionRepo) UpdateNotificationIdxsInCells(ctx context.Context, ce
 		ctx, updateQuery, pq.Int64Array(cids), c.clock.Now())
 }
 
+// GetNotificationIndices returns the current and next notification indices
+// of the Subscription identified by "id".
+func (c *subscriptionRepo) GetNotificationIndices(ctx context.Context, id dssmodels.ID) (int, int, error) {
+	return getNotificationIndices(ctx, c, id)
+}
+
 // SearchSubscriptions returns all subscriptions in "cells".
 func (c *subscriptionRepo) SearchSubscriptions(ctx context.Context, cells s2.CellUnion) ([]*ridmodels.Subscription, error) {
 	var (
@@ -274,30 +315,57 @@ func (c *subscriptionRepo) SearchSubscriptions(ctx context.Context, cells s2.Cel
 	return c.process(ctx, query, pq.Int64Array(cids), c.clock.Now())
 }
 
-// SearchSubscriptionsByOwner returns all subscriptions in "cells".
-func (c *subscriptionRepo) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner) ([]*ridmodels.Subscription, error) {
+// SearchSubscriptionsByOwner returns all subscriptions owned by "owner" in
+// "cells", or anywhere if "cells" is empty, paged as by SearchISAs.
+func (c *subscriptionRepo) SearchSubscriptionsByOwner(ctx context.Context, cells s2.CellUnion, owner dssmodels.Owner, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
 	var (
-		query = fmt.Sprintf(`
+		args        = []interface{}{owner, c.clock.Now()}
+		cellsFilter string
+	)
+	if len(cells) > 0 {
+		cids := make([]int64, len(cells))
+		for i, cell := range cells {
+			cids[i] = int64(cell)
+		}
+		args = append(args, pq.Int64Array(cids))
+		cellsFilter = `
+			AND
+				cells && $3`
+	}
+	page, args, err := pageFilter(args, afterID, limit)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
+	query := fmt.Sprintf(`
 			SELECT
 				%s
 			FROM
 				subscriptions
 			WHERE
-				cells && $1
-			AND
-				subscriptions.owner = $2
+				subscriptions.owner = $1
 			AND
-				ends_at >= $3`, subscriptionFields)
-	)
+				ends_at >= $2%s%s`, subscriptionFields, cellsFilter, page)
 
-	if len(cells) == 0 {
-		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "no location provided")
-	}
+	return c.process(ctx, query, args...)
+}
 
-	cids := make([]int64, len(cells))
-	for i, cell := range cells {
-		cids[i] = int64(cell)
+// DeleteExpiredSubscriptions deletes the Subscriptions that ended before "expiredBefore".
+func (c *subscriptionRepo) DeleteExpiredSubscriptions(ctx context.Context, expiredBefore time.Time) (int, error) {
+	return deleteExpired(ctx, c, "subscriptions", expiredBefore)
+}
+
+// ListSubscriptions returns up to "limit" Subscriptions with an ID greater than "afterID",
+// ordered by ID.
+func (c *subscriptionRepo) ListSubscriptions(ctx context.Context, afterID dssmodels.ID, limit int) ([]*ridmodels.Subscription, error) {
+	query, args, err := listByIDQuery("subscriptions", subscriptionFields, afterID, limit)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
 	}
+	return c.process(ctx, query, args...)
+}
 
-	return c.process(ctx, query, pq.Int64Array(cids), owner, c.clock.Now())
+// CountSubscriptionsByOwner implements repos.Subscription.CountSubscriptionsByOwner.
+func (c *subscriptionRepo) CountSubscriptionsByOwner(ctx context.Context, owner dssmodels.Owner, activeAt time.Time) (int, error) {
+	return countActiveByOwner(ctx, c, "subscriptions", owner, activeAt)
 }
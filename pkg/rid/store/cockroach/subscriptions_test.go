@@ -7,9 +7,11 @@ import (
 
 	"github.com/golang/geo/s2"
 	"github.com/google/uuid"
+	dsserr "github.com/interuss/dss/pkg/errors"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	ridmodels "github.com/interuss/dss/pkg/rid/models"
 	"github.com/interuss/dss/pkg/rid/repos"
+	"github.com/interuss/stacktrace"
 	"github.com/stretchr/testify/require"
 )
 
@@ -155,14 +157,19 @@ func TestStoreDeleteSubscription(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, sub1)
 
-			// Ensure mismatched versions returns nothing
+			// Ensure mismatched versions are rejected and leave the Subscription in place
 			sub1BadVersion := *sub1
 			sub1BadVersion.Version, err = dssmodels.VersionFromString("a3cg3tcuhk000")
 			require.NoError(t, err)
 			sub2, err := repo.DeleteSubscription(ctx, &sub1BadVersion)
-			require.NoError(t, err)
+			require.Error(t, err)
+			require.Equal(t, dsserr.VersionMismatch, stacktrace.GetCode(err))
 			require.Nil(t, sub2)
 
+			sub3, err := repo.GetSubscription(ctx, sub1.ID)
+			require.NoError(t, err)
+			require.NotNil(t, sub3)
+
 			sub4, err := repo.DeleteSubscription(ctx, sub1)
 			require.NoError(t, err)
 			require.NotNil(t, sub4)
@@ -213,7 +220,7 @@ func TestStoreSearchSubscription(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, found, 3)
 	for _, owner := range owners {
-		found, err := repo.SearchSubscriptionsByOwner(ctx, cells, owner)
+		found, err := repo.SearchSubscriptionsByOwner(ctx, cells, owner, "", 0)
 		require.NoError(t, err)
 		require.NotNil(t, found)
 		// We insert one subscription per owner. Hence, no matter how many cells are touched by the subscription,
@@ -244,7 +251,7 @@ func TestStoreExpiredSubscription(t *testing.T) {
 	fakeClock.Advance(23 * time.Hour)
 
 	// We should still be able to find the subscription by searching and by ID.
-	subs, err := repo.SearchSubscriptionsByOwner(ctx, sub.Cells, "original owner")
+	subs, err := repo.SearchSubscriptionsByOwner(ctx, sub.Cells, "original owner", "", 0)
 	require.NoError(t, err)
 	require.Len(t, subs, 1)
 
@@ -255,7 +262,7 @@ func TestStoreExpiredSubscription(t *testing.T) {
 	// But now the subscription has expired.
 	fakeClock.Advance(2 * time.Hour)
 
-	subs, err = repo.SearchSubscriptionsByOwner(ctx, sub.Cells, "original owner")
+	subs, err = repo.SearchSubscriptionsByOwner(ctx, sub.Cells, "original owner", "", 0)
 	require.NoError(t, err)
 	require.Len(t, subs, 0)
 
@@ -299,3 +306,36 @@ func TestMaxSubscriptionCountInCellsByOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 2, count)
 }
+
+func TestGetNotificationIndicesPredictsIncrement(t *testing.T) {
+	ctx := context.Background()
+	store, tearDownStore := setUpStore(ctx, t)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	sub, err := repo.InsertSubscription(ctx, subscriptionsPool[0].input)
+	require.NoError(t, err)
+
+	// Reading the indices does not change them.
+	for i := 0; i < 2; i++ {
+		current, next, err := repo.GetNotificationIndices(ctx, sub.ID)
+		require.NoError(t, err)
+		require.Equal(t, 42, current)
+		require.Equal(t, 43, next)
+	}
+
+	subs, err := repo.UpdateNotificationIdxsInCells(ctx, sub.Cells)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	require.Equal(t, 43, subs[0].NotificationIndex)
+
+	current, next, err := repo.GetNotificationIndices(ctx, sub.ID)
+	require.NoError(t, err)
+	require.Equal(t, 43, current)
+	require.Equal(t, 44, next)
+
+	_, _, err = repo.GetNotificationIndices(ctx, dssmodels.ID(uuid.New().String()))
+	require.Equal(t, dsserr.NotFound, stacktrace.GetCode(err))
+}
@@ -14,9 +14,14 @@ type Store interface {
 	io.Closer
 	Interactor
 	Transactor
+	Reader
 
 	// Get store version
 	GetVersion(ctx context.Context) (*semver.Version, error)
+
+	// RefreshStatistics refreshes the statistics of the tables storing remote
+	// ID entities and returns their names.
+	RefreshStatistics(ctx context.Context) ([]string, error)
 }
 
 // Interactor provides means to get hold of a repos.Repository instance *without* any
@@ -33,3 +38,12 @@ type Transactor interface {
 	// isolation/atomicity.
 	Transact(ctx context.Context, f func(repos.Repository) error) error
 }
+
+// Reader provides means to get hold of a repos.Repository instance for reads
+// that tolerate bounded staleness.
+type Reader interface {
+	// Read executes f, which must only read, with a repos.Repository instance
+	// that may serve reads from a recent snapshot rather than the latest
+	// writes. Reads on which a write depends must use Transact instead.
+	Read(ctx context.Context, f func(repos.Repository) error) error
+}
@@ -0,0 +1,105 @@
+package scd
+
+import (
+	"context"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	"github.com/interuss/stacktrace"
+)
+
+// GetUssAvailability returns the availability of the specified USS.
+func (a *Server) GetUssAvailability(ctx context.Context, req *scdpb.GetUssAvailabilityRequest) (*scdpb.UssAvailabilityStatusResponse, error) {
+	uss := dssmodels.Owner(req.GetUssId())
+	if uss == "" {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Missing uss_id")
+	}
+
+	var response *scdpb.UssAvailabilityStatusResponse
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		status, err := r.GetUssAvailability(ctx, uss)
+		if err != nil {
+			return stacktrace.Propagate(err, "Could not get USS availability from repo")
+		}
+		if status == nil {
+			// USSs that never reported their availability are reported as
+			// Unknown, at version 0.
+			status = &scdmodels.UssAvailabilityStatus{
+				Uss:          uss,
+				Availability: scdmodels.UssAvailabilityStateUnknown,
+			}
+		}
+		response = status.ToProto()
+		return nil
+	}
+
+	err := a.Store.Read(ctx, action)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return response, nil
+}
+
+// SetUssAvailability sets the availability of the calling USS.
+func (a *Server) SetUssAvailability(ctx context.Context, req *scdpb.SetUssAvailabilityRequest) (*scdpb.UssAvailabilityStatusResponse, error) {
+	// Retrieve ID of client making call
+	owner, ok := auth.OwnerFromContext(ctx)
+	if !ok {
+		return nil, stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "Missing owner from context")
+	}
+
+	uss := dssmodels.Owner(req.GetUssId())
+	if uss != owner {
+		return nil, stacktrace.Propagate(
+			stacktrace.NewErrorWithCode(dsserr.PermissionDenied, "USS may only set its own availability"),
+			"%s attempted to set the availability of %s", owner, uss)
+	}
+
+	params := req.GetParams()
+	if params == nil {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Params not set")
+	}
+	availability := scdmodels.UssAvailabilityState(params.GetAvailability())
+	if !availability.Valid() {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid availability: `%s`", params.GetAvailability())
+	}
+
+	var response *scdpb.UssAvailabilityStatusResponse
+	action := func(ctx context.Context, r repos.Repository) (err error) {
+		old, err := r.GetUssAvailability(ctx, uss)
+		if err != nil {
+			return stacktrace.Propagate(err, "Could not get USS availability from repo")
+		}
+		if old == nil && params.OldVersion != 0 {
+			return stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+				"Availability of USS %s was never set and therefore is not version %d", uss, params.OldVersion)
+		}
+		if old != nil && old.Version != scdmodels.Version(params.OldVersion) {
+			return stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+				"Current version is %d but client specified version %d", old.Version, params.OldVersion)
+		}
+
+		status, err := r.UpsertUssAvailability(ctx, &scdmodels.UssAvailabilityStatus{
+			Uss:          uss,
+			Availability: availability,
+			Version:      scdmodels.Version(params.OldVersion),
+		})
+		if err != nil {
+			return stacktrace.Propagate(err, "Could not upsert USS availability into repo")
+		}
+		response = status.ToProto()
+		return nil
+	}
+
+	err := a.Store.Transact(ctx, action)
+	if err != nil {
+		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
+	}
+
+	return response, nil
+}
@@ -0,0 +1,82 @@
+package scd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUssAvailabilityLifecycle(t *testing.T) {
+	var (
+		ctx   = auth.ContextWithOwner(context.Background(), "uss1")
+		store = &fakeStore{
+			ussStatuses: map[dssmodels.Owner]*scdmodels.UssAvailabilityStatus{},
+		}
+		s   = &Server{Store: store}
+		set = func(ussID string, availability string, oldVersion int32) (*scdpb.UssAvailabilityStatusResponse, error) {
+			return s.SetUssAvailability(ctx, &scdpb.SetUssAvailabilityRequest{
+				UssId: ussID,
+				Params: &scdpb.SetUssAvailabilityStatusParameters{
+					Availability: availability,
+					OldVersion:   oldVersion,
+				},
+			})
+		}
+	)
+
+	// USSs that never set their availability are Unknown.
+	resp, err := s.GetUssAvailability(ctx, &scdpb.GetUssAvailabilityRequest{UssId: "uss1"})
+	require.NoError(t, err)
+	require.Equal(t, "Unknown", resp.Status.Availability)
+	require.Equal(t, "uss1", resp.Status.Uss)
+	require.Equal(t, int32(0), resp.Version)
+
+	resp, err = set("uss1", "Down", 0)
+	require.NoError(t, err)
+	require.Equal(t, "Down", resp.Status.Availability)
+	require.Equal(t, int32(1), resp.Version)
+
+	// Setting the availability requires its current version.
+	_, err = set("uss1", "Up", 0)
+	require.Error(t, err)
+	require.Equal(t, dsserr.VersionMismatch, stacktrace.GetCode(err))
+
+	resp, err = set("uss1", "Up", 1)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.Version)
+
+	resp, err = s.GetUssAvailability(ctx, &scdpb.GetUssAvailabilityRequest{UssId: "uss1"})
+	require.NoError(t, err)
+	require.Equal(t, "Up", resp.Status.Availability)
+	require.Equal(t, int32(2), resp.Version)
+
+	// Only known availabilities can be set.
+	_, err = set("uss1", "Sideways", 2)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+}
+
+func TestUssMaySetOnlyItsOwnAvailability(t *testing.T) {
+	var (
+		ctx   = auth.ContextWithOwner(context.Background(), "uss1")
+		store = &fakeStore{
+			ussStatuses: map[dssmodels.Owner]*scdmodels.UssAvailabilityStatus{},
+		}
+		s = &Server{Store: store}
+	)
+
+	_, err := s.SetUssAvailability(ctx, &scdpb.SetUssAvailabilityRequest{
+		UssId:  "uss2",
+		Params: &scdpb.SetUssAvailabilityStatusParameters{Availability: "Down"},
+	})
+	require.Error(t, err)
+	require.Equal(t, dsserr.PermissionDenied, stacktrace.GetCode(err))
+	require.Empty(t, store.ussStatuses)
+}
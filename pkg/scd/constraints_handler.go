@@ -8,6 +8,7 @@ import (
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
 	"github.com/interuss/dss/pkg/auth"
 	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	"github.com/interuss/dss/pkg/scd/repos"
@@ -98,6 +99,8 @@ func (a *Server) DeleteConstraintReference(ctx context.Context, req *scdpb.Delet
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	a.notify(ctx, EntityChange{Kind: ConstraintEntity, ID: id, Deleted: true, Subscribers: response.Subscribers})
+
 	return response, nil
 }
 
@@ -141,7 +144,7 @@ func (a *Server) GetConstraintReference(ctx context.Context, req *scdpb.GetConst
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.Store.Read(ctx, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
@@ -195,6 +198,9 @@ func (a *Server) PutConstraintReference(ctx context.Context, req *scdpb.PutConst
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Invalid area")
 	}
+	if err := geo.ValidateStoredCells(cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid area")
+	}
 
 	var response *scdpb.ChangeConstraintReferenceResponse
 	action := func(ctx context.Context, r repos.Repository) (err error) {
@@ -299,6 +305,8 @@ func (a *Server) PutConstraintReference(ctx context.Context, req *scdpb.PutConst
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	a.notify(ctx, EntityChange{Kind: ConstraintEntity, ID: id, Subscribers: response.Subscribers})
+
 	return response, nil
 }
 
@@ -347,7 +355,7 @@ func (a *Server) QueryConstraintReferences(ctx context.Context, req *scdpb.Query
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.Store.Read(ctx, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
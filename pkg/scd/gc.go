@@ -0,0 +1,25 @@
+package scd
+
+import (
+	"context"
+	"time"
+
+	"github.com/interuss/stacktrace"
+)
+
+// GCGracePeriod is how long Operations are kept after they end before
+// CollectGarbage deletes them.
+var GCGracePeriod time.Duration
+
+// CollectGarbage deletes the Operations that ended more than GCGracePeriod
+// ago and returns how many were deleted. Operations are deleted by their end
+// time alone, so that any number of DSS instances may collect garbage
+// concurrently.
+func (a *Server) CollectGarbage(ctx context.Context) (int, error) {
+	repo, err := a.Store.Interact(ctx)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Unable to interact with store")
+	}
+	deleted, err := repo.DeleteExpiredOperations(ctx, DefaultClock.Now().Add(-GCGracePeriod))
+	return deleted, stacktrace.Propagate(err, "Error deleting expired Operations")
+}
@@ -0,0 +1,64 @@
+package scd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+	"github.com/stretchr/testify/require"
+)
+
+// expiringStore holds the end times of Operations.
+type expiringStore struct {
+	scdstore.Store
+	repos.Repository
+	ends map[dssmodels.ID]time.Time
+}
+
+func (s *expiringStore) Interact(ctx context.Context) (repos.Repository, error) {
+	return s, nil
+}
+
+func (s *expiringStore) DeleteExpiredOperations(ctx context.Context, expiredBefore time.Time) (int, error) {
+	deleted := 0
+	for id, end := range s.ends {
+		if end.Before(expiredBefore) {
+			delete(s.ends, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func TestCollectGarbageKeepsOperationsWithinGracePeriod(t *testing.T) {
+	defer func(clock clockwork.Clock, grace time.Duration) {
+		DefaultClock, GCGracePeriod = clock, grace
+	}(DefaultClock, GCGracePeriod)
+	clock := clockwork.NewFakeClock()
+	DefaultClock, GCGracePeriod = clock, time.Hour
+
+	var (
+		recent = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		old    = dssmodels.ID("6348c8e5-0b1c-43cf-9114-2e67a4532765")
+		store  = &expiringStore{ends: map[dssmodels.ID]time.Time{
+			recent: clock.Now().Add(-time.Minute),
+			old:    clock.Now().Add(-2 * time.Hour),
+		}}
+		server = &Server{Store: store}
+	)
+
+	deleted, err := server.CollectGarbage(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	require.Contains(t, store.ends, recent)
+
+	clock.Advance(time.Hour)
+	deleted, err = server.CollectGarbage(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+	require.Empty(t, store.ends)
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	dssmodels "github.com/interuss/dss/pkg/models"
+)
+
+// UssAvailabilityState models the availability of a USS.
+type UssAvailabilityState string
+
+const (
+	// UssAvailabilityStateUnknown is the availability of a USS that never set it.
+	UssAvailabilityStateUnknown UssAvailabilityState = "Unknown"
+	// UssAvailabilityStateUp indicates that a USS is available.
+	UssAvailabilityStateUp UssAvailabilityState = "Up"
+	// UssAvailabilityStateDown indicates that a USS is not available.
+	UssAvailabilityStateDown UssAvailabilityState = "Down"
+)
+
+// Valid returns true if s is one of the known availability states.
+func (s UssAvailabilityState) Valid() bool {
+	switch s {
+	case UssAvailabilityStateUnknown, UssAvailabilityStateUp, UssAvailabilityStateDown:
+		return true
+	}
+	return false
+}
+
+func (s UssAvailabilityState) String() string {
+	return string(s)
+}
+
+// UssAvailabilityStatus models the availability of a USS, as known by the DSS.
+type UssAvailabilityStatus struct {
+	Uss          dssmodels.Owner
+	Availability UssAvailabilityState
+	Version      Version
+}
+
+// ToProto converts the UssAvailabilityStatus to its proto API format
+func (u *UssAvailabilityStatus) ToProto() *scdpb.UssAvailabilityStatusResponse {
+	return &scdpb.UssAvailabilityStatusResponse{
+		Status: &scdpb.UssAvailabilityStatus{
+			Uss:          u.Uss.String(),
+			Availability: u.Availability.String(),
+		},
+		Version: int32(u.Version),
+	}
+}
@@ -23,6 +23,9 @@ type Constraint struct {
 	AltitudeUpper *float32
 	USSBaseURL    string
 	Cells         s2.CellUnion
+	// TimeToExpiry is how long remains until EndTime, if computed by the
+	// store.
+	TimeToExpiry *time.Duration
 }
 
 // ToProto converts the Constraint to its proto API format
@@ -56,6 +59,10 @@ func (c *Constraint) ToProto() (*scdpb.ConstraintReference, error) {
 			Format: dssmodels.TimeFormatRFC3339,
 		}
 	}
+
+	if c.TimeToExpiry != nil {
+		result.TimeToExpiry = ptypes.DurationProto(*c.TimeToExpiry)
+	}
 	return result, nil
 }
 
@@ -11,3 +11,23 @@ import (
 func TestOVNFromTimeIsValid(t *testing.T) {
 	require.True(t, NewOVNFromTime(time.Now(), uuid.New().String()).Valid())
 }
+
+func TestTimeToExpiryToProto(t *testing.T) {
+	remaining := 90 * time.Second
+
+	p, err := (&Operation{}).ToProto()
+	require.NoError(t, err)
+	require.Nil(t, p.TimeToExpiry)
+
+	p, err = (&Operation{TimeToExpiry: &remaining}).ToProto()
+	require.NoError(t, err)
+	require.Equal(t, int64(90), p.TimeToExpiry.Seconds)
+
+	s, err := (&Subscription{TimeToExpiry: &remaining}).ToProto(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(90), s.TimeToExpiry.Seconds)
+
+	c, err := (&Constraint{TimeToExpiry: &remaining}).ToProto()
+	require.NoError(t, err)
+	require.Equal(t, int64(90), c.TimeToExpiry.Seconds)
+}
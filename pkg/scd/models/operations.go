@@ -66,6 +66,10 @@ type Operation struct {
 	State          OperationState
 	Cells          s2.CellUnion
 	SubscriptionID dssmodels.ID
+	Priority       int32
+	// TimeToExpiry is how long remains until EndTime, if computed by the
+	// store.
+	TimeToExpiry *time.Duration
 }
 
 // ToProto converts the Operation to its proto API format
@@ -77,6 +81,7 @@ func (o *Operation) ToProto() (*scdpb.OperationReference, error) {
 		Version:        int32(o.Version),
 		UssBaseUrl:     o.USSBaseURL,
 		SubscriptionId: o.SubscriptionID.String(),
+		Priority:       o.Priority,
 	}
 
 	if o.StartTime != nil {
@@ -100,6 +105,10 @@ func (o *Operation) ToProto() (*scdpb.OperationReference, error) {
 			Format: dssmodels.TimeFormatRFC3339,
 		}
 	}
+
+	if o.TimeToExpiry != nil {
+		result.TimeToExpiry = ptypes.DurationProto(*o.TimeToExpiry)
+	}
 	return result, nil
 }
 
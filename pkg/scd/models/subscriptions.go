@@ -38,6 +38,9 @@ type Subscription struct {
 	NotifyForConstraints bool
 	ImplicitSubscription bool
 	Cells                s2.CellUnion
+	// TimeToExpiry is how long remains until EndTime, if computed by the
+	// store.
+	TimeToExpiry *time.Duration
 }
 
 // ToProto converts the Subscription to its proto API format
@@ -74,6 +77,10 @@ func (s *Subscription) ToProto(dependentOperations []dssmodels.ID) (*scdpb.Subsc
 		}
 	}
 
+	if s.TimeToExpiry != nil {
+		result.TimeToExpiry = ptypes.DurationProto(*s.TimeToExpiry)
+	}
+
 	for _, op := range dependentOperations {
 		result.DependentOperations = append(result.DependentOperations, op.String())
 	}
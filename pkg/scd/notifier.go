@@ -0,0 +1,99 @@
+package scd
+
+import (
+	"context"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/logging"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	"go.uber.org/zap"
+)
+
+// EntityKind identifies the kind of entity whose change triggers
+// notifications.
+type EntityKind string
+
+const (
+	// OperationEntity marks changes to Operations.
+	OperationEntity EntityKind = "operation"
+	// ConstraintEntity marks changes to Constraints.
+	ConstraintEntity EntityKind = "constraint"
+)
+
+// EntityChange describes a committed change to an Operation or Constraint
+// along with the subscribers to notify of it.
+type EntityChange struct {
+	Kind        EntityKind
+	ID          dssmodels.ID
+	Deleted     bool
+	Subscribers []*scdpb.SubscriberToNotify
+}
+
+// Notifier delivers notifications of entity changes to subscribers.
+type Notifier interface {
+	// Notify is called once per change, after it has been committed. The
+	// subscribers are reported to the client making the change regardless.
+	Notify(ctx context.Context, change EntityChange) error
+}
+
+// ClientNotifier is the default Notifier. It delivers nothing itself, leaving
+// delivery to the client making the change, which receives the subscribers
+// to notify in its response.
+type ClientNotifier struct{}
+
+// Notify implements Notifier.
+func (ClientNotifier) Notify(context.Context, EntityChange) error {
+	return nil
+}
+
+// LogNotifier is a Notifier logging every change along with the base URLs of
+// its subscribers, leaving delivery to the client making the change as
+// ClientNotifier does.
+type LogNotifier struct {
+	Logger *zap.Logger
+}
+
+// Notify implements Notifier.
+func (n LogNotifier) Notify(ctx context.Context, change EntityChange) error {
+	urls := make([]string, len(change.Subscribers))
+	for i, subscriber := range change.Subscribers {
+		urls[i] = subscriber.GetUssBaseUrl()
+	}
+	n.Logger.Info("Subscribers to notify",
+		zap.String("kind", string(change.Kind)),
+		zap.String("id", change.ID.String()),
+		zap.Bool("deleted", change.Deleted),
+		zap.Strings("uss_base_urls", urls))
+	return nil
+}
+
+// DefaultNotifier is the name of ClientNotifier in Notifiers.
+const DefaultNotifier = "client"
+
+// Notifiers maps the names under which Notifiers may be configured to their
+// constructors. Alternative delivery backends are made available for
+// configuration by adding them before the server is set up.
+var Notifiers = map[string]func(logger *zap.Logger) (Notifier, error){
+	DefaultNotifier: func(*zap.Logger) (Notifier, error) {
+		return ClientNotifier{}, nil
+	},
+	"log": func(logger *zap.Logger) (Notifier, error) {
+		return LogNotifier{Logger: logger}, nil
+	},
+}
+
+// notify hands change to a's Notifier. Delivery failures are logged rather
+// than returned, as the change has been committed and the client may still
+// notify the subscribers it is told about.
+func (a *Server) notify(ctx context.Context, change EntityChange) {
+	notifier := a.Notifier
+	if notifier == nil {
+		notifier = ClientNotifier{}
+	}
+	if err := notifier.Notify(ctx, change); err != nil {
+		logging.WithValuesFromContext(ctx, logging.Logger).Warn("Failed to deliver notifications",
+			zap.String("kind", string(change.Kind)),
+			zap.String("id", change.ID.String()),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,66 @@
+package scd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNotifier struct {
+	changes []EntityChange
+	err     error
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, change EntityChange) error {
+	f.changes = append(f.changes, change)
+	return f.err
+}
+
+func TestNotifierReceivesSubscribersOfDeletedConstraint(t *testing.T) {
+	var (
+		ctx   = auth.ContextWithOwner(context.Background(), "owner")
+		start = time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+		end   = start.Add(time.Hour)
+		id    = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		store = &fakeStore{
+			constraints: map[dssmodels.ID]*scdmodels.Constraint{
+				id: {ID: id, Owner: "owner", Version: 1, StartTime: &start, EndTime: &end},
+			},
+			subs: []*scdmodels.Subscription{
+				{ID: "1111c8e5-0b1c-43cf-9114-2e67a4532765", BaseURL: "https://uss1", NotificationIndex: 4, NotifyForConstraints: true},
+				{ID: "2222c8e5-0b1c-43cf-9114-2e67a4532765", BaseURL: "https://uss2", NotificationIndex: 7},
+				{ID: "3333c8e5-0b1c-43cf-9114-2e67a4532765", BaseURL: "https://uss1", NotificationIndex: 0, NotifyForConstraints: true},
+			},
+		}
+		notifier = &fakeNotifier{err: errors.New("queue unavailable")}
+		s        = &Server{Store: store, Notifier: notifier}
+	)
+
+	// A failure to deliver does not fail the committed deletion.
+	resp, err := s.DeleteConstraintReference(ctx, &scdpb.DeleteConstraintReferenceRequest{Entityuuid: id.String()})
+	require.NoError(t, err)
+	require.Empty(t, store.constraints)
+
+	// Only the Subscriptions to Constraints are notified, at their
+	// incremented notification indices.
+	require.Len(t, notifier.changes, 1)
+	change := notifier.changes[0]
+	require.Equal(t, ConstraintEntity, change.Kind)
+	require.Equal(t, id, change.ID)
+	require.True(t, change.Deleted)
+	require.Equal(t, []*scdpb.SubscriberToNotify{{
+		UssBaseUrl: "https://uss1",
+		Subscriptions: []*scdpb.SubscriptionState{
+			{SubscriptionId: "1111c8e5-0b1c-43cf-9114-2e67a4532765", NotificationIndex: 5},
+			{SubscriptionId: "3333c8e5-0b1c-43cf-9114-2e67a4532765", NotificationIndex: 1},
+		},
+	}}, change.Subscribers)
+	require.Equal(t, resp.Subscribers, change.Subscribers)
+}
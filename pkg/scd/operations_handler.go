@@ -9,7 +9,9 @@ import (
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
 	"github.com/interuss/dss/pkg/auth"
 	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/geo"
 	dssmodels "github.com/interuss/dss/pkg/models"
+	"github.com/interuss/dss/pkg/quota"
 	scderr "github.com/interuss/dss/pkg/scd/errors"
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	"github.com/interuss/dss/pkg/scd/repos"
@@ -49,17 +51,20 @@ func (a *Server) DeleteOperationReference(ctx context.Context, req *scdpb.Delete
 				"Operation owned by %s, but %s attempted to delete", old.Owner, owner)
 		}
 
-		// Get the Subscription supporting the Operation
-		sub, err := r.GetSubscription(ctx, old.SubscriptionID)
-		if err != nil {
-			return stacktrace.Propagate(err, "Unable to get Operation's Subscription from repo")
-		}
-		if sub == nil {
-			return stacktrace.NewError("Operation's Subscription missing from repo")
+		// Get the Subscription supporting the Operation, if any
+		var sub *scdmodels.Subscription
+		if !old.SubscriptionID.Empty() {
+			sub, err = r.GetSubscription(ctx, old.SubscriptionID)
+			if err != nil {
+				return stacktrace.Propagate(err, "Unable to get Operation's Subscription from repo")
+			}
+			if sub == nil {
+				return stacktrace.NewError("Operation's Subscription missing from repo")
+			}
 		}
 
 		removeImplicitSubscription := false
-		if sub.ImplicitSubscription {
+		if sub != nil && sub.ImplicitSubscription {
 			// Get the Subscription's dependent Operations
 			dependentOps, err := r.GetDependentOperations(ctx, sub.ID)
 			if err != nil {
@@ -133,6 +138,8 @@ func (a *Server) DeleteOperationReference(ctx context.Context, req *scdpb.Delete
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	a.notify(ctx, EntityChange{Kind: OperationEntity, ID: id, Deleted: true, Subscribers: response.Subscribers})
+
 	return response, nil
 }
 
@@ -174,7 +181,7 @@ func (a *Server) GetOperationReference(ctx context.Context, req *scdpb.GetOperat
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.Store.Read(ctx, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
@@ -197,6 +204,12 @@ func (a *Server) SearchOperationReferences(ctx context.Context, req *scdpb.Searc
 		return nil, stacktrace.PropagateWithCode(err, dsserr.BadRequest, "Error parsing geometry")
 	}
 
+	// Only Operations at or above this priority are returned
+	minPriority := req.GetParams().GetMinPriority()
+	if minPriority < 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid min_priority: %d", minPriority)
+	}
+
 	// Retrieve ID of client making call
 	owner, ok := auth.OwnerFromContext(ctx)
 	if !ok {
@@ -206,7 +219,7 @@ func (a *Server) SearchOperationReferences(ctx context.Context, req *scdpb.Searc
 	var response *scdpb.SearchOperationReferenceResponse
 	action := func(ctx context.Context, r repos.Repository) (err error) {
 		// Perform search query on Store
-		ops, err := r.SearchOperations(ctx, vol4)
+		ops, err := r.SearchOperationsWithPriority(ctx, vol4, minPriority)
 		if err != nil {
 			return stacktrace.Propagate(err, "Unable to search for Operations in repo")
 		}
@@ -227,7 +240,7 @@ func (a *Server) SearchOperationReferences(ctx context.Context, req *scdpb.Searc
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.Store.Read(ctx, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
@@ -235,6 +248,26 @@ func (a *Server) SearchOperationReferences(ctx context.Context, req *scdpb.Searc
 	return response, nil
 }
 
+// validateExtentsSize returns an error with code BadRequest if extents are
+// made of more volumes, or of more polygon vertices in total, than a's limits.
+func (a *Server) validateExtentsSize(extents []*scdpb.Volume4D) error {
+	if a.MaxVolumesPerIntent > 0 && len(extents) > a.MaxVolumesPerIntent {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest,
+			"Operation has %d volumes, beyond the limit of %d", len(extents), a.MaxVolumesPerIntent)
+	}
+	if a.MaxVerticesPerIntent > 0 {
+		vertices := 0
+		for _, extent := range extents {
+			vertices += len(extent.GetVolume().GetOutlinePolygon().GetVertices())
+		}
+		if vertices > a.MaxVerticesPerIntent {
+			return stacktrace.NewErrorWithCode(dsserr.BadRequest,
+				"Operation has %d vertices across its volumes, beyond the limit of %d", vertices, a.MaxVerticesPerIntent)
+		}
+	}
+	return nil
+}
+
 // PutOperationReference creates a single operation ref.
 func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperationReferenceRequest) (*scdpb.ChangeOperationReferenceResponse, error) {
 	id, err := dssmodels.IDFromString(req.GetEntityuuid())
@@ -267,6 +300,14 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid Operation state: %s", params.State)
 	}
 
+	if params.GetPriority().GetValue() < 0 {
+		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Invalid Operation priority: %d", params.GetPriority().GetValue())
+	}
+
+	if err := a.validateExtentsSize(params.GetExtents()); err != nil {
+		return nil, err // No need to Propagate this error as this stack layer does not add useful information
+	}
+
 	for idx, extent := range params.GetExtents() {
 		cExtent, err := dssmodels.Volume4DFromSCDProto(extent)
 		if err != nil {
@@ -294,6 +335,9 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Invalid area")
 	}
+	if err := geo.ValidateStoredCells(cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid area")
+	}
 
 	if uExtent.EndTime.Before(*uExtent.StartTime) {
 		return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "End time is past the start time")
@@ -328,10 +372,23 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 			if params.OldVersion != 0 {
 				return stacktrace.NewErrorWithCode(dsserr.NotFound, "Operation does not exist and therefore is not version %d", params.OldVersion)
 			}
+			if a.Quotas.Limits(quota.Operations) {
+				count, err := r.CountOperationsByOwner(ctx, owner)
+				if err != nil {
+					return stacktrace.Propagate(err, "Could not count Operations of owner")
+				}
+				if err := a.Quotas.Check(quota.Operations, owner, count); err != nil {
+					return err // No need to Propagate this error as this stack layer does not add useful information
+				}
+			}
 		}
 
 		var sub *scdmodels.Subscription
-		if subscriptionID.Empty() {
+		switch {
+		case subscriptionID.Empty() && params.GetNewSubscription() == nil:
+			// The USS polls for conflicting changes itself rather than being
+			// notified of them through a Subscription.
+		case subscriptionID.Empty():
 			// Create implicit Subscription
 			err := scdmodels.ValidateUSSBaseURL(params.GetNewSubscription().GetUssBaseUrl())
 			if err != nil {
@@ -356,7 +413,7 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 				return stacktrace.Propagate(err, "Failed to create implicit subscription")
 			}
 			subscriptionID = sub.ID
-		} else {
+		default:
 			// Use existing Subscription
 			sub, err = r.GetSubscription(ctx, subscriptionID)
 			if err != nil {
@@ -417,7 +474,21 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 				return stacktrace.Propagate(err, "Unable to SearchOperations")
 			}
 			for _, relevantOp := range relevantOps {
-				if _, ok := key[relevantOp.OVN]; !ok {
+				// The Operation being updated does not conflict with its own
+				// prior version.
+				if relevantOp.ID == id {
+					continue
+				}
+				current := false
+				if _, ok := key[relevantOp.OVN]; ok {
+					// Only an OVN that is still the current one of its
+					// Operation counts towards the key.
+					current, err = r.OperationHasOVN(ctx, relevantOp.ID, relevantOp.OVN)
+					if err != nil {
+						return stacktrace.Propagate(err, "Unable to check OVN of Operation %s", relevantOp.ID)
+					}
+				}
+				if !current {
 					if relevantOp.Owner != owner {
 						relevantOp.OVN = ""
 					}
@@ -427,7 +498,7 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 
 			// Identify Constraints missing from the key
 			var missingConstraints []*scdmodels.Constraint
-			if sub.NotifyForConstraints {
+			if sub != nil && sub.NotifyForConstraints {
 				constraints, err := r.SearchConstraints(ctx, uExtent)
 				if err != nil {
 					return stacktrace.Propagate(err, "Unable to SearchConstraints")
@@ -453,6 +524,12 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 			}
 		}
 
+		// An update not providing a priority keeps the current one
+		priority := params.GetPriority().GetValue()
+		if params.GetPriority() == nil && old != nil {
+			priority = old.Priority
+		}
+
 		// Construct the new Operation
 		op := &scdmodels.Operation{
 			ID:      id,
@@ -466,8 +543,9 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 			Cells:         cells,
 
 			USSBaseURL:     params.UssBaseUrl,
-			SubscriptionID: sub.ID,
+			SubscriptionID: subscriptionID,
 			State:          state,
+			Priority:       priority,
 		}
 		err = op.ValidateTimeRange()
 		if err != nil {
@@ -541,5 +619,7 @@ func (a *Server) PutOperationReference(ctx context.Context, req *scdpb.PutOperat
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
 
+	a.notify(ctx, EntityChange{Kind: OperationEntity, ID: id, Subscribers: response.Subscribers})
+
 	return response, nil
 }
@@ -0,0 +1,370 @@
+package scd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/interuss/dss/pkg/api/v1/scdpb"
+	"github.com/interuss/dss/pkg/auth"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatingOperationDoesNotConflictWithItself(t *testing.T) {
+	var (
+		ctx   = auth.ContextWithOwner(context.Background(), "owner")
+		id    = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		subID = dssmodels.ID("1111c8e5-0b1c-43cf-9114-2e67a4532765")
+		store = &fakeStore{
+			ops: map[dssmodels.ID]*scdmodels.Operation{},
+			subs: []*scdmodels.Subscription{{
+				ID:                  subID,
+				Owner:               "owner",
+				BaseURL:             "https://uss",
+				NotifyForOperations: true,
+				Cells: s2.CellUnion{
+					s2.CellIDFromFace(0), s2.CellIDFromFace(1), s2.CellIDFromFace(2),
+					s2.CellIDFromFace(3), s2.CellIDFromFace(4), s2.CellIDFromFace(5),
+				},
+			}},
+		}
+		s   = &Server{Store: store}
+		put = func(version int32, state string, key []string) (*scdpb.ChangeOperationReferenceResponse, error) {
+			start, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			end, err := ptypes.TimestampProto(time.Now().Add(2 * time.Hour))
+			require.NoError(t, err)
+			return s.PutOperationReference(ctx, &scdpb.PutOperationReferenceRequest{
+				Entityuuid: id.String(),
+				Params: &scdpb.PutOperationReferenceParameters{
+					Extents: []*scdpb.Volume4D{{
+						Volume: &scdpb.Volume3D{
+							OutlineCircle: &scdpb.Circle{
+								Center: &scdpb.LatLngPoint{Lat: 37.4, Lng: -122.1},
+								Radius: &scdpb.Radius{Units: dssmodels.UnitsM, Value: 100},
+							},
+						},
+						TimeStart: &scdpb.Time{Value: start, Format: dssmodels.TimeFormatRFC3339},
+						TimeEnd:   &scdpb.Time{Value: end, Format: dssmodels.TimeFormatRFC3339},
+					}},
+					Key:            key,
+					OldVersion:     version,
+					State:          state,
+					UssBaseUrl:     "https://uss",
+					SubscriptionId: subID.String(),
+				},
+			})
+		}
+	)
+
+	resp, err := put(0, "Accepted", nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), resp.OperationReference.Version)
+
+	// Updating the Operation in place without its own OVN in the key does
+	// not report the Operation as conflicting with itself.
+	resp, err = put(1, "Activated", nil)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), resp.OperationReference.Version)
+	require.Equal(t, scdmodels.OperationStateActivated, store.ops[id].State)
+}
+
+func TestOperationKeyRequiresCurrentOVNs(t *testing.T) {
+	var (
+		ctx     = auth.ContextWithOwner(context.Background(), "owner")
+		id      = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		otherID = dssmodels.ID("2222c8e5-0b1c-43cf-9114-2e67a4532765")
+		store   = &fakeStore{ops: map[dssmodels.ID]*scdmodels.Operation{
+			otherID: {ID: otherID, Owner: "other", OVN: "other-ovn"},
+		}}
+		s   = &Server{Store: store}
+		put = func(key []string) error {
+			start, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			end, err := ptypes.TimestampProto(time.Now().Add(2 * time.Hour))
+			require.NoError(t, err)
+			_, err = s.PutOperationReference(ctx, &scdpb.PutOperationReferenceRequest{
+				Entityuuid: id.String(),
+				Params: &scdpb.PutOperationReferenceParameters{
+					Extents: []*scdpb.Volume4D{{
+						Volume: &scdpb.Volume3D{
+							OutlineCircle: &scdpb.Circle{
+								Center: &scdpb.LatLngPoint{Lat: 37.4, Lng: -122.1},
+								Radius: &scdpb.Radius{Units: dssmodels.UnitsM, Value: 100},
+							},
+						},
+						TimeStart: &scdpb.Time{Value: start, Format: dssmodels.TimeFormatRFC3339},
+						TimeEnd:   &scdpb.Time{Value: end, Format: dssmodels.TimeFormatRFC3339},
+					}},
+					Key:        key,
+					State:      "Accepted",
+					UssBaseUrl: "https://uss",
+				},
+			})
+			return err
+		}
+	)
+
+	require.Error(t, put(nil))
+	require.Error(t, put([]string{"stale-ovn"}))
+	require.NoError(t, put([]string{"other-ovn"}))
+}
+
+func TestUpdatingOperationWithoutPriorityKeepsIt(t *testing.T) {
+	var (
+		ctx   = auth.ContextWithOwner(context.Background(), "owner")
+		id    = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		store = &fakeStore{ops: map[dssmodels.ID]*scdmodels.Operation{}}
+		s     = &Server{Store: store}
+		put   = func(version int32, priority *wrappers.Int32Value) error {
+			start, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			end, err := ptypes.TimestampProto(time.Now().Add(2 * time.Hour))
+			require.NoError(t, err)
+			_, err = s.PutOperationReference(ctx, &scdpb.PutOperationReferenceRequest{
+				Entityuuid: id.String(),
+				Params: &scdpb.PutOperationReferenceParameters{
+					Extents: []*scdpb.Volume4D{{
+						Volume: &scdpb.Volume3D{
+							OutlineCircle: &scdpb.Circle{
+								Center: &scdpb.LatLngPoint{Lat: 37.4, Lng: -122.1},
+								Radius: &scdpb.Radius{Units: dssmodels.UnitsM, Value: 100},
+							},
+						},
+						TimeStart: &scdpb.Time{Value: start, Format: dssmodels.TimeFormatRFC3339},
+						TimeEnd:   &scdpb.Time{Value: end, Format: dssmodels.TimeFormatRFC3339},
+					}},
+					OldVersion: version,
+					State:      "Accepted",
+					UssBaseUrl: "https://uss",
+					Priority:   priority,
+				},
+			})
+			return err
+		}
+	)
+
+	require.NoError(t, put(0, nil))
+	require.Equal(t, int32(0), store.ops[id].Priority)
+
+	require.NoError(t, put(1, &wrappers.Int32Value{Value: 5}))
+	require.Equal(t, int32(5), store.ops[id].Priority)
+
+	// Omitting the priority in an update keeps the current one, while
+	// providing 0 explicitly lowers it.
+	require.NoError(t, put(2, nil))
+	require.Equal(t, int32(5), store.ops[id].Priority)
+
+	require.NoError(t, put(3, &wrappers.Int32Value{Value: 0}))
+	require.Equal(t, int32(0), store.ops[id].Priority)
+
+	err := put(4, &wrappers.Int32Value{Value: -1})
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+}
+
+func TestOperationReferenceLifecycleWithAndWithoutSubscription(t *testing.T) {
+	var (
+		subID = dssmodels.ID("1111c8e5-0b1c-43cf-9114-2e67a4532765")
+		cells = s2.CellUnion{
+			s2.CellIDFromFace(0), s2.CellIDFromFace(1), s2.CellIDFromFace(2),
+			s2.CellIDFromFace(3), s2.CellIDFromFace(4), s2.CellIDFromFace(5),
+		}
+	)
+	for _, tc := range []struct {
+		name           string
+		subscriptionID dssmodels.ID
+	}{
+		{name: "with subscription", subscriptionID: subID},
+		{name: "without subscription"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				ctx   = auth.ContextWithOwner(context.Background(), "owner")
+				id    = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+				store = &fakeStore{
+					ops: map[dssmodels.ID]*scdmodels.Operation{},
+					subs: []*scdmodels.Subscription{{
+						ID:                  subID,
+						Owner:               "owner",
+						BaseURL:             "https://uss",
+						NotifyForOperations: true,
+						Cells:               cells,
+					}, {
+						ID:                  "2222c8e5-0b1c-43cf-9114-2e67a4532765",
+						Owner:               "other",
+						BaseURL:             "https://other",
+						NotifyForOperations: true,
+						Cells:               cells,
+					}},
+				}
+				s   = &Server{Store: store}
+				put = func(version int32, state string) (*scdpb.ChangeOperationReferenceResponse, error) {
+					start, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+					require.NoError(t, err)
+					end, err := ptypes.TimestampProto(time.Now().Add(2 * time.Hour))
+					require.NoError(t, err)
+					return s.PutOperationReference(ctx, &scdpb.PutOperationReferenceRequest{
+						Entityuuid: id.String(),
+						Params: &scdpb.PutOperationReferenceParameters{
+							Extents: []*scdpb.Volume4D{{
+								Volume: &scdpb.Volume3D{
+									OutlineCircle: &scdpb.Circle{
+										Center: &scdpb.LatLngPoint{Lat: 37.4, Lng: -122.1},
+										Radius: &scdpb.Radius{Units: dssmodels.UnitsM, Value: 100},
+									},
+								},
+								TimeStart: &scdpb.Time{Value: start, Format: dssmodels.TimeFormatRFC3339},
+								TimeEnd:   &scdpb.Time{Value: end, Format: dssmodels.TimeFormatRFC3339},
+							}},
+							OldVersion:     version,
+							State:          state,
+							UssBaseUrl:     "https://uss",
+							SubscriptionId: tc.subscriptionID.String(),
+						},
+					})
+				}
+				requireNotified = func(subscribers []*scdpb.SubscriberToNotify) {
+					var urls []string
+					for _, s := range subscribers {
+						urls = append(urls, s.UssBaseUrl)
+					}
+					require.Contains(t, urls, "https://other")
+				}
+			)
+
+			resp, err := put(0, "Accepted")
+			require.NoError(t, err)
+			require.Equal(t, tc.subscriptionID.String(), resp.OperationReference.SubscriptionId)
+			require.Equal(t, tc.subscriptionID, store.ops[id].SubscriptionID)
+			requireNotified(resp.Subscribers)
+
+			resp, err = put(1, "Activated")
+			require.NoError(t, err)
+			require.Equal(t, tc.subscriptionID.String(), resp.OperationReference.SubscriptionId)
+			requireNotified(resp.Subscribers)
+
+			resp, err = s.DeleteOperationReference(ctx, &scdpb.DeleteOperationReferenceRequest{Entityuuid: id.String()})
+			require.NoError(t, err)
+			require.Equal(t, tc.subscriptionID.String(), resp.OperationReference.SubscriptionId)
+			requireNotified(resp.Subscribers)
+			require.Empty(t, store.ops)
+			// The explicit Subscription outlives the Operation.
+			require.Len(t, store.subs, 2)
+		})
+	}
+}
+
+func TestSearchOperationReferencesAtOrAbovePriority(t *testing.T) {
+	var (
+		ctx   = auth.ContextWithOwner(context.Background(), "owner")
+		store = &fakeStore{
+			ops: map[dssmodels.ID]*scdmodels.Operation{},
+		}
+		s = &Server{Store: store}
+	)
+	for id, priority := range map[dssmodels.ID]int32{
+		"1111c8e5-0b1c-43cf-9114-2e67a4532765": 0,
+		"2222c8e5-0b1c-43cf-9114-2e67a4532765": 5,
+		"3333c8e5-0b1c-43cf-9114-2e67a4532765": 10,
+	} {
+		store.ops[id] = &scdmodels.Operation{ID: id, Owner: "other", Priority: priority}
+	}
+	search := func(minPriority int32) (map[string]int32, error) {
+		resp, err := s.SearchOperationReferences(ctx, &scdpb.SearchOperationReferencesRequest{
+			Params: &scdpb.SearchOperationReferenceParameters{
+				AreaOfInterest: &scdpb.Volume4D{
+					Volume: &scdpb.Volume3D{
+						OutlineCircle: &scdpb.Circle{
+							Center: &scdpb.LatLngPoint{Lat: 37.4, Lng: -122.1},
+							Radius: &scdpb.Radius{Units: dssmodels.UnitsM, Value: 100},
+						},
+					},
+				},
+				MinPriority: minPriority,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		priorities := map[string]int32{}
+		for _, op := range resp.OperationReferences {
+			priorities[op.Id] = op.Priority
+		}
+		return priorities, nil
+	}
+
+	priorities, err := search(0)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int32{
+		"1111c8e5-0b1c-43cf-9114-2e67a4532765": 0,
+		"2222c8e5-0b1c-43cf-9114-2e67a4532765": 5,
+		"3333c8e5-0b1c-43cf-9114-2e67a4532765": 10,
+	}, priorities)
+
+	priorities, err = search(5)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int32{
+		"2222c8e5-0b1c-43cf-9114-2e67a4532765": 5,
+		"3333c8e5-0b1c-43cf-9114-2e67a4532765": 10,
+	}, priorities)
+
+	_, err = search(-1)
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+}
+
+func TestPutOperationReferenceLimitsVolumesAndVertices(t *testing.T) {
+	var (
+		ctx    = auth.ContextWithOwner(context.Background(), "owner")
+		square = func() *scdpb.Volume4D {
+			return &scdpb.Volume4D{
+				Volume: &scdpb.Volume3D{
+					OutlinePolygon: &scdpb.Polygon{
+						Vertices: []*scdpb.LatLngPoint{
+							{Lat: 37.40, Lng: -122.15},
+							{Lat: 37.40, Lng: -122.13},
+							{Lat: 37.42, Lng: -122.13},
+							{Lat: 37.42, Lng: -122.15},
+						},
+					},
+				},
+			}
+		}
+		put = func(s *Server, extents ...*scdpb.Volume4D) error {
+			_, err := s.PutOperationReference(ctx, &scdpb.PutOperationReferenceRequest{
+				Entityuuid: "4348c8e5-0b1c-43cf-9114-2e67a4532765",
+				Params: &scdpb.PutOperationReferenceParameters{
+					Extents:    extents,
+					State:      "Accepted",
+					UssBaseUrl: "https://uss",
+				},
+			})
+			return err
+		}
+	)
+
+	err := put(&Server{MaxVolumesPerIntent: 2}, square(), square(), square())
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	require.Contains(t, err.Error(), "3 volumes")
+
+	err = put(&Server{MaxVerticesPerIntent: 7}, square(), square())
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	require.Contains(t, err.Error(), "8 vertices")
+
+	// Extents within the limits, or without limits, are accepted.
+	for _, s := range []*Server{
+		{MaxVolumesPerIntent: 2, MaxVerticesPerIntent: 8},
+		{},
+	} {
+		require.NoError(t, s.validateExtentsSize([]*scdpb.Volume4D{square(), square()}))
+	}
+}
@@ -2,6 +2,7 @@ package repos
 
 import (
 	"context"
+	"time"
 
 	dssmodels "github.com/interuss/dss/pkg/models"
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
@@ -15,6 +16,10 @@ type Operation interface {
 	// GetOperation returns the operation identified by "id".
 	GetOperation(ctx context.Context, id dssmodels.ID) (*scdmodels.Operation, error)
 
+	// GetOperations returns the operations identified by "ids" that exist,
+	// ordered by ID.
+	GetOperations(ctx context.Context, ids []dssmodels.ID) ([]*scdmodels.Operation, error)
+
 	// DeleteOperation deletes the operation identified by "id".
 	DeleteOperation(ctx context.Context, id dssmodels.ID) error
 
@@ -24,9 +29,31 @@ type Operation interface {
 	// SearchOperations returns all operations intersecting "v4d".
 	SearchOperations(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Operation, error)
 
+	// SearchOperationsWithPriority returns the operations intersecting "v4d"
+	// with a priority of at least "minPriority".
+	SearchOperationsWithPriority(ctx context.Context, v4d *dssmodels.Volume4D, minPriority int32) ([]*scdmodels.Operation, error)
+
+	// OperationHasOVN returns true if the operation identified by "id" exists
+	// and its current OVN is "ovn", without reading the rest of the
+	// operation. It validates OVNs supplied by clients.
+	OperationHasOVN(ctx context.Context, id dssmodels.ID, ovn scdmodels.OVN) (bool, error)
+
 	// GetDependentOperations returns IDs of all operations dependent on
 	// subscription identified by "subscriptionID".
 	GetDependentOperations(ctx context.Context, subscriptionID dssmodels.ID) ([]dssmodels.ID, error)
+
+	// CountOperationsByOwner returns the number of Operations owned by
+	// "owner" that have not ended yet.
+	CountOperationsByOwner(ctx context.Context, owner dssmodels.Owner) (int, error)
+
+	// DeleteExpiredOperations deletes every Operation that ended before
+	// "expiredBefore", along with its cells, and returns the number deleted.
+	DeleteExpiredOperations(ctx context.Context, expiredBefore time.Time) (int, error)
+
+	// SearchOperationsTouchingSubscription returns the Operations sharing a
+	// cell with the subscription identified by "subscriptionID" while both are
+	// active, ordered by ID.
+	SearchOperationsTouchingSubscription(ctx context.Context, subscriptionID dssmodels.ID) ([]*scdmodels.Operation, error)
 }
 
 // Subscription abstracts subscription-specific interactions with the backing repository.
@@ -39,7 +66,9 @@ type Subscription interface {
 	GetSubscription(ctx context.Context, id dssmodels.ID) (*scdmodels.Subscription, error)
 
 	// UpsertSubscription upserts sub into the store and returns the result
-	// subscription.
+	// subscription. An existing Subscription is only replaced if it is still
+	// at sub.Version; otherwise, an error with code dsserr.VersionMismatch
+	// reports its current version.
 	UpsertSubscription(ctx context.Context, sub *scdmodels.Subscription) (*scdmodels.Subscription, error)
 
 	// DeleteSubscription deletes a Subscription from the store and returns the
@@ -49,7 +78,8 @@ type Subscription interface {
 
 	// IncrementNotificationIndices increments the notification index of each
 	// specified Subscription and returns the resulting corresponding
-	// notification indices.
+	// notification indices. If any Subscription does not exist, a
+	// *dsserr.MultiError reports each of them, and no index is returned.
 	IncrementNotificationIndices(ctx context.Context, subscriptionIds []dssmodels.ID) ([]int, error)
 }
 
@@ -62,6 +92,10 @@ type Constraint interface {
 	// (nil, sql.ErrNoRows) if the Constraint doesn't exist
 	GetConstraint(ctx context.Context, id dssmodels.ID) (*scdmodels.Constraint, error)
 
+	// GetConstraints returns the Constraints identified by "ids" that exist,
+	// ordered by ID.
+	GetConstraints(ctx context.Context, ids []dssmodels.ID) ([]*scdmodels.Constraint, error)
+
 	// UpsertConstraint upserts "constraint" into the store.
 	UpsertConstraint(ctx context.Context, constraint *scdmodels.Constraint) (*scdmodels.Constraint, error)
 
@@ -71,11 +105,26 @@ type Constraint interface {
 	DeleteConstraint(ctx context.Context, id dssmodels.ID) error
 }
 
+// UssAvailability abstracts USS availability-specific interactions with the
+// backing repository.
+type UssAvailability interface {
+	// GetUssAvailability returns the availability of the USS identified by
+	// "uss", or nil and no error if its availability was never set.
+	GetUssAvailability(ctx context.Context, uss dssmodels.Owner) (*scdmodels.UssAvailabilityStatus, error)
+
+	// UpsertUssAvailability sets the availability of a USS and returns the
+	// result, at the next version. An existing availability is only replaced
+	// if it is still at status.Version; otherwise, an error with code
+	// dsserr.VersionMismatch reports its current version.
+	UpsertUssAvailability(ctx context.Context, status *scdmodels.UssAvailabilityStatus) (*scdmodels.UssAvailabilityStatus, error)
+}
+
 // Repository aggregates all SCD-specific repo interfaces.
 type Repository interface {
 	Operation
 	Subscription
 	Constraint
+	UssAvailability
 }
 
 // IncrementNotificationIndices is a utility function that extracts the IDs from
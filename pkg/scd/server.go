@@ -7,15 +7,24 @@ import (
 	"github.com/interuss/dss/pkg/api/v1/scdpb"
 	"github.com/interuss/dss/pkg/auth"
 	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/dss/pkg/quota"
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	scdstore "github.com/interuss/dss/pkg/scd/store"
 	"github.com/interuss/stacktrace"
 )
 
-const (
-	strategicCoordinationScope = "utm.strategic_coordination"
-	constraintManagementScope  = "utm.constraint_management"
-	constraintConsumptionScope = "utm.constraint_consumption"
+var (
+	// Scopes bundles up auth scopes for the strategic conflict detection
+	// server.
+	Scopes = struct {
+		StrategicCoordination auth.Scope
+		ConstraintManagement  auth.Scope
+		ConstraintConsumption auth.Scope
+	}{
+		StrategicCoordination: "utm.strategic_coordination",
+		ConstraintManagement:  "utm.constraint_management",
+		ConstraintConsumption: "utm.constraint_consumption",
+	}
 )
 
 func makeSubscribersToNotify(subscriptions []*scdmodels.Subscription) []*scdpb.SubscriberToNotify {
@@ -43,25 +52,37 @@ func makeSubscribersToNotify(subscriptions []*scdmodels.Subscription) []*scdpb.S
 type Server struct {
 	Store   scdstore.Store
 	Timeout time.Duration
+	// Notifier delivers notifications of changes to Operations and
+	// Constraints; ClientNotifier is used if nil.
+	Notifier Notifier
+	// Quotas limits the number of active Operations each owner may hold.
+	Quotas quota.Quotas
+	// MaxVolumesPerIntent, if positive, limits the number of 4D volumes
+	// making up the extents of an Operation, and MaxVerticesPerIntent, if
+	// positive, the total number of polygon vertices across them.
+	MaxVolumesPerIntent  int
+	MaxVerticesPerIntent int
 }
 
 // AuthScopes returns a map of endpoint to required Oauth scope.
 func (a *Server) AuthScopes() map[auth.Operation]auth.KeyClaimedScopesValidator {
 	// TODO: replace with correct scopes
 	return map[auth.Operation]auth.KeyClaimedScopesValidator{
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteConstraintReference": auth.RequireAnyScope(constraintManagementScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteOperationReference":  auth.RequireAnyScope(strategicCoordinationScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteSubscription":        auth.RequireAnyScope(strategicCoordinationScope, constraintConsumptionScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetConstraintReference":    auth.RequireAnyScope(strategicCoordinationScope, constraintConsumptionScope, constraintManagementScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetOperationReference":     auth.RequireAnyScope(strategicCoordinationScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetSubscription":           auth.RequireAnyScope(strategicCoordinationScope, constraintConsumptionScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/MakeDssReport":             auth.RequireAnyScope(strategicCoordinationScope, constraintConsumptionScope, constraintManagementScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/PutConstraintReference":    auth.RequireAnyScope(constraintManagementScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/PutOperationReference":     auth.RequireAnyScope(strategicCoordinationScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/PutSubscription":           auth.RequireAnyScope(strategicCoordinationScope, constraintConsumptionScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QueryConstraintReferences": auth.RequireAnyScope(strategicCoordinationScope, constraintConsumptionScope, constraintManagementScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QuerySubscriptions":        auth.RequireAnyScope(strategicCoordinationScope, constraintConsumptionScope),
-		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/SearchOperationReferences": auth.RequireAnyScope(strategicCoordinationScope),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteConstraintReference": auth.RequireAnyScope(Scopes.ConstraintManagement),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteOperationReference":  auth.RequireAnyScope(Scopes.StrategicCoordination),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/DeleteSubscription":        auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetConstraintReference":    auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption, Scopes.ConstraintManagement),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetOperationReference":     auth.RequireAnyScope(Scopes.StrategicCoordination),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetSubscription":           auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/GetUssAvailability":        auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption, Scopes.ConstraintManagement),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/MakeDssReport":             auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption, Scopes.ConstraintManagement),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/PutConstraintReference":    auth.RequireAnyScope(Scopes.ConstraintManagement),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/PutOperationReference":     auth.RequireAnyScope(Scopes.StrategicCoordination),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/PutSubscription":           auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QueryConstraintReferences": auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption, Scopes.ConstraintManagement),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/QuerySubscriptions":        auth.RequireAnyScope(Scopes.StrategicCoordination, Scopes.ConstraintConsumption),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/SearchOperationReferences": auth.RequireAnyScope(Scopes.StrategicCoordination),
+		"/scdpb.UTMAPIUSSDSSAndUSSUSSService/SetUssAvailability":        auth.RequireAnyScope(Scopes.StrategicCoordination),
 	}
 }
 
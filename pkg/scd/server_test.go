@@ -0,0 +1,131 @@
+package scd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/dss/pkg/scd/repos"
+	scdstore "github.com/interuss/dss/pkg/scd/store"
+)
+
+type fakeStore struct {
+	scdstore.Store
+	repos.Repository
+	constraints map[dssmodels.ID]*scdmodels.Constraint
+	ops         map[dssmodels.ID]*scdmodels.Operation
+	subs        []*scdmodels.Subscription
+	ussStatuses map[dssmodels.Owner]*scdmodels.UssAvailabilityStatus
+}
+
+func (f *fakeStore) Transact(ctx context.Context, action func(context.Context, repos.Repository) error) error {
+	return action(ctx, f)
+}
+
+func (f *fakeStore) Read(ctx context.Context, action func(context.Context, repos.Repository) error) error {
+	return action(ctx, f)
+}
+
+func (f *fakeStore) GetConstraint(ctx context.Context, id dssmodels.ID) (*scdmodels.Constraint, error) {
+	c, ok := f.constraints[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return c, nil
+}
+
+func (f *fakeStore) DeleteConstraint(ctx context.Context, id dssmodels.ID) error {
+	delete(f.constraints, id)
+	return nil
+}
+
+func (f *fakeStore) SearchSubscriptions(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Subscription, error) {
+	return f.subs, nil
+}
+
+func (f *fakeStore) IncrementNotificationIndices(ctx context.Context, ids []dssmodels.ID) ([]int, error) {
+	var indices []int
+	for _, id := range ids {
+		for _, sub := range f.subs {
+			if sub.ID == id {
+				indices = append(indices, sub.NotificationIndex+1)
+			}
+		}
+	}
+	return indices, nil
+}
+
+func (f *fakeStore) GetOperation(ctx context.Context, id dssmodels.ID) (*scdmodels.Operation, error) {
+	return f.ops[id], nil
+}
+
+func (f *fakeStore) SearchOperations(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Operation, error) {
+	var ops []*scdmodels.Operation
+	for _, op := range f.ops {
+		copied := *op
+		ops = append(ops, &copied)
+	}
+	return ops, nil
+}
+
+func (f *fakeStore) SearchOperationsWithPriority(ctx context.Context, v4d *dssmodels.Volume4D, minPriority int32) ([]*scdmodels.Operation, error) {
+	ops, err := f.SearchOperations(ctx, v4d)
+	if err != nil {
+		return nil, err
+	}
+	var result []*scdmodels.Operation
+	for _, op := range ops {
+		if op.Priority >= minPriority {
+			result = append(result, op)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeStore) OperationHasOVN(ctx context.Context, id dssmodels.ID, ovn scdmodels.OVN) (bool, error) {
+	op, ok := f.ops[id]
+	return ok && op.OVN == ovn, nil
+}
+
+func (f *fakeStore) UpsertOperation(ctx context.Context, op *scdmodels.Operation) (*scdmodels.Operation, error) {
+	op.OVN = scdmodels.OVN(fmt.Sprintf("%s-%d", op.ID, op.Version))
+	f.ops[op.ID] = op
+	return op, nil
+}
+
+func (f *fakeStore) GetSubscription(ctx context.Context, id dssmodels.ID) (*scdmodels.Subscription, error) {
+	for _, sub := range f.subs {
+		if sub.ID == id {
+			return sub, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeStore) DeleteOperation(ctx context.Context, id dssmodels.ID) error {
+	delete(f.ops, id)
+	return nil
+}
+
+func (f *fakeStore) GetDependentOperations(ctx context.Context, subscriptionID dssmodels.ID) ([]dssmodels.ID, error) {
+	var ids []dssmodels.ID
+	for _, op := range f.ops {
+		if op.SubscriptionID == subscriptionID {
+			ids = append(ids, op.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (f *fakeStore) GetUssAvailability(ctx context.Context, uss dssmodels.Owner) (*scdmodels.UssAvailabilityStatus, error) {
+	return f.ussStatuses[uss], nil
+}
+
+func (f *fakeStore) UpsertUssAvailability(ctx context.Context, s *scdmodels.UssAvailabilityStatus) (*scdmodels.UssAvailabilityStatus, error) {
+	stored := *s
+	stored.Version++
+	f.ussStatuses[s.Uss] = &stored
+	return &stored, nil
+}
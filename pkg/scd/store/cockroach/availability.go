@@ -0,0 +1,84 @@
+package cockroach
+
+import (
+	"context"
+	"database/sql"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	dsssql "github.com/interuss/dss/pkg/sql"
+
+	"github.com/interuss/stacktrace"
+)
+
+const availabilityFields = "id, availability, version"
+
+// fetchAvailability returns the USS availability returned by query, or nil
+// and no error if query returns no rows.
+func (c *repo) fetchAvailability(ctx context.Context, q dsssql.Queryable, query string, args ...interface{}) (*scdmodels.UssAvailabilityStatus, error) {
+	var (
+		status       = new(scdmodels.UssAvailabilityStatus)
+		availability string
+	)
+	err := q.QueryRowContext(ctx, query, args...).Scan(&status.Uss, &availability, &status.Version)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	status.Availability = scdmodels.UssAvailabilityState(availability)
+	return status, nil
+}
+
+// GetUssAvailability implements repos.UssAvailability.GetUssAvailability.
+func (c *repo) GetUssAvailability(ctx context.Context, uss dssmodels.Owner) (*scdmodels.UssAvailabilityStatus, error) {
+	const query = `
+		SELECT
+			` + availabilityFields + `
+		FROM
+			scd_uss_availability
+		WHERE
+			id = $1`
+	return c.fetchAvailability(ctx, c.q, query, uss)
+}
+
+// UpsertUssAvailability implements repos.UssAvailability.UpsertUssAvailability.
+func (c *repo) UpsertUssAvailability(ctx context.Context, s *scdmodels.UssAvailabilityStatus) (*scdmodels.UssAvailabilityStatus, error) {
+	// The update only applies if the stored availability is still at the
+	// version the caller based it on, so concurrent updates cannot clobber
+	// each other.
+	const upsertQuery = `
+		INSERT INTO
+			scd_uss_availability
+			(id, availability, version, updated_at)
+		VALUES
+			($1, $2, 1, transaction_timestamp())
+		ON CONFLICT (id) DO UPDATE SET
+			availability = excluded.availability,
+			version = scd_uss_availability.version + 1,
+			updated_at = excluded.updated_at
+		WHERE
+			scd_uss_availability.version = $3
+		RETURNING
+			` + availabilityFields
+
+	result, err := c.fetchAvailability(ctx, c.q, upsertQuery, s.Uss, s.Availability.String(), s.Version)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching USS availability from upsert query")
+	}
+	if result == nil {
+		// The stored availability is at a different version.
+		current, err := c.GetUssAvailability(ctx, s.Uss)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error fetching conflicting USS availability")
+		}
+		if current == nil {
+			return nil, stacktrace.NewError("Upsert query did not return a USS availability")
+		}
+		return nil, stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+			"Availability of USS %s is at version %d, not version %d", s.Uss, current.Version, s.Version)
+	}
+	return result, nil
+}
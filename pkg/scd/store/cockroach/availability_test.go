@@ -0,0 +1,54 @@
+package cockroach
+
+import (
+	"context"
+	"testing"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUssAvailabilityUpdatesDoNotClobber(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	status, err := repo.GetUssAvailability(ctx, "uss1")
+	require.NoError(t, err)
+	require.Nil(t, status)
+
+	created, err := repo.UpsertUssAvailability(ctx, &scdmodels.UssAvailabilityStatus{
+		Uss:          "uss1",
+		Availability: scdmodels.UssAvailabilityStateDown,
+	})
+	require.NoError(t, err)
+	require.Equal(t, scdmodels.Version(1), created.Version)
+
+	updated, err := repo.UpsertUssAvailability(ctx, &scdmodels.UssAvailabilityStatus{
+		Uss:          "uss1",
+		Availability: scdmodels.UssAvailabilityStateUp,
+		Version:      created.Version,
+	})
+	require.NoError(t, err)
+	require.Equal(t, scdmodels.Version(2), updated.Version)
+
+	_, err = repo.UpsertUssAvailability(ctx, &scdmodels.UssAvailabilityStatus{
+		Uss:          "uss1",
+		Availability: scdmodels.UssAvailabilityStateDown,
+		Version:      created.Version,
+	})
+	require.Error(t, err)
+	require.Equal(t, dsserr.VersionMismatch, stacktrace.GetCode(err))
+
+	status, err = repo.GetUssAvailability(ctx, "uss1")
+	require.NoError(t, err)
+	require.Equal(t, scdmodels.UssAvailabilityStateUp, status.Availability)
+	require.Equal(t, scdmodels.Version(2), status.Version)
+}
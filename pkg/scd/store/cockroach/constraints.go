@@ -88,6 +88,11 @@ func (c *repo) fetchConstraints(ctx context.Context, q dsssql.Queryable, query s
 	if err := rows.Err(); err != nil {
 		return nil, stacktrace.Propagate(err, "Error in rows query result")
 	}
+	if now, ok := c.now(); ok {
+		for _, constraint := range payload {
+			constraint.TimeToExpiry = timeToExpiry(now, constraint.EndTime)
+		}
+	}
 	return payload, nil
 }
 
@@ -119,6 +124,26 @@ func (c *repo) GetConstraint(ctx context.Context, id dssmodels.ID) (*scdmodels.C
 	return c.fetchConstraint(ctx, c.q, query, id)
 }
 
+// Implements scd.repos.Constraint.GetConstraints
+func (c *repo) GetConstraints(ctx context.Context, ids []dssmodels.ID) ([]*scdmodels.Constraint, error) {
+	var (
+		query = fmt.Sprintf(`
+			SELECT
+				%s
+			FROM
+				scd_constraints
+			WHERE
+				id = ANY($1)
+			ORDER BY
+				id`, constraintFieldsWithoutPrefix)
+	)
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return c.fetchConstraints(ctx, c.q, query, pq.StringArray(idStrings(ids)))
+}
+
 // Implements scd.repos.Constraint.UpsertConstraint
 func (c *repo) UpsertConstraint(ctx context.Context, s *scdmodels.Constraint) (*scdmodels.Constraint, error) {
 	var (
@@ -132,12 +157,12 @@ func (c *repo) UpsertConstraint(ctx context.Context, s *scdmodels.Constraint) (*
 			%s`, constraintFieldsWithoutPrefix, constraintFieldsWithPrefix)
 	)
 
-	cids := make([]int64, len(s.Cells))
+	if err := geo.ValidateCells(s.Cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Error validating cells")
+	}
 
+	cids := make([]int64, len(s.Cells))
 	for i, cell := range s.Cells {
-		if err := geo.ValidateCell(cell); err != nil {
-			return nil, stacktrace.Propagate(err, "Error validating cell")
-		}
 		cids[i] = int64(cell)
 	}
 
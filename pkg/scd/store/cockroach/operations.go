@@ -2,6 +2,7 @@ package cockroach
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -16,7 +17,7 @@ import (
 )
 
 var (
-	operationFieldsWithIndices   [10]string
+	operationFieldsWithIndices   [11]string
 	operationFieldsWithPrefix    string
 	operationFieldsWithoutPrefix string
 )
@@ -32,6 +33,7 @@ func init() {
 	operationFieldsWithIndices[7] = "ends_at"
 	operationFieldsWithIndices[8] = "subscription_id"
 	operationFieldsWithIndices[9] = "updated_at"
+	operationFieldsWithIndices[10] = "priority"
 
 	operationFieldsWithoutPrefix = strings.Join(
 		operationFieldsWithIndices[:], ",",
@@ -57,8 +59,9 @@ func (s *repo) fetchOperations(ctx context.Context, q dsssql.Queryable, query st
 	var payload []*scdmodels.Operation
 	for rows.Next() {
 		var (
-			o         = &scdmodels.Operation{}
-			updatedAt time.Time
+			o              = &scdmodels.Operation{}
+			subscriptionID sql.NullString
+			updatedAt      time.Time
 		)
 		err := rows.Scan(
 			&o.ID,
@@ -69,12 +72,14 @@ func (s *repo) fetchOperations(ctx context.Context, q dsssql.Queryable, query st
 			&o.AltitudeUpper,
 			&o.StartTime,
 			&o.EndTime,
-			&o.SubscriptionID,
+			&subscriptionID,
 			&updatedAt,
+			&o.Priority,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Error scanning Operation row")
 		}
+		o.SubscriptionID = dssmodels.ID(subscriptionID.String)
 		o.OVN = scdmodels.NewOVNFromTime(updatedAt, o.ID.String())
 		payload = append(payload, o)
 	}
@@ -82,6 +87,12 @@ func (s *repo) fetchOperations(ctx context.Context, q dsssql.Queryable, query st
 		return nil, stacktrace.Propagate(err, "Error in rows query result")
 	}
 
+	if now, ok := s.now(); ok {
+		for _, op := range payload {
+			op.TimeToExpiry = timeToExpiry(now, op.EndTime)
+		}
+	}
+
 	for _, op := range payload {
 		if err := s.populateOperationCells(ctx, q, op); err != nil {
 			return nil, stacktrace.Propagate(err, "Error populating cells for Operation %s", op.ID)
@@ -149,6 +160,24 @@ func (s *repo) GetOperation(ctx context.Context, id dssmodels.ID) (*scdmodels.Op
 	return s.fetchOperationByID(ctx, s.q, id)
 }
 
+// GetOperations implements repos.Operation.GetOperations.
+func (s *repo) GetOperations(ctx context.Context, ids []dssmodels.ID) ([]*scdmodels.Operation, error) {
+	var (
+		query = fmt.Sprintf(`
+			SELECT %s FROM
+				scd_operations
+			WHERE
+				id = ANY($1)
+			ORDER BY
+				id`, operationFieldsWithoutPrefix)
+	)
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.fetchOperations(ctx, s.q, query, pq.StringArray(idStrings(ids)))
+}
+
 // DeleteOperation implements repos.Operation.DeleteOperation.
 func (s *repo) DeleteOperation(ctx context.Context, id dssmodels.ID) error {
 	var (
@@ -183,7 +212,7 @@ func (s *repo) UpsertOperation(ctx context.Context, operation *scdmodels.Operati
 				scd_operations
 				(%s)
 			VALUES
-				($1, $2, $3, $4, $5, $6, $7, $8, $9, transaction_timestamp())
+				($1, $2, $3, $4, $5, $6, $7, $8, $9, transaction_timestamp(), $10)
 			RETURNING
 				%s`, operationFieldsWithoutPrefix, operationFieldsWithPrefix)
 		upsertCellsForOperationQuery = `
@@ -219,7 +248,10 @@ func (s *repo) UpsertOperation(ctx context.Context, operation *scdmodels.Operati
 		operation.AltitudeUpper,
 		operation.StartTime,
 		operation.EndTime,
-		operation.SubscriptionID,
+		// Operations without a Subscription are stored with a NULL
+		// subscription_id.
+		sql.NullString{String: operation.SubscriptionID.String(), Valid: !operation.SubscriptionID.Empty()},
+		operation.Priority,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error fetching Operation")
@@ -239,7 +271,9 @@ func (s *repo) UpsertOperation(ctx context.Context, operation *scdmodels.Operati
 	return operation, nil
 }
 
-func (s *repo) searchOperations(ctx context.Context, q dsssql.Queryable, v4d *dssmodels.Volume4D) ([]*scdmodels.Operation, error) {
+// searchOperations returns the Operations intersecting v4d, restricted to
+// those at or above minPriority if it is positive.
+func (s *repo) searchOperations(ctx context.Context, q dsssql.Queryable, v4d *dssmodels.Volume4D, minPriority int32) ([]*scdmodels.Operation, error) {
 	var (
 		operationsIntersectingVolumeQuery = fmt.Sprintf(`
 			SELECT
@@ -284,14 +318,21 @@ func (s *repo) searchOperations(ctx context.Context, q dsssql.Queryable, v4d *ds
 		cids[i] = int64(cid)
 	}
 
-	result, err := s.fetchOperations(
-		ctx, q, operationsIntersectingVolumeQuery,
+	args := []interface{}{
 		pq.Array(cids),
 		v4d.SpatialVolume.AltitudeLo,
 		v4d.SpatialVolume.AltitudeHi,
 		v4d.StartTime,
 		v4d.EndTime,
-	)
+	}
+	if minPriority > 0 {
+		args = append(args, minPriority)
+		operationsIntersectingVolumeQuery += fmt.Sprintf(`
+			AND
+				scd_operations.priority >= $%d`, len(args))
+	}
+
+	result, err := s.fetchOperations(ctx, q, operationsIntersectingVolumeQuery, args...)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error fetching Operations")
 	}
@@ -301,7 +342,34 @@ func (s *repo) searchOperations(ctx context.Context, q dsssql.Queryable, v4d *ds
 
 // SearchOperations implements repos.Operation.SearchOperations.
 func (s *repo) SearchOperations(ctx context.Context, v4d *dssmodels.Volume4D) ([]*scdmodels.Operation, error) {
-	return s.searchOperations(ctx, s.q, v4d)
+	return s.searchOperations(ctx, s.q, v4d, 0)
+}
+
+// SearchOperationsWithPriority implements
+// repos.Operation.SearchOperationsWithPriority.
+func (s *repo) SearchOperationsWithPriority(ctx context.Context, v4d *dssmodels.Volume4D, minPriority int32) ([]*scdmodels.Operation, error) {
+	return s.searchOperations(ctx, s.q, v4d, minPriority)
+}
+
+// OperationHasOVN implements repos.Operation.OperationHasOVN.
+func (s *repo) OperationHasOVN(ctx context.Context, id dssmodels.ID, ovn scdmodels.OVN) (bool, error) {
+	const query = `
+		SELECT
+			updated_at
+		FROM
+			scd_operations
+		WHERE
+			id = $1`
+
+	var updatedAt time.Time
+	err := s.q.QueryRowContext(ctx, query, id).Scan(&updatedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return scdmodels.NewOVNFromTime(updatedAt, id.String()) == ovn, nil
 }
 
 // GetDependentOperations implements repos.Operation.GetDependentOperations.
@@ -331,3 +399,84 @@ func (s *repo) GetDependentOperations(ctx context.Context, subscriptionID dssmod
 
 	return dependentOps, nil
 }
+
+// CountOperationsByOwner implements repos.Operation.CountOperationsByOwner.
+func (s *repo) CountOperationsByOwner(ctx context.Context, owner dssmodels.Owner) (int, error) {
+	const query = `
+		SELECT
+			COUNT(*)
+		FROM
+			scd_operations
+		WHERE
+			owner = $1
+		AND
+			ends_at >= $2`
+
+	var count int
+	err := s.q.QueryRowContext(ctx, query, owner, s.clock.Now()).Scan(&count)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	return count, nil
+}
+
+// DeleteExpiredOperations implements repos.Operation.DeleteExpiredOperations.
+func (s *repo) DeleteExpiredOperations(ctx context.Context, expiredBefore time.Time) (int, error) {
+	const query = `
+		DELETE FROM
+			scd_operations
+		WHERE
+			ends_at < $1`
+
+	result, err := s.q.ExecContext(ctx, query, expiredBefore)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error in query: %s", query)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "Error counting expired Operations deleted")
+	}
+	return int(deleted), nil
+}
+
+// SearchOperationsTouchingSubscription implements
+// repos.Operation.SearchOperationsTouchingSubscription.
+func (s *repo) SearchOperationsTouchingSubscription(ctx context.Context, subscriptionID dssmodels.ID) ([]*scdmodels.Operation, error) {
+	var operationsTouchingSubscriptionQuery = fmt.Sprintf(`
+		SELECT
+			%s
+		FROM
+			scd_operations
+		JOIN
+			(SELECT DISTINCT
+				scd_cells_operations.operation_id
+			FROM
+				scd_cells_operations
+			JOIN
+				scd_cells_subscriptions
+			ON
+				scd_cells_operations.cell_id = scd_cells_subscriptions.cell_id
+			WHERE
+				scd_cells_subscriptions.subscription_id = $1
+			)
+		AS
+			touching_operations
+		ON
+			scd_operations.id = touching_operations.operation_id
+		JOIN
+			scd_subscriptions
+		ON
+			scd_subscriptions.id = $1
+		WHERE
+			COALESCE(scd_operations.ends_at >= scd_subscriptions.starts_at, true)
+		AND
+			COALESCE(scd_operations.starts_at <= scd_subscriptions.ends_at, true)
+		ORDER BY
+			scd_operations.id`, operationFieldsWithPrefix)
+
+	result, err := s.fetchOperations(ctx, s.q, operationsTouchingSubscriptionQuery, subscriptionID)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error fetching Operations")
+	}
+	return result, nil
+}
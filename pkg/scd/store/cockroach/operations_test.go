@@ -0,0 +1,383 @@
+package cockroach
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"github.com/golang/geo/s2"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationHasOVN(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now()
+		end                  = start.Add(time.Hour)
+		cells                = s2.CellUnion{s2.CellID(17106221850767130624)}
+		id                   = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	sub, err := repo.UpsertSubscription(ctx, &scdmodels.Subscription{
+		ID:                  dssmodels.ID("5348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		Owner:               "me",
+		BaseURL:             "https://no/place/like/home",
+		NotifyForOperations: true,
+		StartTime:           &start,
+		EndTime:             &end,
+		Cells:               cells,
+	})
+	require.NoError(t, err)
+
+	op := &scdmodels.Operation{
+		ID:             id,
+		Owner:          "me",
+		Version:        1,
+		USSBaseURL:     "https://no/place/like/home",
+		StartTime:      &start,
+		EndTime:        &end,
+		SubscriptionID: sub.ID,
+		Cells:          cells,
+	}
+	created, err := repo.UpsertOperation(ctx, op)
+	require.NoError(t, err)
+
+	matches, err := repo.OperationHasOVN(ctx, id, created.OVN)
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	// Updating the Operation makes its previous OVN stale.
+	op.Version = 2
+	updated, err := repo.UpsertOperation(ctx, op)
+	require.NoError(t, err)
+	require.NotEqual(t, created.OVN, updated.OVN)
+
+	matches, err = repo.OperationHasOVN(ctx, id, created.OVN)
+	require.NoError(t, err)
+	require.False(t, matches)
+
+	matches, err = repo.OperationHasOVN(ctx, id, updated.OVN)
+	require.NoError(t, err)
+	require.True(t, matches)
+
+	// No Operation has any OVN once it is deleted.
+	require.NoError(t, repo.DeleteOperation(ctx, id))
+	matches, err = repo.OperationHasOVN(ctx, id, updated.OVN)
+	require.NoError(t, err)
+	require.False(t, matches)
+}
+
+func TestGetOperations(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now()
+		end                  = start.Add(time.Hour)
+		cells                = s2.CellUnion{s2.CellID(17106221850767130624)}
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	sub, err := repo.UpsertSubscription(ctx, &scdmodels.Subscription{
+		ID:                  dssmodels.ID("5348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		Owner:               "me",
+		BaseURL:             "https://no/place/like/home",
+		NotifyForOperations: true,
+		StartTime:           &start,
+		EndTime:             &end,
+		Cells:               cells,
+	})
+	require.NoError(t, err)
+
+	created := map[dssmodels.ID]scdmodels.OVN{}
+	for _, id := range []dssmodels.ID{"2348c8e5-0b1c-43cf-9114-2e67a4532765", "1348c8e5-0b1c-43cf-9114-2e67a4532765"} {
+		op, err := repo.UpsertOperation(ctx, &scdmodels.Operation{
+			ID:             id,
+			Owner:          "me",
+			Version:        1,
+			USSBaseURL:     "https://no/place/like/home",
+			StartTime:      &start,
+			EndTime:        &end,
+			SubscriptionID: sub.ID,
+			Cells:          cells,
+		})
+		require.NoError(t, err)
+		created[id] = op.OVN
+	}
+
+	// Operations that do not exist are omitted.
+	ops, err := repo.GetOperations(ctx, []dssmodels.ID{
+		"2348c8e5-0b1c-43cf-9114-2e67a4532765",
+		"9999c8e5-0b1c-43cf-9114-2e67a4532765",
+		"1348c8e5-0b1c-43cf-9114-2e67a4532765",
+	})
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	require.Equal(t, dssmodels.ID("1348c8e5-0b1c-43cf-9114-2e67a4532765"), ops[0].ID)
+	require.Equal(t, dssmodels.ID("2348c8e5-0b1c-43cf-9114-2e67a4532765"), ops[1].ID)
+	for _, op := range ops {
+		require.Equal(t, created[op.ID], op.OVN)
+	}
+
+	ops, err = repo.GetOperations(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, ops)
+}
+
+func TestSearchOperationsTouchingSubscription(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now()
+		end                  = start.Add(time.Hour)
+		cell                 = s2.CellID(17106221850767130624)
+		elsewhere            = s2.CellID(12494535935418957824)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	sub, err := repo.UpsertSubscription(ctx, &scdmodels.Subscription{
+		ID:                  dssmodels.ID("5348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		Owner:               "me",
+		BaseURL:             "https://no/place/like/home",
+		NotifyForOperations: true,
+		StartTime:           &start,
+		EndTime:             &end,
+		Cells:               s2.CellUnion{cell},
+	})
+	require.NoError(t, err)
+
+	later, laterEnd := end.Add(time.Hour), end.Add(2*time.Hour)
+	for _, op := range []struct {
+		id         dssmodels.ID
+		start, end *time.Time
+		cells      s2.CellUnion
+	}{
+		// Overlaps the Subscription in area and time.
+		{"1348c8e5-0b1c-43cf-9114-2e67a4532765", &start, &end, s2.CellUnion{cell, elsewhere}},
+		// Shares its area, but only once it has ended.
+		{"2348c8e5-0b1c-43cf-9114-2e67a4532765", &later, &laterEnd, s2.CellUnion{cell}},
+		// Active at the same time, but elsewhere.
+		{"3348c8e5-0b1c-43cf-9114-2e67a4532765", &start, &end, s2.CellUnion{elsewhere}},
+	} {
+		_, err := repo.UpsertOperation(ctx, &scdmodels.Operation{
+			ID:             op.id,
+			Owner:          "other",
+			Version:        1,
+			USSBaseURL:     "https://no/place/like/home",
+			StartTime:      op.start,
+			EndTime:        op.end,
+			SubscriptionID: sub.ID,
+			Cells:          op.cells,
+		})
+		require.NoError(t, err)
+	}
+
+	ops, err := repo.SearchOperationsTouchingSubscription(ctx, sub.ID)
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	require.Equal(t, dssmodels.ID("1348c8e5-0b1c-43cf-9114-2e67a4532765"), ops[0].ID)
+}
+
+func TestDeleteExpiredOperations(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now().Add(-2 * time.Hour)
+		expired              = fakeClock.Now().Add(-time.Hour)
+		end                  = fakeClock.Now().Add(time.Hour)
+		cells                = s2.CellUnion{s2.CellID(17106221850767130624)}
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	sub, err := repo.UpsertSubscription(ctx, &scdmodels.Subscription{
+		ID:                  dssmodels.ID("5348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		Owner:               "me",
+		BaseURL:             "https://no/place/like/home",
+		NotifyForOperations: true,
+		StartTime:           &start,
+		EndTime:             &end,
+		Cells:               cells,
+	})
+	require.NoError(t, err)
+
+	ids := []dssmodels.ID{"4348c8e5-0b1c-43cf-9114-2e67a4532765", "6348c8e5-0b1c-43cf-9114-2e67a4532765"}
+	for i, opEnd := range []*time.Time{&expired, &end} {
+		_, err := repo.UpsertOperation(ctx, &scdmodels.Operation{
+			ID:             ids[i],
+			Owner:          "me",
+			Version:        1,
+			USSBaseURL:     "https://no/place/like/home",
+			StartTime:      &start,
+			EndTime:        opEnd,
+			SubscriptionID: sub.ID,
+			Cells:          cells,
+		})
+		require.NoError(t, err)
+	}
+
+	deleted, err := repo.DeleteExpiredOperations(ctx, fakeClock.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	op, err := repo.GetOperation(ctx, ids[0])
+	require.NoError(t, err)
+	require.Nil(t, op)
+	op, err = repo.GetOperation(ctx, ids[1])
+	require.NoError(t, err)
+	require.NotNil(t, op)
+
+	// Deleting again is a no-op, as for concurrent sweepers.
+	deleted, err = repo.DeleteExpiredOperations(ctx, fakeClock.Now())
+	require.NoError(t, err)
+	require.Zero(t, deleted)
+}
+
+func TestOperationWithoutSubscription(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now()
+		end                  = start.Add(time.Hour)
+		id                   = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	_, err = repo.UpsertOperation(ctx, &scdmodels.Operation{
+		ID:         id,
+		Owner:      "me",
+		Version:    1,
+		USSBaseURL: "https://no/place/like/home",
+		StartTime:  &start,
+		EndTime:    &end,
+		Cells:      s2.CellUnion{s2.CellID(17106221850767130624)},
+	})
+	require.NoError(t, err)
+
+	op, err := repo.GetOperation(ctx, id)
+	require.NoError(t, err)
+	require.True(t, op.SubscriptionID.Empty())
+}
+
+func TestSearchOperationsWithPriority(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now()
+		end                  = start.Add(time.Hour)
+		cells                = s2.CellUnion{s2.CellID(17106221850767130624)}
+		altitude             = func(a float32) *float32 { return &a }
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	for _, op := range []*scdmodels.Operation{
+		{ID: "1111c8e5-0b1c-43cf-9114-2e67a4532765", Priority: 0, AltitudeLower: altitude(0), AltitudeUpper: altitude(100)},
+		{ID: "2222c8e5-0b1c-43cf-9114-2e67a4532765", Priority: 5, AltitudeLower: altitude(50), AltitudeUpper: altitude(150)},
+		{ID: "3333c8e5-0b1c-43cf-9114-2e67a4532765", Priority: 10, AltitudeLower: altitude(100), AltitudeUpper: altitude(200)},
+		// Above the area of interest.
+		{ID: "4444c8e5-0b1c-43cf-9114-2e67a4532765", Priority: 10, AltitudeLower: altitude(500), AltitudeUpper: altitude(600)},
+	} {
+		op.Owner = "me"
+		op.Version = 1
+		op.USSBaseURL = "https://no/place/like/home"
+		op.StartTime = &start
+		op.EndTime = &end
+		op.Cells = cells
+		_, err := repo.UpsertOperation(ctx, op)
+		require.NoError(t, err)
+	}
+
+	v4d := &dssmodels.Volume4D{
+		StartTime: &start,
+		EndTime:   &end,
+		SpatialVolume: &dssmodels.Volume3D{
+			AltitudeLo: altitude(80),
+			AltitudeHi: altitude(300),
+			Footprint: dssmodels.GeometryFunc(func() (s2.CellUnion, error) {
+				return cells, nil
+			}),
+		},
+	}
+	priorities := func(minPriority int32) map[dssmodels.ID]int32 {
+		ops, err := repo.SearchOperationsWithPriority(ctx, v4d, minPriority)
+		require.NoError(t, err)
+		result := map[dssmodels.ID]int32{}
+		for _, op := range ops {
+			result[op.ID] = op.Priority
+		}
+		return result
+	}
+
+	require.Equal(t, map[dssmodels.ID]int32{
+		"1111c8e5-0b1c-43cf-9114-2e67a4532765": 0,
+		"2222c8e5-0b1c-43cf-9114-2e67a4532765": 5,
+		"3333c8e5-0b1c-43cf-9114-2e67a4532765": 10,
+	}, priorities(0))
+	require.Equal(t, map[dssmodels.ID]int32{
+		"2222c8e5-0b1c-43cf-9114-2e67a4532765": 5,
+		"3333c8e5-0b1c-43cf-9114-2e67a4532765": 10,
+	}, priorities(5))
+	require.Equal(t, map[dssmodels.ID]int32{
+		"3333c8e5-0b1c-43cf-9114-2e67a4532765": 10,
+	}, priorities(6))
+}
+
+func TestOperationTimeToExpiryTracksClock(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		clock                = clockwork.NewFakeClock()
+		start                = clock.Now()
+		end                  = start.Add(time.Hour)
+		id                   = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+	)
+	defer tearDownStore()
+	store.clock = clock
+	store.timesToExpiry = true
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	created, err := repo.UpsertOperation(ctx, &scdmodels.Operation{
+		ID:         id,
+		Owner:      "me",
+		Version:    1,
+		USSBaseURL: "https://no/place/like/home",
+		StartTime:  &start,
+		EndTime:    &end,
+		Cells:      s2.CellUnion{s2.CellID(17106221850767130624)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, *created.TimeToExpiry)
+
+	clock.Advance(15 * time.Minute)
+	op, err := repo.GetOperation(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, 45*time.Minute, *op.TimeToExpiry)
+
+	clock.Advance(time.Hour)
+	op, err = repo.GetOperation(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), *op.TimeToExpiry)
+}
@@ -3,11 +3,12 @@ package cockroach
 import (
 	"context"
 	"database/sql"
+	"time"
 
-	"github.com/cockroachdb/cockroach-go/crdb"
 	"github.com/coreos/go-semver/semver"
 	"github.com/dpjacques/clockwork"
 	"github.com/interuss/dss/pkg/cockroach"
+	dssmodels "github.com/interuss/dss/pkg/models"
 	"github.com/interuss/dss/pkg/scd/repos"
 	dsssql "github.com/interuss/dss/pkg/sql"
 	"github.com/interuss/stacktrace"
@@ -17,6 +18,15 @@ import (
 const (
 	// currentMajorSchemaVersion is the current major schema version.
 	currentMajorSchemaVersion = 1
+
+	// versionTable records the schema version. Like every other strategic
+	// conflict detection table, it is prefixed so that the schema can share
+	// a database with the remote ID schema.
+	versionTable = "scd_schema_versions"
+
+	// ownDatabaseName is the name of the database holding the strategic
+	// conflict detection schema when it does not share one.
+	ownDatabaseName = "scd"
 )
 
 var (
@@ -24,31 +34,46 @@ var (
 	DefaultClock = clockwork.NewRealClock()
 
 	// DatabaseName is the name of database storing strategic conflict detection data.
-	DatabaseName = "scd"
+	DatabaseName = ownDatabaseName
+
+	// FollowerReads makes the Store returned by NewStore serve Read from the
+	// nearest replica, as of follower_read_timestamp(); see
+	// cockroach.DB.ExecuteFollowerReadTx for the staleness this implies.
+	FollowerReads = false
+
+	// TimesToExpiry makes the Store returned by NewStore compute, by its
+	// clock, how long remains until each Operation, Subscription and
+	// Constraint it returns ends.
+	TimesToExpiry = false
 )
 
 // repo is an implementation of repos.Repo using
 // a CockroachDB transaction.
 type repo struct {
-	q      dsssql.Queryable
-	logger *zap.Logger
-	clock  clockwork.Clock
+	q             dsssql.Queryable
+	logger        *zap.Logger
+	clock         clockwork.Clock
+	timesToExpiry bool
 }
 
 // Store is an implementation of an scd.Store using
 // a CockroachDB database.
 type Store struct {
-	db     *cockroach.DB
-	logger *zap.Logger
-	clock  clockwork.Clock
+	db            *cockroach.DB
+	logger        *zap.Logger
+	clock         clockwork.Clock
+	followerReads bool
+	timesToExpiry bool
 }
 
 // NewStore returns a Store instance connected to a cockroach instance via db.
 func NewStore(ctx context.Context, db *cockroach.DB, logger *zap.Logger) (*Store, error) {
 	store := &Store{
-		db:     db,
-		logger: logger,
-		clock:  DefaultClock,
+		db:            db,
+		logger:        logger,
+		clock:         DefaultClock,
+		followerReads: FollowerReads,
+		timesToExpiry: TimesToExpiry,
 	}
 
 	if err := store.CheckCurrentMajorSchemaVersion(ctx); err != nil {
@@ -78,30 +103,113 @@ func (s *Store) CheckCurrentMajorSchemaVersion(ctx context.Context) error {
 // Interact implements store.Interactor interface.
 func (s *Store) Interact(_ context.Context) (repos.Repository, error) {
 	return &repo{
-		q:      s.db,
-		logger: s.logger,
-		clock:  s.clock,
+		q:             dsssql.WithTiming(s.db),
+		logger:        s.logger,
+		clock:         s.clock,
+		timesToExpiry: s.timesToExpiry,
 	}, nil
 }
 
 // Transact implements store.Transactor interface.
 func (s *Store) Transact(ctx context.Context, f func(context.Context, repos.Repository) error) error {
-	return crdb.ExecuteTx(ctx, s.db.DB, nil /* nil txopts */, func(tx *sql.Tx) error {
+	return s.db.ExecuteTx(ctx, func(tx *sql.Tx) error {
 		return f(ctx, &repo{
-			q:      tx,
-			logger: s.logger,
-			clock:  s.clock,
+			q:             dsssql.WithTiming(tx),
+			logger:        s.logger,
+			clock:         s.clock,
+			timesToExpiry: s.timesToExpiry,
 		})
 	})
 }
 
+// Read implements store.Reader interface. Unless s serves follower reads, f
+// runs in a transaction as with Transact, reading the latest writes.
+func (s *Store) Read(ctx context.Context, f func(context.Context, repos.Repository) error) error {
+	if !s.followerReads {
+		return s.Transact(ctx, f)
+	}
+	return s.db.ExecuteFollowerReadTx(ctx, func(tx *sql.Tx) error {
+		return f(ctx, &repo{
+			q:             dsssql.WithTiming(tx),
+			logger:        s.logger,
+			clock:         s.clock,
+			timesToExpiry: s.timesToExpiry,
+		})
+	})
+}
+
+// Ping verifies that the database is reachable.
+func (s *Store) Ping(ctx context.Context) error {
+	return stacktrace.Propagate(s.db.PingContext(ctx), "Error pinging strategic conflict detection database")
+}
+
+// Stats returns the statistics of the strategic conflict detection database connection pool.
+func (s *Store) Stats() sql.DBStats {
+	return s.db.Stats()
+}
+
 // Close closes the underlying DB connection.
 func (s *Store) Close() error {
 	return s.db.Close()
 }
 
+// RefreshStatistics implements store.Store interface.
+func (s *Store) RefreshStatistics(ctx context.Context) ([]string, error) {
+	tables := []string{
+		"scd_subscriptions", "scd_operations", "scd_constraints",
+		"scd_cells_subscriptions", "scd_cells_operations", "scd_uss_availability",
+	}
+	if err := s.db.RefreshStatistics(ctx, tables...); err != nil {
+		return nil, stacktrace.Propagate(err, "Error refreshing strategic conflict detection statistics")
+	}
+	return tables, nil
+}
+
 // GetVersion returns the Version string for the Database.
 // If the DB was is not bootstrapped using the schema manager we throw and error
 func (s *Store) GetVersion(ctx context.Context) (*semver.Version, error) {
+	vs, err := s.db.GetVersionFromTable(ctx, DatabaseName, versionTable)
+	if err != nil || vs != cockroach.UnknownVersion {
+		return vs, err
+	}
+	// Databases record their version in the default table until migrated
+	// to v1.4.0, which prefixes it. In a shared database, that table may
+	// hold the version of another schema instead.
+	if DatabaseName != ownDatabaseName {
+		return nil, stacktrace.NewError(
+			"No %s table in database %s: the strategic conflict detection schema must be at v1.4.0 or later to share a database", versionTable, DatabaseName)
+	}
 	return s.db.GetVersion(ctx, DatabaseName)
 }
+
+// idStrings returns ids as strings, for use as a pq.StringArray query
+// argument.
+func idStrings(ids []dssmodels.ID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+	return result
+}
+
+// now returns the current time by the clock of c, and whether c computes the
+// time remaining until the entities it returns end.
+func (c *repo) now() (time.Time, bool) {
+	if !c.timesToExpiry {
+		return time.Time{}, false
+	}
+	return c.clock.Now(), true
+}
+
+// timeToExpiry returns how long remains at now until end, or nil if end is
+// not set. Entities that already ended have no time remaining.
+func timeToExpiry(now time.Time, end *time.Time) *time.Duration {
+	if end == nil {
+		return nil
+	}
+	remaining := end.Sub(now)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return &remaining
+}
@@ -0,0 +1,149 @@
+package cockroach
+
+import (
+	"context"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/dpjacques/clockwork"
+	"github.com/golang/geo/s2"
+	"github.com/interuss/dss/pkg/cockroach"
+	"github.com/interuss/dss/pkg/logging"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	ridmodels "github.com/interuss/dss/pkg/rid/models"
+	ridc "github.com/interuss/dss/pkg/rid/store/cockroach"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	storeURI  = flag.String("store-uri", "", "URI pointing to a Cockroach node")
+	fakeClock = clockwork.NewFakeClock()
+)
+
+func setUpStore(ctx context.Context, t *testing.T) (*Store, func()) {
+	if len(*storeURI) == 0 {
+		t.Skip()
+	}
+	cdb, err := cockroach.Dial(*storeURI)
+	require.NoError(t, err)
+	store := &Store{
+		db:     cdb,
+		logger: logging.Logger,
+		clock:  fakeClock,
+	}
+	return store, func() {
+		require.NoError(t, CleanUp(ctx, store))
+		require.NoError(t, store.Close())
+	}
+}
+
+// CleanUp deletes all strategic conflict detection entities from the store,
+// useful for testing.
+func CleanUp(ctx context.Context, s *Store) error {
+	const query = `
+	DELETE FROM scd_operations WHERE id IS NOT NULL;
+	DELETE FROM scd_constraints WHERE id IS NOT NULL;
+	DELETE FROM scd_subscriptions WHERE id IS NOT NULL;
+	DELETE FROM scd_uss_availability WHERE id IS NOT NULL;`
+
+	_, err := s.db.ExecContext(ctx, query)
+	return err
+}
+
+func TestStoresShareDatabase(t *testing.T) {
+	if len(*storeURI) == 0 {
+		t.Skip()
+	}
+	var (
+		ctx   = context.Background()
+		id    = dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765")
+		cells = s2.CellUnion{s2.CellID(17106221850767130624)}
+		start = time.Now()
+		end   = start.Add(time.Hour)
+	)
+	cdb, err := cockroach.Dial(*storeURI)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, cdb.Close())
+	}()
+
+	// Point the strategic conflict detection store at the remote ID database,
+	// which both schemas have been applied to.
+	defer func(name string) {
+		DatabaseName = name
+	}(DatabaseName)
+	DatabaseName = ridc.DatabaseName
+
+	// Each store finds its own schema version.
+	ridStore, err := ridc.NewStore(ctx, cdb, logging.Logger)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, ridStore.CleanUp(ctx))
+	}()
+	scdStore, err := NewStore(ctx, cdb, logging.Logger)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, CleanUp(ctx, scdStore))
+	}()
+
+	// Subscriptions with the same ID are stored separately.
+	ridRepo, err := ridStore.Interact(ctx)
+	require.NoError(t, err)
+	_, err = ridRepo.InsertSubscription(ctx, &ridmodels.Subscription{
+		ID:        id,
+		Owner:     "me",
+		URL:       "https://no/place/like/rid",
+		StartTime: &start,
+		EndTime:   &end,
+		Cells:     cells,
+	})
+	require.NoError(t, err)
+
+	scdRepo, err := scdStore.Interact(ctx)
+	require.NoError(t, err)
+	_, err = scdRepo.UpsertSubscription(ctx, &scdmodels.Subscription{
+		ID:                  id,
+		Owner:               "me",
+		BaseURL:             "https://no/place/like/scd",
+		NotifyForOperations: true,
+		StartTime:           &start,
+		EndTime:             &end,
+		Cells:               cells,
+	})
+	require.NoError(t, err)
+
+	ridSub, err := ridRepo.GetSubscription(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "https://no/place/like/rid", ridSub.URL)
+	scdSub, err := scdRepo.GetSubscription(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "https://no/place/like/scd", scdSub.BaseURL)
+}
+
+func TestTimeToExpiryTracksClock(t *testing.T) {
+	var (
+		clock = clockwork.NewFakeClock()
+		end   = clock.Now().Add(time.Hour)
+		r     = &repo{clock: clock, timesToExpiry: true}
+	)
+	remaining := func() time.Duration {
+		now, ok := r.now()
+		require.True(t, ok)
+		return *timeToExpiry(now, &end)
+	}
+
+	require.Equal(t, time.Hour, remaining())
+	clock.Advance(20 * time.Minute)
+	require.Equal(t, 40*time.Minute, remaining())
+	clock.Advance(time.Hour)
+	require.Equal(t, time.Duration(0), remaining())
+
+	now, _ := r.now()
+	require.Nil(t, timeToExpiry(now, nil))
+
+	r.timesToExpiry = false
+	_, ok := r.now()
+	require.False(t, ok)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	dsserr "github.com/interuss/dss/pkg/errors"
 	dssmodels "github.com/interuss/dss/pkg/models"
 	scdmodels "github.com/interuss/dss/pkg/scd/models"
 	dsssql "github.com/interuss/dss/pkg/sql"
@@ -118,6 +119,12 @@ func (c *repo) fetchSubscriptions(ctx context.Context, q dsssql.Queryable, query
 		return nil, stacktrace.Propagate(err, "Error in rows query result")
 	}
 
+	if now, ok := c.now(); ok {
+		for _, sub := range payload {
+			sub.TimeToExpiry = timeToExpiry(now, sub.EndTime)
+		}
+	}
+
 	return payload, nil
 }
 
@@ -161,20 +168,28 @@ func (c *repo) fetchSubscriptionByID(ctx context.Context, q dsssql.Queryable, id
 
 func (c *repo) pushSubscription(ctx context.Context, q dsssql.Queryable, s *scdmodels.Subscription) (*scdmodels.Subscription, error) {
 	var (
+		// The update only applies if the stored Subscription is still at the
+		// version the caller based it on, so concurrent updates cannot clobber
+		// each other.
 		upsertQuery = fmt.Sprintf(`
-		WITH v AS (
-			SELECT
-				version
-			FROM
-				scd_subscriptions
-			WHERE
-				id = $1
-		)
-		UPSERT INTO
+		INSERT INTO
 		  scd_subscriptions
 		  (%s)
 		VALUES
-			($1, $2, COALESCE((SELECT version from v), 0) + 1, $3, $4, $5, $6, $7, $8, $9, transaction_timestamp())
+			($1, $2, 1, $3, $4, $5, $6, $7, $8, $9, transaction_timestamp())
+		ON CONFLICT (id) DO UPDATE SET
+			owner = excluded.owner,
+			version = scd_subscriptions.version + 1,
+			url = excluded.url,
+			notification_index = excluded.notification_index,
+			notify_for_operations = excluded.notify_for_operations,
+			notify_for_constraints = excluded.notify_for_constraints,
+			implicit = excluded.implicit,
+			starts_at = excluded.starts_at,
+			ends_at = excluded.ends_at,
+			updated_at = excluded.updated_at
+		WHERE
+			scd_subscriptions.version = $10
 		RETURNING
 			%s`, subscriptionFieldsWithoutPrefix, subscriptionFieldsWithPrefix)
 		subscriptionCellQuery = `
@@ -201,7 +216,11 @@ func (c *repo) pushSubscription(ctx context.Context, q dsssql.Queryable, s *scdm
 		clevels[i] = cell.Level()
 	}
 
-	cells := s.Cells
+	var (
+		cells   = s.Cells
+		id      = s.ID
+		version = s.Version
+	)
 	s, err := c.fetchSubscription(ctx, q, upsertQuery,
 		s.ID,
 		s.Owner,
@@ -211,12 +230,22 @@ func (c *repo) pushSubscription(ctx context.Context, q dsssql.Queryable, s *scdm
 		s.NotifyForConstraints,
 		s.ImplicitSubscription,
 		s.StartTime,
-		s.EndTime)
+		s.EndTime,
+		s.Version)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error fetching Subscription from upsert query")
 	}
 	if s == nil {
-		return nil, stacktrace.NewError("Upsert query did not return a Subscription")
+		// The stored Subscription is at a different version.
+		current, err := c.fetchSubscriptionByID(ctx, q, id)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Error fetching conflicting Subscription")
+		}
+		if current == nil {
+			return nil, stacktrace.NewError("Upsert query did not return a Subscription")
+		}
+		return nil, stacktrace.NewErrorWithCode(dsserr.VersionMismatch,
+			"Subscription %s is at version %d, not version %d", id, current.Version, version)
 	}
 	s.Cells = cells
 
@@ -338,36 +367,44 @@ func (c *repo) IncrementNotificationIndices(ctx context.Context, subscriptionIds
 			UPDATE scd_subscriptions
 			SET notification_index = notification_index + 1
 			WHERE id = ANY($1)
-			RETURNING notification_index`
-
-	ids := make([]string, len(subscriptionIds))
-	for i, id := range subscriptionIds {
-		ids[i] = id.String()
-	}
+			RETURNING id, notification_index`
 
-	rows, err := c.q.QueryContext(ctx, updateQuery, pq.StringArray(ids))
+	rows, err := c.q.QueryContext(ctx, updateQuery, pq.StringArray(idStrings(subscriptionIds)))
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error in query: %s", updateQuery)
 	}
 	defer rows.Close()
 
-	var indices []int
+	updated := make(map[dssmodels.ID]int, len(subscriptionIds))
 	for rows.Next() {
-		var notificationIndex int
-		err := rows.Scan(&notificationIndex)
+		var (
+			id                dssmodels.ID
+			notificationIndex int
+		)
+		err := rows.Scan(&id, &notificationIndex)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "Error scanning notification index row")
 		}
-		indices = append(indices, notificationIndex)
+		updated[id] = notificationIndex
 	}
 	if err := rows.Err(); err != nil {
 		return nil, stacktrace.Propagate(err, "Error in rows query result")
 	}
 
-	if len(indices) != len(subscriptionIds) {
-		return nil, stacktrace.NewError(
-			"Expected %d notification_index results when incrementing but got %d instead",
-			len(subscriptionIds), len(indices))
+	var (
+		indices = make([]int, len(subscriptionIds))
+		errs    dsserr.MultiError
+	)
+	for i, id := range subscriptionIds {
+		notificationIndex, ok := updated[id]
+		if !ok {
+			errs.Add(id.String(), stacktrace.NewErrorWithCode(dsserr.NotFound, "Subscription %s not found", id))
+			continue
+		}
+		indices[i] = notificationIndex
+	}
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, stacktrace.Propagate(err, "Error incrementing notification indices")
 	}
 
 	return indices, nil
@@ -0,0 +1,103 @@
+package cockroach
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/geo/s2"
+	dsserr "github.com/interuss/dss/pkg/errors"
+	dssmodels "github.com/interuss/dss/pkg/models"
+	scdmodels "github.com/interuss/dss/pkg/scd/models"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentSubscriptionUpdatesDoNotClobber(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now()
+		end                  = start.Add(time.Hour)
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	created, err := repo.UpsertSubscription(ctx, &scdmodels.Subscription{
+		ID:                  dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		Owner:               "me",
+		BaseURL:             "https://no/place/like/home",
+		NotifyForOperations: true,
+		StartTime:           &start,
+		EndTime:             &end,
+		Cells:               s2.CellUnion{s2.CellID(17106221850767130624)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, scdmodels.Version(1), created.Version)
+
+	// Two clients read the Subscription and update it concurrently.
+	first, second := *created, *created
+	first.BaseURL = "https://no/place/like/work"
+	second.BaseURL = "https://no/place/like/school"
+
+	updated, err := repo.UpsertSubscription(ctx, &first)
+	require.NoError(t, err)
+	require.Equal(t, scdmodels.Version(2), updated.Version)
+
+	_, err = repo.UpsertSubscription(ctx, &second)
+	require.Error(t, err)
+	require.Equal(t, dsserr.VersionMismatch, stacktrace.GetCode(err))
+	require.Contains(t, err.Error(), "is at version 2")
+
+	stored, err := repo.GetSubscription(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, first.BaseURL, stored.BaseURL)
+	require.Equal(t, scdmodels.Version(2), stored.Version)
+}
+
+func TestIncrementNotificationIndicesReportsEveryMissingSubscription(t *testing.T) {
+	var (
+		ctx                  = context.Background()
+		store, tearDownStore = setUpStore(ctx, t)
+		start                = fakeClock.Now()
+		end                  = start.Add(time.Hour)
+		missing              = []dssmodels.ID{
+			dssmodels.ID("d6a6c5c2-4b0e-4b8a-9d7e-0d3d2b6b3f01"),
+			dssmodels.ID("d6a6c5c2-4b0e-4b8a-9d7e-0d3d2b6b3f02"),
+		}
+	)
+	defer tearDownStore()
+
+	repo, err := store.Interact(ctx)
+	require.NoError(t, err)
+
+	created, err := repo.UpsertSubscription(ctx, &scdmodels.Subscription{
+		ID:                  dssmodels.ID("4348c8e5-0b1c-43cf-9114-2e67a4532765"),
+		Owner:               "me",
+		BaseURL:             "https://no/place/like/home",
+		NotifyForOperations: true,
+		StartTime:           &start,
+		EndTime:             &end,
+		Cells:               s2.CellUnion{s2.CellID(17106221850767130624)},
+	})
+	require.NoError(t, err)
+
+	indices, err := repo.IncrementNotificationIndices(ctx, []dssmodels.ID{missing[0], created.ID, missing[1]})
+	require.Error(t, err)
+	require.Nil(t, indices)
+	multi, ok := stacktrace.RootCause(err).(*dsserr.MultiError)
+	require.True(t, ok)
+	require.Len(t, multi.Items, 2)
+	for i, item := range multi.Items {
+		require.Equal(t, missing[i].String(), item.Item)
+		require.Equal(t, dsserr.NotFound, stacktrace.GetCode(item.Err))
+	}
+
+	// Outside of a transaction, the index of the existing Subscription was
+	// incremented regardless.
+	indices, err = repo.IncrementNotificationIndices(ctx, []dssmodels.ID{created.ID})
+	require.NoError(t, err)
+	require.Equal(t, []int{created.NotificationIndex + 2}, indices)
+}
@@ -11,9 +11,14 @@ import (
 type Store interface {
 	Interactor
 	Transactor
+	Reader
 
 	// Close closes the store and releases all of its resources.
 	Close() error
+
+	// RefreshStatistics refreshes the statistics of the tables storing
+	// strategic conflict detection entities and returns their names.
+	RefreshStatistics(ctx context.Context) ([]string, error)
 }
 
 // Interactor provides means to get hold of a repos.Repository instance *without* any
@@ -30,3 +35,12 @@ type Transactor interface {
 	// isolation/atomicity.
 	Transact(ctx context.Context, f func(context.Context, repos.Repository) error) error
 }
+
+// Reader provides means to get hold of a repos.Repository instance for reads
+// that tolerate bounded staleness.
+type Reader interface {
+	// Read executes f, which must only read, with a repos.Repository instance
+	// that may serve reads from a recent snapshot rather than the latest
+	// writes. Reads on which a write depends must use Transact instead.
+	Read(ctx context.Context, f func(context.Context, repos.Repository) error) error
+}
@@ -51,6 +51,9 @@ func (a *Server) PutSubscription(ctx context.Context, req *scdpb.PutSubscription
 	default:
 		return nil, stacktrace.Propagate(err, "Invalid area")
 	}
+	if err := geo.ValidateStoredCells(cells); err != nil {
+		return nil, stacktrace.Propagate(err, "Invalid area")
+	}
 
 	subreq := &scdmodels.Subscription{
 		ID:      id,
@@ -253,7 +256,7 @@ func (a *Server) GetSubscription(ctx context.Context, req *scdpb.GetSubscription
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.Store.Read(ctx, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
@@ -310,7 +313,7 @@ func (a *Server) QuerySubscriptions(ctx context.Context, req *scdpb.QuerySubscri
 		return nil
 	}
 
-	err = a.Store.Transact(ctx, action)
+	err = a.Store.Read(ctx, action)
 	if err != nil {
 		return nil, err // No need to Propagate this error as this is not a useful stacktrace line
 	}
@@ -3,6 +3,8 @@ package sql
 import (
 	"context"
 	"database/sql"
+
+	"github.com/interuss/dss/pkg/logging"
 )
 
 // Queryable abstracts common operations on sql.DB and sql.Tx instances.
@@ -11,3 +13,32 @@ type Queryable interface {
 	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 }
+
+// StorePhase is the request phase queries run through a Queryable returned by
+// WithTiming are accounted to; see logging.TimePhase.
+const StorePhase = "store"
+
+// WithTiming returns a Queryable running queries through q, and accounting
+// the time they take to StorePhase of the request in their context.
+func WithTiming(q Queryable) Queryable {
+	return timedQueryable{q}
+}
+
+type timedQueryable struct {
+	q Queryable
+}
+
+func (t timedQueryable) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer logging.TimePhase(ctx, StorePhase)()
+	return t.q.QueryContext(ctx, query, args...)
+}
+
+func (t timedQueryable) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	defer logging.TimePhase(ctx, StorePhase)()
+	return t.q.QueryRowContext(ctx, query, args...)
+}
+
+func (t timedQueryable) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer logging.TimePhase(ctx, StorePhase)()
+	return t.q.ExecContext(ctx, query, args...)
+}
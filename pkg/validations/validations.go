@@ -2,12 +2,15 @@ package validations
 
 import (
 	"context"
+	"mime"
+	"strings"
 
 	"github.com/google/uuid"
 
 	dsserr "github.com/interuss/dss/pkg/errors"
 	"github.com/interuss/stacktrace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // ReqWithID checks if the proto message contains an ID, so it can validate it
@@ -37,3 +40,78 @@ func ValidateUUID(req interface{}) error {
 	}
 	return nil
 }
+
+// DeadlineInterceptor returns a grpc Interceptor rejecting requests whose
+// context has no deadline, so that clients bound the work done on their
+// behalf. Operations for which exempt returns true are always served.
+func DeadlineInterceptor(exempt func(fullMethod string) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if _, ok := ctx.Deadline(); !ok && !exempt(info.FullMethod) {
+			return nil, stacktrace.NewErrorWithCode(dsserr.BadRequest, "Request must set a deadline")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ContentTypeInterceptor returns a grpc Interceptor rejecting requests whose
+// content type is not one of "allowed", such as application/grpc+proto, so
+// that traffic misrouted to the DSS is not served. Parameters of content types
+// are ignored, and application/grpc is the same content type as
+// application/grpc+proto.
+func ContentTypeInterceptor(allowed []string) grpc.UnaryServerInterceptor {
+	types := contentTypeSet(allowed)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateContentType(ctx, types, allowed); err != nil {
+			return nil, err // No need to Propagate this error as this stack layer does not add useful information
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamContentTypeInterceptor is the ContentTypeInterceptor of streaming
+// RPCs.
+func StreamContentTypeInterceptor(allowed []string) grpc.StreamServerInterceptor {
+	types := contentTypeSet(allowed)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := validateContentType(ss.Context(), types, allowed); err != nil {
+			return err // No need to Propagate this error as this stack layer does not add useful information
+		}
+		return handler(srv, ss)
+	}
+}
+
+func contentTypeSet(contentTypes []string) map[string]bool {
+	types := map[string]bool{}
+	for _, t := range contentTypes {
+		types[normalizeContentType(t)] = true
+	}
+	return types
+}
+
+// normalizeContentType returns the media type of contentType, in lower case
+// and without parameters, or contentType unchanged if it is malformed.
+func normalizeContentType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+	if mediaType == "application/grpc" {
+		return "application/grpc+proto"
+	}
+	return mediaType
+}
+
+func validateContentType(ctx context.Context, types map[string]bool, allowed []string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	vs := md.Get("content-type")
+	if len(vs) == 0 {
+		return stacktrace.NewErrorWithCode(dsserr.BadRequest, "Request is missing a content type")
+	}
+	for _, v := range vs {
+		if !types[normalizeContentType(v)] {
+			return stacktrace.NewErrorWithCode(dsserr.BadRequest,
+				"Content type %q is not accepted; requests must use one of %s", v, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package validations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dsserr "github.com/interuss/dss/pkg/errors"
+	"github.com/interuss/stacktrace"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDeadlineInterceptorRejectsRequestsWithoutDeadline(t *testing.T) {
+	var (
+		ic = DeadlineInterceptor(func(method string) bool {
+			return method == "/svc/Health"
+		})
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+		call = func(ctx context.Context, method string) error {
+			_, err := ic(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+			return err
+		}
+	)
+
+	err := call(context.Background(), "/svc/Search")
+	require.Error(t, err)
+	require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	require.NoError(t, call(ctx, "/svc/Search"))
+
+	require.NoError(t, call(context.Background(), "/svc/Health"))
+}
+
+func TestContentTypeInterceptorRejectsMismatchedContentTypes(t *testing.T) {
+	var (
+		ic      = ContentTypeInterceptor([]string{"application/grpc+proto"})
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+		call = func(contentType ...string) error {
+			md := metadata.MD{}
+			if len(contentType) > 0 {
+				md.Set("content-type", contentType...)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+			_, err := ic(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/svc/Search"}, handler)
+			return err
+		}
+	)
+
+	require.NoError(t, call("application/grpc+proto"))
+	require.NoError(t, call("application/grpc"))
+	require.NoError(t, call("Application/GRPC+proto; charset=utf-8"))
+
+	for _, contentType := range [][]string{{"application/grpc+json"}, {"application/json"}, {}} {
+		err := call(contentType...)
+		require.Error(t, err)
+		require.Equal(t, dsserr.BadRequest, stacktrace.GetCode(err))
+	}
+}
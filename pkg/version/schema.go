@@ -0,0 +1,33 @@
+package version
+
+import (
+	"context"
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/interuss/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// SchemaVersionTrailer is the trailer through which SchemaVersionInterceptor
+// reports the version of the database schema serving a request.
+const SchemaVersionTrailer = "x-dss-schema-version"
+
+// SchemaVersionInterceptor returns a grpc.UnaryServerInterceptor setting the
+// SchemaVersionTrailer of responses to operations whose full method starts
+// with one of the prefixes of schemas, such as "/ridpb.", to the version of
+// the corresponding schema. Other responses are left untouched.
+func SchemaVersionInterceptor(schemas map[string]*semver.Version) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for prefix, v := range schemas {
+			if strings.HasPrefix(info.FullMethod, prefix) {
+				if err := grpc.SetTrailer(ctx, metadata.Pairs(SchemaVersionTrailer, v.String())); err != nil {
+					return nil, stacktrace.Propagate(err, "Unable to report schema version")
+				}
+				break
+			}
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,41 @@
+package version
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/coreos/go-semver/semver"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestSchemaVersionInterceptorSetsTrailer(t *testing.T) {
+	var (
+		listener = bufconn.Listen(1 << 20)
+		s        = grpc.NewServer(grpc.UnaryInterceptor(SchemaVersionInterceptor(map[string]*semver.Version{
+			"/ridpb.":          semver.New("3.1.0"),
+			"/grpc.health.v1.": semver.New("1.0.0"),
+		})))
+	)
+	grpc_health_v1.RegisterHealthServer(s, health.NewServer())
+	go s.Serve(listener)
+	defer s.Stop()
+
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	var trailer metadata.MD
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(context.Background(),
+		&grpc_health_v1.HealthCheckRequest{}, grpc.Trailer(&trailer))
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.0.0"}, trailer.Get(SchemaVersionTrailer))
+}